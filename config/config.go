@@ -32,10 +32,22 @@ const (
 type (
 	// Config stores complete configuration.
 	Config struct {
-		HTTP     HTTPConfig
-		App      AppConfig
-		Database DatabaseConfig
-		JWT      JWTConfig
+		HTTP         HTTPConfig
+		App          AppConfig
+		Database     DatabaseConfig
+		JWT          JWTConfig
+		Cookie       SessionCookieConfig
+		TokenCleanup TokenCleanupConfig
+		Login        LoginProtectionConfig
+		Account      AccountConfig
+		Password     PasswordPolicyConfig
+		PasswordHash PasswordHashConfig
+		Mail         MailConfig
+		WebAuthn     WebAuthnConfig
+		OIDC         OIDCConfig
+		OAuth        OAuthConfig
+		Admin        AdminConfig
+		Security     SecurityEventConfig
 	}
 
 	// HTTPConfig stores HTTP configuration.
@@ -46,6 +58,7 @@ type (
 		WriteTimeout    time.Duration
 		IdleTimeout     time.Duration
 		ShutdownTimeout time.Duration
+		TrustedProxies  []string // 可信反向代理的IP或CIDR，只信任来自这些地址的 X-Forwarded-For，为空时使用连接地址
 	}
 
 	// AppConfig stores application configuration.
@@ -62,6 +75,140 @@ type (
 		AccessTokenExpiry  time.Duration // Access token 过期时间
 		RefreshTokenExpiry time.Duration // Refresh token 过期时间
 		Issuer             string        // Token发行者
+		TokenHashKey       string        // 令牌哈希密钥，为空时使用 Secret
+		SigningKeyID       string        // 当前签名密钥ID，为空时使用 Secret 进行 HS256 签名
+		MaxSessionsPerUser int           // 每个用户最多同时存在的会话数，0表示不限制
+		IdleTimeout        time.Duration // 会话空闲超时，超过该时间未使用的access token将被拒绝，0表示不限制
+		RecentAuthWindow   time.Duration // 敏感操作要求在该时间内完成过认证，超过后需要重新验证身份
+		UsageFlushInterval time.Duration // 令牌最后使用时间写入数据库的间隔
+		AuthCacheTTL       time.Duration // 认证结果缓存时间，0表示不缓存
+		AuthCacheSize      int           // 认证缓存最多保存的令牌数
+		Keys               []JWTKeyConfig
+	}
+
+	// JWTKeyConfig stores an asymmetric JWT key.
+	JWTKeyConfig struct {
+		ID             string // 密钥ID（kid）
+		Algorithm      string // 签名算法：RS256/ES256/EdDSA 等
+		PrivateKeyFile string // 私钥PEM文件，仅用于验证的旧密钥可不配置
+		PublicKeyFile  string // 公钥PEM文件，配置了私钥时可省略
+	}
+
+	// SessionCookieConfig stores the cookie session mode configuration for browser clients.
+	SessionCookieConfig struct {
+		Enabled  bool   // 是否允许浏览器客户端通过 X-Session-Mode: cookie 使用 Cookie 保存令牌
+		Domain   string // Cookie 域名，为空时仅限当前主机
+		Secure   bool   // 是否仅通过 HTTPS 发送
+		SameSite string // 跨站发送策略：lax/strict/none
+	}
+
+	// TokenCleanupConfig stores the expired token cleanup configuration.
+	TokenCleanupConfig struct {
+		Interval  time.Duration // 清理间隔
+		Grace     time.Duration // 过期后保留的宽限期
+		BatchSize int           // 每批删除的最大行数
+	}
+
+	// LoginProtectionConfig stores the login brute-force protection configuration.
+	LoginProtectionConfig struct {
+		AccountThreshold int           // 同一账户连续失败多少次后锁定
+		IPThreshold      int           // 同一IP连续失败多少次后锁定
+		BaseDelay        time.Duration // 首次失败后的等待时间，之后每次失败翻倍
+		MaxDelay         time.Duration // 指数退避的最长等待时间
+		LockoutDuration  time.Duration // 达到阈值后的锁定时间
+		Window           time.Duration // 超过该时间没有失败则重新计数
+	}
+
+	// AccountConfig stores the account lifecycle configuration.
+	AccountConfig struct {
+		VerifyEmail                  bool          // 注册后是否需要验证邮箱才能登录
+		VerificationExpiry           time.Duration // 邮箱验证链接有效期
+		PasswordResetExpiry          time.Duration // 重置密码链接有效期
+		PasswordResetURL             string        // 重置密码页面地址，为空时使用 app.host + /reset-password
+		MagicLinkEnabled             bool          // 是否允许通过邮件中的一次性链接免密码登录
+		MagicLinkExpiry              time.Duration // 登录链接有效期
+		MagicLinkURL                 string        // 登录链接打开的页面地址，为空时使用 app.host + /magic-link
+		KeepSessionsOnPasswordChange bool          // 修改密码后是否保留其他会话，默认撤销并为当前会话签发新令牌
+		EmailChangeExpiry            time.Duration // 修改邮箱确认链接有效期
+		EmailChangeURL               string        // 确认修改邮箱页面地址，为空时使用 app.host + /confirm-email
+		EmailRevertExpiry            time.Duration // 发送到原邮箱的撤销链接有效期
+		EmailRevertURL               string        // 撤销修改邮箱页面地址，为空时使用 app.host + /revert-email
+		EmailCooldown                time.Duration // 同一邮箱再次申请登录链接或重置密码邮件的间隔
+		EmailIPLimit                 int           // 同一IP在时间窗口内最多申请几次登录链接或重置密码邮件
+		EmailIPWindow                time.Duration // 按IP限制申请次数的时间窗口
+	}
+
+	// PasswordPolicyConfig stores the password policy configuration.
+	PasswordPolicyConfig struct {
+		MinLength           int    // 最小长度（字符数）
+		MaxLength           int    // 最大长度（字符数）
+		RequireUpper        bool   // 是否必须包含大写字母
+		RequireLower        bool   // 是否必须包含小写字母
+		RequireDigit        bool   // 是否必须包含数字
+		RequireSymbol       bool   // 是否必须包含特殊字符
+		DisallowUserInfo    bool   // 是否禁止包含用户名或邮箱
+		CommonPasswordsFile string // 常见或已泄露密码列表文件，每行一个，为空时不检查
+		HistorySize         int    // 不能重复使用最近几次的密码（包括当前密码），0表示不限制
+	}
+
+	// PasswordHashConfig stores the Argon2id password hashing configuration.
+	PasswordHashConfig struct {
+		Memory      uint32 // 内存开销（KiB）
+		Iterations  uint32 // 迭代次数
+		Parallelism uint8  // 并行度
+		Pepper      string // 服务端密钥，与数据库分开保存，修改后所有 Argon2id 密码哈希失效
+	}
+
+	// MailConfig stores the mail delivery configuration.
+	MailConfig struct {
+		Driver   string // 发送方式：smtp/file/memory
+		From     string // 发件人地址
+		Host     string // SMTP服务器地址
+		Port     int    // SMTP服务器端口
+		Username string // SMTP用户名，为空时不认证
+		Password string // SMTP密码
+		File     string // file 方式写入的文件路径
+	}
+
+	// WebAuthnConfig stores the passkey (WebAuthn) configuration.
+	WebAuthnConfig struct {
+		RPID          string        // 依赖方ID，通常为站点域名，为空时使用 app.host 的主机名
+		RPDisplayName string        // 认证器中显示的站点名称，为空时使用 app.name
+		Origins       []string      // 允许发起认证的来源，为空时使用 app.host
+		Timeout       time.Duration // 注册与登录流程的有效期
+	}
+
+	// OIDCConfig stores the external OpenID Connect login configuration.
+	OIDCConfig struct {
+		StateExpiry time.Duration        // 跳转到身份提供方后完成登录的时限
+		LoginURL    string               // 登录完成后跳转的前端页面，未开启 Cookie 会话模式时通过地址片段中的 code 换取令牌，为空时使用 app.host + /oidc-login
+		Providers   []OIDCProviderConfig // 身份提供方列表
+	}
+
+	// OIDCProviderConfig stores an OpenID Connect identity provider.
+	OIDCProviderConfig struct {
+		Name         string   // 提供方名称，用于登录地址 /api/v1/auth/oidc/:name
+		Issuer       string   // 发行者地址，通过 /.well-known/openid-configuration 获取端点
+		ClientID     string   // 客户端ID
+		ClientSecret string   // 客户端密钥
+		Scopes       []string // 额外申请的权限，openid、email、profile 总是会申请
+		RedirectURL  string   // 回调地址，为空时使用 app.host + /api/v1/auth/oidc/:name/callback
+	}
+
+	// OAuthConfig stores the OAuth 2.0 authorization server configuration.
+	OAuthConfig struct {
+		AuthorizeURL string        // 授权确认页面地址，为空时使用 app.host + /oauth/authorize
+		CodeExpiry   time.Duration // 授权码有效期
+	}
+
+	// AdminConfig stores the administration configuration.
+	AdminConfig struct {
+		ImpersonationExpiry time.Duration // 管理员代为登录签发的访问令牌有效期
+	}
+
+	// SecurityEventConfig stores the user security event log configuration.
+	SecurityEventConfig struct {
+		EventRetention time.Duration // 安全事件保留时间，超过后由令牌清理任务删除
 	}
 
 	// DatabaseConfig stores the database configuration.
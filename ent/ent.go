@@ -12,6 +12,18 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/liukeshao/echo-template/ent/identity"
+	"github.com/liukeshao/echo-template/ent/impersonation"
+	"github.com/liukeshao/echo-template/ent/oauthclient"
+	"github.com/liukeshao/echo-template/ent/oauthcode"
+	"github.com/liukeshao/echo-template/ent/oidcstate"
+	"github.com/liukeshao/echo-template/ent/onetimetoken"
+	"github.com/liukeshao/echo-template/ent/passkey"
+	"github.com/liukeshao/echo-template/ent/passkeysession"
+	"github.com/liukeshao/echo-template/ent/passwordhistory"
+	"github.com/liukeshao/echo-template/ent/personalaccesstoken"
+	"github.com/liukeshao/echo-template/ent/recoverycode"
+	"github.com/liukeshao/echo-template/ent/securityevent"
 	"github.com/liukeshao/echo-template/ent/token"
 	"github.com/liukeshao/echo-template/ent/user"
 )
@@ -71,14 +83,26 @@ var (
 )
 
 // checkColumn checks if the column exists in the given table.
-func checkColumn(table, column string) error {
+func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			token.Table: token.ValidColumn,
-			user.Table:  user.ValidColumn,
+			identity.Table:            identity.ValidColumn,
+			impersonation.Table:       impersonation.ValidColumn,
+			oauthclient.Table:         oauthclient.ValidColumn,
+			oauthcode.Table:           oauthcode.ValidColumn,
+			oidcstate.Table:           oidcstate.ValidColumn,
+			onetimetoken.Table:        onetimetoken.ValidColumn,
+			passkey.Table:             passkey.ValidColumn,
+			passkeysession.Table:      passkeysession.ValidColumn,
+			passwordhistory.Table:     passwordhistory.ValidColumn,
+			personalaccesstoken.Table: personalaccesstoken.ValidColumn,
+			recoverycode.Table:        recoverycode.ValidColumn,
+			securityevent.Table:       securityevent.ValidColumn,
+			token.Table:               token.ValidColumn,
+			user.Table:                user.ValidColumn,
 		})
 	})
-	return columnCheck(table, column)
+	return columnCheck(t, c)
 }
 
 // Asc applies the given fields in ASC order.
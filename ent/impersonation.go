@@ -0,0 +1,231 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/liukeshao/echo-template/ent/impersonation"
+)
+
+// Impersonation is the model entity for the Impersonation schema.
+type Impersonation struct {
+	config `json:"-"`
+	// ID of the ent.
+	// 唯一标识符，ULID格式
+	ID string `json:"id,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 逻辑删除时间戳（毫秒），0表示未删除
+	DeletedAt int64 `json:"deleted_at,omitempty"`
+	// 发起代为登录的管理员ID
+	AdminID string `json:"admin_id,omitempty"`
+	// 被代为登录的用户ID
+	UserID string `json:"user_id,omitempty"`
+	// 代为登录的原因，例如工单编号
+	Reason string `json:"reason,omitempty"`
+	// 代为登录签发的令牌族ID，用于结束代为登录时撤销令牌
+	FamilyID string `json:"family_id,omitempty"`
+	// 开始时间
+	StartedAt time.Time `json:"started_at,omitempty"`
+	// 代为登录令牌的过期时间，未主动结束时即为结束时间
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// 主动结束的时间
+	EndedAt *time.Time `json:"ended_at,omitempty"`
+	// 管理员发起代为登录时的User-Agent
+	UserAgent string `json:"user_agent,omitempty"`
+	// 管理员发起代为登录时的IP地址
+	IP           string `json:"ip,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Impersonation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case impersonation.FieldDeletedAt:
+			values[i] = new(sql.NullInt64)
+		case impersonation.FieldID, impersonation.FieldAdminID, impersonation.FieldUserID, impersonation.FieldReason, impersonation.FieldFamilyID, impersonation.FieldUserAgent, impersonation.FieldIP:
+			values[i] = new(sql.NullString)
+		case impersonation.FieldCreatedAt, impersonation.FieldUpdatedAt, impersonation.FieldStartedAt, impersonation.FieldExpiresAt, impersonation.FieldEndedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Impersonation fields.
+func (_m *Impersonation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case impersonation.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case impersonation.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case impersonation.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case impersonation.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = value.Int64
+			}
+		case impersonation.FieldAdminID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field admin_id", values[i])
+			} else if value.Valid {
+				_m.AdminID = value.String
+			}
+		case impersonation.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = value.String
+			}
+		case impersonation.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case impersonation.FieldFamilyID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field family_id", values[i])
+			} else if value.Valid {
+				_m.FamilyID = value.String
+			}
+		case impersonation.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				_m.StartedAt = value.Time
+			}
+		case impersonation.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Time
+			}
+		case impersonation.FieldEndedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ended_at", values[i])
+			} else if value.Valid {
+				_m.EndedAt = new(time.Time)
+				*_m.EndedAt = value.Time
+			}
+		case impersonation.FieldUserAgent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_agent", values[i])
+			} else if value.Valid {
+				_m.UserAgent = value.String
+			}
+		case impersonation.FieldIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip", values[i])
+			} else if value.Valid {
+				_m.IP = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Impersonation.
+// This includes values selected through modifiers, order, etc.
+func (_m *Impersonation) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Impersonation.
+// Note that you need to call Impersonation.Unwrap() before calling this method if this Impersonation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Impersonation) Update() *ImpersonationUpdateOne {
+	return NewImpersonationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Impersonation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Impersonation) Unwrap() *Impersonation {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Impersonation is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Impersonation) String() string {
+	var builder strings.Builder
+	builder.WriteString("Impersonation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DeletedAt))
+	builder.WriteString(", ")
+	builder.WriteString("admin_id=")
+	builder.WriteString(_m.AdminID)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(_m.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("family_id=")
+	builder.WriteString(_m.FamilyID)
+	builder.WriteString(", ")
+	builder.WriteString("started_at=")
+	builder.WriteString(_m.StartedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.EndedAt; v != nil {
+		builder.WriteString("ended_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("user_agent=")
+	builder.WriteString(_m.UserAgent)
+	builder.WriteString(", ")
+	builder.WriteString("ip=")
+	builder.WriteString(_m.IP)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Impersonations is a parsable slice of Impersonation.
+type Impersonations []*Impersonation
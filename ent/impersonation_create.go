@@ -0,0 +1,439 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/liukeshao/echo-template/ent/impersonation"
+)
+
+// ImpersonationCreate is the builder for creating a Impersonation entity.
+type ImpersonationCreate struct {
+	config
+	mutation *ImpersonationMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ImpersonationCreate) SetCreatedAt(v time.Time) *ImpersonationCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ImpersonationCreate) SetNillableCreatedAt(v *time.Time) *ImpersonationCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ImpersonationCreate) SetUpdatedAt(v time.Time) *ImpersonationCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ImpersonationCreate) SetNillableUpdatedAt(v *time.Time) *ImpersonationCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *ImpersonationCreate) SetDeletedAt(v int64) *ImpersonationCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *ImpersonationCreate) SetNillableDeletedAt(v *int64) *ImpersonationCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetAdminID sets the "admin_id" field.
+func (_c *ImpersonationCreate) SetAdminID(v string) *ImpersonationCreate {
+	_c.mutation.SetAdminID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *ImpersonationCreate) SetUserID(v string) *ImpersonationCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *ImpersonationCreate) SetReason(v string) *ImpersonationCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetFamilyID sets the "family_id" field.
+func (_c *ImpersonationCreate) SetFamilyID(v string) *ImpersonationCreate {
+	_c.mutation.SetFamilyID(v)
+	return _c
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_c *ImpersonationCreate) SetStartedAt(v time.Time) *ImpersonationCreate {
+	_c.mutation.SetStartedAt(v)
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *ImpersonationCreate) SetExpiresAt(v time.Time) *ImpersonationCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (_c *ImpersonationCreate) SetEndedAt(v time.Time) *ImpersonationCreate {
+	_c.mutation.SetEndedAt(v)
+	return _c
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (_c *ImpersonationCreate) SetNillableEndedAt(v *time.Time) *ImpersonationCreate {
+	if v != nil {
+		_c.SetEndedAt(*v)
+	}
+	return _c
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_c *ImpersonationCreate) SetUserAgent(v string) *ImpersonationCreate {
+	_c.mutation.SetUserAgent(v)
+	return _c
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_c *ImpersonationCreate) SetNillableUserAgent(v *string) *ImpersonationCreate {
+	if v != nil {
+		_c.SetUserAgent(*v)
+	}
+	return _c
+}
+
+// SetIP sets the "ip" field.
+func (_c *ImpersonationCreate) SetIP(v string) *ImpersonationCreate {
+	_c.mutation.SetIP(v)
+	return _c
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_c *ImpersonationCreate) SetNillableIP(v *string) *ImpersonationCreate {
+	if v != nil {
+		_c.SetIP(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ImpersonationCreate) SetID(v string) *ImpersonationCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the ImpersonationMutation object of the builder.
+func (_c *ImpersonationCreate) Mutation() *ImpersonationMutation {
+	return _c.mutation
+}
+
+// Save creates the Impersonation in the database.
+func (_c *ImpersonationCreate) Save(ctx context.Context) (*Impersonation, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ImpersonationCreate) SaveX(ctx context.Context) *Impersonation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ImpersonationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ImpersonationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ImpersonationCreate) defaults() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if impersonation.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized impersonation.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := impersonation.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if impersonation.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized impersonation.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := impersonation.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.DeletedAt(); !ok {
+		v := impersonation.DefaultDeletedAt
+		_c.mutation.SetDeletedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ImpersonationCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Impersonation.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Impersonation.updated_at"`)}
+	}
+	if _, ok := _c.mutation.DeletedAt(); !ok {
+		return &ValidationError{Name: "deleted_at", err: errors.New(`ent: missing required field "Impersonation.deleted_at"`)}
+	}
+	if _, ok := _c.mutation.AdminID(); !ok {
+		return &ValidationError{Name: "admin_id", err: errors.New(`ent: missing required field "Impersonation.admin_id"`)}
+	}
+	if v, ok := _c.mutation.AdminID(); ok {
+		if err := impersonation.AdminIDValidator(v); err != nil {
+			return &ValidationError{Name: "admin_id", err: fmt.Errorf(`ent: validator failed for field "Impersonation.admin_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Impersonation.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := impersonation.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Impersonation.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "Impersonation.reason"`)}
+	}
+	if v, ok := _c.mutation.Reason(); ok {
+		if err := impersonation.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "Impersonation.reason": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FamilyID(); !ok {
+		return &ValidationError{Name: "family_id", err: errors.New(`ent: missing required field "Impersonation.family_id"`)}
+	}
+	if v, ok := _c.mutation.FamilyID(); ok {
+		if err := impersonation.FamilyIDValidator(v); err != nil {
+			return &ValidationError{Name: "family_id", err: fmt.Errorf(`ent: validator failed for field "Impersonation.family_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`ent: missing required field "Impersonation.started_at"`)}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "Impersonation.expires_at"`)}
+	}
+	if v, ok := _c.mutation.UserAgent(); ok {
+		if err := impersonation.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "Impersonation.user_agent": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.IP(); ok {
+		if err := impersonation.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "Impersonation.ip": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := impersonation.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Impersonation.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *ImpersonationCreate) sqlSave(ctx context.Context) (*Impersonation, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Impersonation.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ImpersonationCreate) createSpec() (*Impersonation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Impersonation{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(impersonation.Table, sqlgraph.NewFieldSpec(impersonation.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(impersonation.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(impersonation.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(impersonation.FieldDeletedAt, field.TypeInt64, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := _c.mutation.AdminID(); ok {
+		_spec.SetField(impersonation.FieldAdminID, field.TypeString, value)
+		_node.AdminID = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(impersonation.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(impersonation.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.FamilyID(); ok {
+		_spec.SetField(impersonation.FieldFamilyID, field.TypeString, value)
+		_node.FamilyID = value
+	}
+	if value, ok := _c.mutation.StartedAt(); ok {
+		_spec.SetField(impersonation.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(impersonation.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.EndedAt(); ok {
+		_spec.SetField(impersonation.FieldEndedAt, field.TypeTime, value)
+		_node.EndedAt = &value
+	}
+	if value, ok := _c.mutation.UserAgent(); ok {
+		_spec.SetField(impersonation.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	if value, ok := _c.mutation.IP(); ok {
+		_spec.SetField(impersonation.FieldIP, field.TypeString, value)
+		_node.IP = value
+	}
+	return _node, _spec
+}
+
+// ImpersonationCreateBulk is the builder for creating many Impersonation entities in bulk.
+type ImpersonationCreateBulk struct {
+	config
+	err      error
+	builders []*ImpersonationCreate
+}
+
+// Save creates the Impersonation entities in the database.
+func (_c *ImpersonationCreateBulk) Save(ctx context.Context) ([]*Impersonation, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Impersonation, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ImpersonationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ImpersonationCreateBulk) SaveX(ctx context.Context) []*Impersonation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ImpersonationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ImpersonationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
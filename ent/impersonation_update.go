@@ -0,0 +1,714 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/liukeshao/echo-template/ent/impersonation"
+	"github.com/liukeshao/echo-template/ent/predicate"
+)
+
+// ImpersonationUpdate is the builder for updating Impersonation entities.
+type ImpersonationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ImpersonationMutation
+}
+
+// Where appends a list predicates to the ImpersonationUpdate builder.
+func (_u *ImpersonationUpdate) Where(ps ...predicate.Impersonation) *ImpersonationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ImpersonationUpdate) SetUpdatedAt(v time.Time) *ImpersonationUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ImpersonationUpdate) SetDeletedAt(v int64) *ImpersonationUpdate {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ImpersonationUpdate) SetNillableDeletedAt(v *int64) *ImpersonationUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *ImpersonationUpdate) AddDeletedAt(v int64) *ImpersonationUpdate {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// SetAdminID sets the "admin_id" field.
+func (_u *ImpersonationUpdate) SetAdminID(v string) *ImpersonationUpdate {
+	_u.mutation.SetAdminID(v)
+	return _u
+}
+
+// SetNillableAdminID sets the "admin_id" field if the given value is not nil.
+func (_u *ImpersonationUpdate) SetNillableAdminID(v *string) *ImpersonationUpdate {
+	if v != nil {
+		_u.SetAdminID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ImpersonationUpdate) SetUserID(v string) *ImpersonationUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ImpersonationUpdate) SetNillableUserID(v *string) *ImpersonationUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *ImpersonationUpdate) SetReason(v string) *ImpersonationUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *ImpersonationUpdate) SetNillableReason(v *string) *ImpersonationUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetFamilyID sets the "family_id" field.
+func (_u *ImpersonationUpdate) SetFamilyID(v string) *ImpersonationUpdate {
+	_u.mutation.SetFamilyID(v)
+	return _u
+}
+
+// SetNillableFamilyID sets the "family_id" field if the given value is not nil.
+func (_u *ImpersonationUpdate) SetNillableFamilyID(v *string) *ImpersonationUpdate {
+	if v != nil {
+		_u.SetFamilyID(*v)
+	}
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *ImpersonationUpdate) SetStartedAt(v time.Time) *ImpersonationUpdate {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *ImpersonationUpdate) SetNillableStartedAt(v *time.Time) *ImpersonationUpdate {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *ImpersonationUpdate) SetExpiresAt(v time.Time) *ImpersonationUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *ImpersonationUpdate) SetNillableExpiresAt(v *time.Time) *ImpersonationUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (_u *ImpersonationUpdate) SetEndedAt(v time.Time) *ImpersonationUpdate {
+	_u.mutation.SetEndedAt(v)
+	return _u
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (_u *ImpersonationUpdate) SetNillableEndedAt(v *time.Time) *ImpersonationUpdate {
+	if v != nil {
+		_u.SetEndedAt(*v)
+	}
+	return _u
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (_u *ImpersonationUpdate) ClearEndedAt() *ImpersonationUpdate {
+	_u.mutation.ClearEndedAt()
+	return _u
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_u *ImpersonationUpdate) SetUserAgent(v string) *ImpersonationUpdate {
+	_u.mutation.SetUserAgent(v)
+	return _u
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_u *ImpersonationUpdate) SetNillableUserAgent(v *string) *ImpersonationUpdate {
+	if v != nil {
+		_u.SetUserAgent(*v)
+	}
+	return _u
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (_u *ImpersonationUpdate) ClearUserAgent() *ImpersonationUpdate {
+	_u.mutation.ClearUserAgent()
+	return _u
+}
+
+// SetIP sets the "ip" field.
+func (_u *ImpersonationUpdate) SetIP(v string) *ImpersonationUpdate {
+	_u.mutation.SetIP(v)
+	return _u
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_u *ImpersonationUpdate) SetNillableIP(v *string) *ImpersonationUpdate {
+	if v != nil {
+		_u.SetIP(*v)
+	}
+	return _u
+}
+
+// ClearIP clears the value of the "ip" field.
+func (_u *ImpersonationUpdate) ClearIP() *ImpersonationUpdate {
+	_u.mutation.ClearIP()
+	return _u
+}
+
+// Mutation returns the ImpersonationMutation object of the builder.
+func (_u *ImpersonationUpdate) Mutation() *ImpersonationMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ImpersonationUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ImpersonationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ImpersonationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ImpersonationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ImpersonationUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if impersonation.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized impersonation.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := impersonation.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ImpersonationUpdate) check() error {
+	if v, ok := _u.mutation.AdminID(); ok {
+		if err := impersonation.AdminIDValidator(v); err != nil {
+			return &ValidationError{Name: "admin_id", err: fmt.Errorf(`ent: validator failed for field "Impersonation.admin_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := impersonation.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Impersonation.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := impersonation.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "Impersonation.reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FamilyID(); ok {
+		if err := impersonation.FamilyIDValidator(v); err != nil {
+			return &ValidationError{Name: "family_id", err: fmt.Errorf(`ent: validator failed for field "Impersonation.family_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserAgent(); ok {
+		if err := impersonation.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "Impersonation.user_agent": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IP(); ok {
+		if err := impersonation.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "Impersonation.ip": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ImpersonationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(impersonation.Table, impersonation.Columns, sqlgraph.NewFieldSpec(impersonation.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(impersonation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(impersonation.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(impersonation.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AdminID(); ok {
+		_spec.SetField(impersonation.FieldAdminID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(impersonation.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(impersonation.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FamilyID(); ok {
+		_spec.SetField(impersonation.FieldFamilyID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(impersonation.FieldStartedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(impersonation.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndedAt(); ok {
+		_spec.SetField(impersonation.FieldEndedAt, field.TypeTime, value)
+	}
+	if _u.mutation.EndedAtCleared() {
+		_spec.ClearField(impersonation.FieldEndedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UserAgent(); ok {
+		_spec.SetField(impersonation.FieldUserAgent, field.TypeString, value)
+	}
+	if _u.mutation.UserAgentCleared() {
+		_spec.ClearField(impersonation.FieldUserAgent, field.TypeString)
+	}
+	if value, ok := _u.mutation.IP(); ok {
+		_spec.SetField(impersonation.FieldIP, field.TypeString, value)
+	}
+	if _u.mutation.IPCleared() {
+		_spec.ClearField(impersonation.FieldIP, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{impersonation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ImpersonationUpdateOne is the builder for updating a single Impersonation entity.
+type ImpersonationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ImpersonationMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ImpersonationUpdateOne) SetUpdatedAt(v time.Time) *ImpersonationUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ImpersonationUpdateOne) SetDeletedAt(v int64) *ImpersonationUpdateOne {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ImpersonationUpdateOne) SetNillableDeletedAt(v *int64) *ImpersonationUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *ImpersonationUpdateOne) AddDeletedAt(v int64) *ImpersonationUpdateOne {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// SetAdminID sets the "admin_id" field.
+func (_u *ImpersonationUpdateOne) SetAdminID(v string) *ImpersonationUpdateOne {
+	_u.mutation.SetAdminID(v)
+	return _u
+}
+
+// SetNillableAdminID sets the "admin_id" field if the given value is not nil.
+func (_u *ImpersonationUpdateOne) SetNillableAdminID(v *string) *ImpersonationUpdateOne {
+	if v != nil {
+		_u.SetAdminID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ImpersonationUpdateOne) SetUserID(v string) *ImpersonationUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ImpersonationUpdateOne) SetNillableUserID(v *string) *ImpersonationUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *ImpersonationUpdateOne) SetReason(v string) *ImpersonationUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *ImpersonationUpdateOne) SetNillableReason(v *string) *ImpersonationUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetFamilyID sets the "family_id" field.
+func (_u *ImpersonationUpdateOne) SetFamilyID(v string) *ImpersonationUpdateOne {
+	_u.mutation.SetFamilyID(v)
+	return _u
+}
+
+// SetNillableFamilyID sets the "family_id" field if the given value is not nil.
+func (_u *ImpersonationUpdateOne) SetNillableFamilyID(v *string) *ImpersonationUpdateOne {
+	if v != nil {
+		_u.SetFamilyID(*v)
+	}
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *ImpersonationUpdateOne) SetStartedAt(v time.Time) *ImpersonationUpdateOne {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *ImpersonationUpdateOne) SetNillableStartedAt(v *time.Time) *ImpersonationUpdateOne {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *ImpersonationUpdateOne) SetExpiresAt(v time.Time) *ImpersonationUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *ImpersonationUpdateOne) SetNillableExpiresAt(v *time.Time) *ImpersonationUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (_u *ImpersonationUpdateOne) SetEndedAt(v time.Time) *ImpersonationUpdateOne {
+	_u.mutation.SetEndedAt(v)
+	return _u
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (_u *ImpersonationUpdateOne) SetNillableEndedAt(v *time.Time) *ImpersonationUpdateOne {
+	if v != nil {
+		_u.SetEndedAt(*v)
+	}
+	return _u
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (_u *ImpersonationUpdateOne) ClearEndedAt() *ImpersonationUpdateOne {
+	_u.mutation.ClearEndedAt()
+	return _u
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_u *ImpersonationUpdateOne) SetUserAgent(v string) *ImpersonationUpdateOne {
+	_u.mutation.SetUserAgent(v)
+	return _u
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_u *ImpersonationUpdateOne) SetNillableUserAgent(v *string) *ImpersonationUpdateOne {
+	if v != nil {
+		_u.SetUserAgent(*v)
+	}
+	return _u
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (_u *ImpersonationUpdateOne) ClearUserAgent() *ImpersonationUpdateOne {
+	_u.mutation.ClearUserAgent()
+	return _u
+}
+
+// SetIP sets the "ip" field.
+func (_u *ImpersonationUpdateOne) SetIP(v string) *ImpersonationUpdateOne {
+	_u.mutation.SetIP(v)
+	return _u
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_u *ImpersonationUpdateOne) SetNillableIP(v *string) *ImpersonationUpdateOne {
+	if v != nil {
+		_u.SetIP(*v)
+	}
+	return _u
+}
+
+// ClearIP clears the value of the "ip" field.
+func (_u *ImpersonationUpdateOne) ClearIP() *ImpersonationUpdateOne {
+	_u.mutation.ClearIP()
+	return _u
+}
+
+// Mutation returns the ImpersonationMutation object of the builder.
+func (_u *ImpersonationUpdateOne) Mutation() *ImpersonationMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ImpersonationUpdate builder.
+func (_u *ImpersonationUpdateOne) Where(ps ...predicate.Impersonation) *ImpersonationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ImpersonationUpdateOne) Select(field string, fields ...string) *ImpersonationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Impersonation entity.
+func (_u *ImpersonationUpdateOne) Save(ctx context.Context) (*Impersonation, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ImpersonationUpdateOne) SaveX(ctx context.Context) *Impersonation {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ImpersonationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ImpersonationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ImpersonationUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if impersonation.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized impersonation.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := impersonation.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ImpersonationUpdateOne) check() error {
+	if v, ok := _u.mutation.AdminID(); ok {
+		if err := impersonation.AdminIDValidator(v); err != nil {
+			return &ValidationError{Name: "admin_id", err: fmt.Errorf(`ent: validator failed for field "Impersonation.admin_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := impersonation.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Impersonation.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := impersonation.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "Impersonation.reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FamilyID(); ok {
+		if err := impersonation.FamilyIDValidator(v); err != nil {
+			return &ValidationError{Name: "family_id", err: fmt.Errorf(`ent: validator failed for field "Impersonation.family_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserAgent(); ok {
+		if err := impersonation.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "Impersonation.user_agent": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IP(); ok {
+		if err := impersonation.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "Impersonation.ip": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ImpersonationUpdateOne) sqlSave(ctx context.Context) (_node *Impersonation, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(impersonation.Table, impersonation.Columns, sqlgraph.NewFieldSpec(impersonation.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Impersonation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, impersonation.FieldID)
+		for _, f := range fields {
+			if !impersonation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != impersonation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(impersonation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(impersonation.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(impersonation.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AdminID(); ok {
+		_spec.SetField(impersonation.FieldAdminID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(impersonation.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(impersonation.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FamilyID(); ok {
+		_spec.SetField(impersonation.FieldFamilyID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(impersonation.FieldStartedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(impersonation.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndedAt(); ok {
+		_spec.SetField(impersonation.FieldEndedAt, field.TypeTime, value)
+	}
+	if _u.mutation.EndedAtCleared() {
+		_spec.ClearField(impersonation.FieldEndedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UserAgent(); ok {
+		_spec.SetField(impersonation.FieldUserAgent, field.TypeString, value)
+	}
+	if _u.mutation.UserAgentCleared() {
+		_spec.ClearField(impersonation.FieldUserAgent, field.TypeString)
+	}
+	if value, ok := _u.mutation.IP(); ok {
+		_spec.SetField(impersonation.FieldIP, field.TypeString, value)
+	}
+	if _u.mutation.IPCleared() {
+		_spec.ClearField(impersonation.FieldIP, field.TypeString)
+	}
+	_node = &Impersonation{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{impersonation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
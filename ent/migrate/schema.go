@@ -8,18 +8,630 @@ import (
 )
 
 var (
+	// IdentitiesColumns holds the columns for the "identities" table.
+	IdentitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 26},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeInt64, Default: 0},
+		{Name: "provider", Type: field.TypeString, Size: 64},
+		{Name: "subject", Type: field.TypeString, Size: 255},
+		{Name: "email", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "last_login_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeString, Size: 26},
+	}
+	// IdentitiesTable holds the schema information for the "identities" table.
+	IdentitiesTable = &schema.Table{
+		Name:       "identities",
+		Columns:    IdentitiesColumns,
+		PrimaryKey: []*schema.Column{IdentitiesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "identities_users_identities",
+				Columns:    []*schema.Column{IdentitiesColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "identity_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{IdentitiesColumns[3]},
+			},
+			{
+				Name:    "identity_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{IdentitiesColumns[1]},
+			},
+			{
+				Name:    "identity_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{IdentitiesColumns[2]},
+			},
+			{
+				Name:    "identity_provider_subject_deleted_at",
+				Unique:  true,
+				Columns: []*schema.Column{IdentitiesColumns[4], IdentitiesColumns[5], IdentitiesColumns[3]},
+			},
+			{
+				Name:    "identity_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{IdentitiesColumns[8]},
+			},
+		},
+	}
+	// ImpersonationsColumns holds the columns for the "impersonations" table.
+	ImpersonationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 26},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeInt64, Default: 0},
+		{Name: "admin_id", Type: field.TypeString, Size: 26},
+		{Name: "user_id", Type: field.TypeString, Size: 26},
+		{Name: "reason", Type: field.TypeString, Size: 500},
+		{Name: "family_id", Type: field.TypeString, Size: 26},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "ended_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true, Size: 512},
+		{Name: "ip", Type: field.TypeString, Nullable: true, Size: 64},
+	}
+	// ImpersonationsTable holds the schema information for the "impersonations" table.
+	ImpersonationsTable = &schema.Table{
+		Name:       "impersonations",
+		Columns:    ImpersonationsColumns,
+		PrimaryKey: []*schema.Column{ImpersonationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "impersonation_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{ImpersonationsColumns[3]},
+			},
+			{
+				Name:    "impersonation_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ImpersonationsColumns[1]},
+			},
+			{
+				Name:    "impersonation_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{ImpersonationsColumns[2]},
+			},
+			{
+				Name:    "impersonation_admin_id_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{ImpersonationsColumns[4], ImpersonationsColumns[8]},
+			},
+			{
+				Name:    "impersonation_user_id_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{ImpersonationsColumns[5], ImpersonationsColumns[8]},
+			},
+			{
+				Name:    "impersonation_family_id",
+				Unique:  false,
+				Columns: []*schema.Column{ImpersonationsColumns[7]},
+			},
+		},
+	}
+	// OauthClientsColumns holds the columns for the "oauth_clients" table.
+	OauthClientsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 26},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeInt64, Default: 0},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "secret_hash", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "redirect_uris", Type: field.TypeJSON, Nullable: true},
+		{Name: "grant_types", Type: field.TypeJSON},
+		{Name: "scopes", Type: field.TypeJSON},
+		{Name: "user_id", Type: field.TypeString, Size: 26},
+	}
+	// OauthClientsTable holds the schema information for the "oauth_clients" table.
+	OauthClientsTable = &schema.Table{
+		Name:       "oauth_clients",
+		Columns:    OauthClientsColumns,
+		PrimaryKey: []*schema.Column{OauthClientsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "oauth_clients_users_oauth_clients",
+				Columns:    []*schema.Column{OauthClientsColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "oauthclient_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{OauthClientsColumns[3]},
+			},
+			{
+				Name:    "oauthclient_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{OauthClientsColumns[1]},
+			},
+			{
+				Name:    "oauthclient_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{OauthClientsColumns[2]},
+			},
+			{
+				Name:    "oauthclient_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{OauthClientsColumns[9]},
+			},
+		},
+	}
+	// OauthCodesColumns holds the columns for the "oauth_codes" table.
+	OauthCodesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 26},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeInt64, Default: 0},
+		{Name: "client_id", Type: field.TypeString, Size: 26},
+		{Name: "user_id", Type: field.TypeString, Size: 26},
+		{Name: "code_hash", Type: field.TypeString, Size: 64},
+		{Name: "redirect_uri", Type: field.TypeString, Size: 2048},
+		{Name: "scope", Type: field.TypeString, Nullable: true, Size: 1024},
+		{Name: "code_challenge", Type: field.TypeString, Size: 128},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "family_id", Type: field.TypeString, Nullable: true, Size: 26},
+	}
+	// OauthCodesTable holds the schema information for the "oauth_codes" table.
+	OauthCodesTable = &schema.Table{
+		Name:       "oauth_codes",
+		Columns:    OauthCodesColumns,
+		PrimaryKey: []*schema.Column{OauthCodesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "oauthcode_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{OauthCodesColumns[3]},
+			},
+			{
+				Name:    "oauthcode_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{OauthCodesColumns[1]},
+			},
+			{
+				Name:    "oauthcode_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{OauthCodesColumns[2]},
+			},
+			{
+				Name:    "oauthcode_code_hash_deleted_at",
+				Unique:  true,
+				Columns: []*schema.Column{OauthCodesColumns[6], OauthCodesColumns[3]},
+			},
+			{
+				Name:    "oauthcode_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{OauthCodesColumns[10]},
+			},
+		},
+	}
+	// OidcStatesColumns holds the columns for the "oidc_states" table.
+	OidcStatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 26},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeInt64, Default: 0},
+		{Name: "provider", Type: field.TypeString, Size: 64},
+		{Name: "state_hash", Type: field.TypeString, Size: 64},
+		{Name: "nonce", Type: field.TypeString, Size: 64},
+		{Name: "code_verifier", Type: field.TypeString, Size: 128},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+	}
+	// OidcStatesTable holds the schema information for the "oidc_states" table.
+	OidcStatesTable = &schema.Table{
+		Name:       "oidc_states",
+		Columns:    OidcStatesColumns,
+		PrimaryKey: []*schema.Column{OidcStatesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "oidcstate_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{OidcStatesColumns[3]},
+			},
+			{
+				Name:    "oidcstate_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{OidcStatesColumns[1]},
+			},
+			{
+				Name:    "oidcstate_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{OidcStatesColumns[2]},
+			},
+			{
+				Name:    "oidcstate_state_hash_deleted_at",
+				Unique:  true,
+				Columns: []*schema.Column{OidcStatesColumns[5], OidcStatesColumns[3]},
+			},
+			{
+				Name:    "oidcstate_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{OidcStatesColumns[8]},
+			},
+		},
+	}
+	// OneTimeTokensColumns holds the columns for the "one_time_tokens" table.
+	OneTimeTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 26},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeInt64, Default: 0},
+		{Name: "purpose", Type: field.TypeEnum, Enums: []string{"verify_email", "reset_password", "mfa_challenge", "magic_link", "change_email", "revert_email", "oidc_login"}},
+		{Name: "token_hash", Type: field.TypeString, Size: 64},
+		{Name: "nonce_hash", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "email", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeString, Size: 26},
+	}
+	// OneTimeTokensTable holds the schema information for the "one_time_tokens" table.
+	OneTimeTokensTable = &schema.Table{
+		Name:       "one_time_tokens",
+		Columns:    OneTimeTokensColumns,
+		PrimaryKey: []*schema.Column{OneTimeTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "one_time_tokens_users_one_time_tokens",
+				Columns:    []*schema.Column{OneTimeTokensColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "onetimetoken_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{OneTimeTokensColumns[3]},
+			},
+			{
+				Name:    "onetimetoken_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{OneTimeTokensColumns[1]},
+			},
+			{
+				Name:    "onetimetoken_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{OneTimeTokensColumns[2]},
+			},
+			{
+				Name:    "onetimetoken_token_hash_deleted_at",
+				Unique:  true,
+				Columns: []*schema.Column{OneTimeTokensColumns[5], OneTimeTokensColumns[3]},
+			},
+			{
+				Name:    "onetimetoken_user_id_purpose",
+				Unique:  false,
+				Columns: []*schema.Column{OneTimeTokensColumns[10], OneTimeTokensColumns[4]},
+			},
+			{
+				Name:    "onetimetoken_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{OneTimeTokensColumns[8]},
+			},
+		},
+	}
+	// PasskeysColumns holds the columns for the "passkeys" table.
+	PasskeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 26},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeInt64, Default: 0},
+		{Name: "name", Type: field.TypeString, Size: 64},
+		{Name: "credential_id", Type: field.TypeString, Size: 1366},
+		{Name: "public_key", Type: field.TypeBytes},
+		{Name: "attestation_type", Type: field.TypeString, Nullable: true, Size: 32},
+		{Name: "aaguid", Type: field.TypeBytes, Nullable: true},
+		{Name: "transports", Type: field.TypeJSON, Nullable: true},
+		{Name: "sign_count", Type: field.TypeUint32, Default: 0},
+		{Name: "backup_eligible", Type: field.TypeBool, Default: false},
+		{Name: "backup_state", Type: field.TypeBool, Default: false},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeString, Size: 26},
+	}
+	// PasskeysTable holds the schema information for the "passkeys" table.
+	PasskeysTable = &schema.Table{
+		Name:       "passkeys",
+		Columns:    PasskeysColumns,
+		PrimaryKey: []*schema.Column{PasskeysColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "passkeys_users_passkeys",
+				Columns:    []*schema.Column{PasskeysColumns[14]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "passkey_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{PasskeysColumns[3]},
+			},
+			{
+				Name:    "passkey_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{PasskeysColumns[1]},
+			},
+			{
+				Name:    "passkey_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{PasskeysColumns[2]},
+			},
+			{
+				Name:    "passkey_credential_id_deleted_at",
+				Unique:  true,
+				Columns: []*schema.Column{PasskeysColumns[5], PasskeysColumns[3]},
+			},
+			{
+				Name:    "passkey_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{PasskeysColumns[14]},
+			},
+		},
+	}
+	// PasskeySessionsColumns holds the columns for the "passkey_sessions" table.
+	PasskeySessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 26},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeInt64, Default: 0},
+		{Name: "ceremony", Type: field.TypeEnum, Enums: []string{"registration", "login"}},
+		{Name: "user_id", Type: field.TypeString, Nullable: true, Size: 26},
+		{Name: "token_hash", Type: field.TypeString, Size: 64},
+		{Name: "data", Type: field.TypeBytes},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+	}
+	// PasskeySessionsTable holds the schema information for the "passkey_sessions" table.
+	PasskeySessionsTable = &schema.Table{
+		Name:       "passkey_sessions",
+		Columns:    PasskeySessionsColumns,
+		PrimaryKey: []*schema.Column{PasskeySessionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "passkeysession_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{PasskeySessionsColumns[3]},
+			},
+			{
+				Name:    "passkeysession_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{PasskeySessionsColumns[1]},
+			},
+			{
+				Name:    "passkeysession_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{PasskeySessionsColumns[2]},
+			},
+			{
+				Name:    "passkeysession_token_hash_deleted_at",
+				Unique:  true,
+				Columns: []*schema.Column{PasskeySessionsColumns[6], PasskeySessionsColumns[3]},
+			},
+			{
+				Name:    "passkeysession_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{PasskeySessionsColumns[8]},
+			},
+		},
+	}
+	// PasswordHistoriesColumns holds the columns for the "password_histories" table.
+	PasswordHistoriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 26},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeInt64, Default: 0},
+		{Name: "password_hash", Type: field.TypeString, Size: 255},
+		{Name: "user_id", Type: field.TypeString, Size: 26},
+	}
+	// PasswordHistoriesTable holds the schema information for the "password_histories" table.
+	PasswordHistoriesTable = &schema.Table{
+		Name:       "password_histories",
+		Columns:    PasswordHistoriesColumns,
+		PrimaryKey: []*schema.Column{PasswordHistoriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "password_histories_users_password_histories",
+				Columns:    []*schema.Column{PasswordHistoriesColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "passwordhistory_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{PasswordHistoriesColumns[3]},
+			},
+			{
+				Name:    "passwordhistory_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{PasswordHistoriesColumns[1]},
+			},
+			{
+				Name:    "passwordhistory_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{PasswordHistoriesColumns[2]},
+			},
+			{
+				Name:    "passwordhistory_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{PasswordHistoriesColumns[5], PasswordHistoriesColumns[1]},
+			},
+		},
+	}
+	// PersonalAccessTokensColumns holds the columns for the "personal_access_tokens" table.
+	PersonalAccessTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 26},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeInt64, Default: 0},
+		{Name: "name", Type: field.TypeString, Size: 64},
+		{Name: "token_hash", Type: field.TypeString, Size: 64},
+		{Name: "token_prefix", Type: field.TypeString, Size: 16},
+		{Name: "scopes", Type: field.TypeJSON},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeString, Size: 26},
+	}
+	// PersonalAccessTokensTable holds the schema information for the "personal_access_tokens" table.
+	PersonalAccessTokensTable = &schema.Table{
+		Name:       "personal_access_tokens",
+		Columns:    PersonalAccessTokensColumns,
+		PrimaryKey: []*schema.Column{PersonalAccessTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "personal_access_tokens_users_personal_access_tokens",
+				Columns:    []*schema.Column{PersonalAccessTokensColumns[11]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "personalaccesstoken_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{PersonalAccessTokensColumns[3]},
+			},
+			{
+				Name:    "personalaccesstoken_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{PersonalAccessTokensColumns[1]},
+			},
+			{
+				Name:    "personalaccesstoken_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{PersonalAccessTokensColumns[2]},
+			},
+			{
+				Name:    "personalaccesstoken_token_hash_deleted_at",
+				Unique:  true,
+				Columns: []*schema.Column{PersonalAccessTokensColumns[5], PersonalAccessTokensColumns[3]},
+			},
+			{
+				Name:    "personalaccesstoken_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{PersonalAccessTokensColumns[11]},
+			},
+		},
+	}
+	// RecoveryCodesColumns holds the columns for the "recovery_codes" table.
+	RecoveryCodesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 26},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeInt64, Default: 0},
+		{Name: "code_hash", Type: field.TypeString, Size: 64},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeString, Size: 26},
+	}
+	// RecoveryCodesTable holds the schema information for the "recovery_codes" table.
+	RecoveryCodesTable = &schema.Table{
+		Name:       "recovery_codes",
+		Columns:    RecoveryCodesColumns,
+		PrimaryKey: []*schema.Column{RecoveryCodesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "recovery_codes_users_recovery_codes",
+				Columns:    []*schema.Column{RecoveryCodesColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "recoverycode_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{RecoveryCodesColumns[3]},
+			},
+			{
+				Name:    "recoverycode_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{RecoveryCodesColumns[1]},
+			},
+			{
+				Name:    "recoverycode_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{RecoveryCodesColumns[2]},
+			},
+			{
+				Name:    "recoverycode_user_id_code_hash",
+				Unique:  false,
+				Columns: []*schema.Column{RecoveryCodesColumns[6], RecoveryCodesColumns[4]},
+			},
+		},
+	}
+	// SecurityEventsColumns holds the columns for the "security_events" table.
+	SecurityEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 26},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeInt64, Default: 0},
+		{Name: "user_id", Type: field.TypeString, Size: 26},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"login_succeeded", "login_failed", "token_refreshed", "logout", "password_changed", "email_change_requested", "email_changed", "email_change_reverted", "session_revoked", "two_factor_enabled", "two_factor_disabled", "account_deleted"}},
+		{Name: "detail", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "ip", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true, Size: 512},
+		{Name: "request_id", Type: field.TypeString, Nullable: true, Size: 64},
+	}
+	// SecurityEventsTable holds the schema information for the "security_events" table.
+	SecurityEventsTable = &schema.Table{
+		Name:       "security_events",
+		Columns:    SecurityEventsColumns,
+		PrimaryKey: []*schema.Column{SecurityEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "securityevent_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{SecurityEventsColumns[3]},
+			},
+			{
+				Name:    "securityevent_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{SecurityEventsColumns[1]},
+			},
+			{
+				Name:    "securityevent_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{SecurityEventsColumns[2]},
+			},
+			{
+				Name:    "securityevent_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{SecurityEventsColumns[4], SecurityEventsColumns[1]},
+			},
+		},
+	}
 	// TokensColumns holds the columns for the "tokens" table.
 	TokensColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true, Size: 26},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "deleted_at", Type: field.TypeInt64, Default: 0},
-		{Name: "token", Type: field.TypeString, Size: 1000},
+		{Name: "token_hash", Type: field.TypeString, Size: 64},
 		{Name: "type", Type: field.TypeEnum, Enums: []string{"access", "refresh"}},
 		{Name: "expires_at", Type: field.TypeTime},
 		{Name: "is_revoked", Type: field.TypeBool, Default: false},
 		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
-		{Name: "user_id", Type: field.TypeString, Size: 26},
+		{Name: "family_id", Type: field.TypeString, Nullable: true, Size: 26},
+		{Name: "rotated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "client_id", Type: field.TypeString, Nullable: true, Size: 26},
+		{Name: "scope", Type: field.TypeString, Nullable: true, Size: 1024},
+		{Name: "auth_time", Type: field.TypeTime, Nullable: true},
+		{Name: "impersonator_id", Type: field.TypeString, Nullable: true, Size: 26},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true, Size: 512},
+		{Name: "ip", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "user_id", Type: field.TypeString, Nullable: true, Size: 26},
 	}
 	// TokensTable holds the schema information for the "tokens" table.
 	TokensTable = &schema.Table{
@@ -29,9 +641,9 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "tokens_users_tokens",
-				Columns:    []*schema.Column{TokensColumns[9]},
+				Columns:    []*schema.Column{TokensColumns[17]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
-				OnDelete:   schema.NoAction,
+				OnDelete:   schema.SetNull,
 			},
 		},
 		Indexes: []*schema.Index{
@@ -51,19 +663,19 @@ var (
 				Columns: []*schema.Column{TokensColumns[2]},
 			},
 			{
-				Name:    "token_token_deleted_at",
+				Name:    "token_token_hash_deleted_at",
 				Unique:  true,
 				Columns: []*schema.Column{TokensColumns[4], TokensColumns[3]},
 			},
 			{
 				Name:    "token_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{TokensColumns[9]},
+				Columns: []*schema.Column{TokensColumns[17]},
 			},
 			{
 				Name:    "token_user_id_type",
 				Unique:  false,
-				Columns: []*schema.Column{TokensColumns[9], TokensColumns[5]},
+				Columns: []*schema.Column{TokensColumns[17], TokensColumns[5]},
 			},
 			{
 				Name:    "token_expires_at",
@@ -83,7 +695,17 @@ var (
 			{
 				Name:    "token_user_id_deleted_at",
 				Unique:  false,
-				Columns: []*schema.Column{TokensColumns[9], TokensColumns[3]},
+				Columns: []*schema.Column{TokensColumns[17], TokensColumns[3]},
+			},
+			{
+				Name:    "token_family_id",
+				Unique:  false,
+				Columns: []*schema.Column{TokensColumns[9]},
+			},
+			{
+				Name:    "token_client_id",
+				Unique:  false,
+				Columns: []*schema.Column{TokensColumns[11]},
 			},
 		},
 	}
@@ -95,9 +717,14 @@ var (
 		{Name: "deleted_at", Type: field.TypeInt64, Default: 0},
 		{Name: "username", Type: field.TypeString, Size: 50},
 		{Name: "email", Type: field.TypeString, Size: 255},
+		{Name: "pending_email", Type: field.TypeString, Nullable: true, Size: 255},
 		{Name: "password_hash", Type: field.TypeString, Size: 255},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"active", "inactive", "suspended"}, Default: "active"},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"user", "admin"}, Default: "user"},
 		{Name: "last_login_at", Type: field.TypeTime, Nullable: true},
+		{Name: "totp_secret", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "two_factor_enabled", Type: field.TypeBool, Default: false},
+		{Name: "totp_last_step", Type: field.TypeInt64, Default: 0},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
@@ -133,7 +760,7 @@ var (
 			{
 				Name:    "user_status",
 				Unique:  false,
-				Columns: []*schema.Column{UsersColumns[7]},
+				Columns: []*schema.Column{UsersColumns[8]},
 			},
 			{
 				Name:    "user_email",
@@ -149,11 +776,30 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		IdentitiesTable,
+		ImpersonationsTable,
+		OauthClientsTable,
+		OauthCodesTable,
+		OidcStatesTable,
+		OneTimeTokensTable,
+		PasskeysTable,
+		PasskeySessionsTable,
+		PasswordHistoriesTable,
+		PersonalAccessTokensTable,
+		RecoveryCodesTable,
+		SecurityEventsTable,
 		TokensTable,
 		UsersTable,
 	}
 )
 
 func init() {
+	IdentitiesTable.ForeignKeys[0].RefTable = UsersTable
+	OauthClientsTable.ForeignKeys[0].RefTable = UsersTable
+	OneTimeTokensTable.ForeignKeys[0].RefTable = UsersTable
+	PasskeysTable.ForeignKeys[0].RefTable = UsersTable
+	PasswordHistoriesTable.ForeignKeys[0].RefTable = UsersTable
+	PersonalAccessTokensTable.ForeignKeys[0].RefTable = UsersTable
+	RecoveryCodesTable.ForeignKeys[0].RefTable = UsersTable
 	TokensTable.ForeignKeys[0].RefTable = UsersTable
 }
@@ -0,0 +1,664 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/liukeshao/echo-template/ent/oauthclient"
+	"github.com/liukeshao/echo-template/ent/predicate"
+	"github.com/liukeshao/echo-template/ent/user"
+)
+
+// OAuthClientUpdate is the builder for updating OAuthClient entities.
+type OAuthClientUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OAuthClientMutation
+}
+
+// Where appends a list predicates to the OAuthClientUpdate builder.
+func (_u *OAuthClientUpdate) Where(ps ...predicate.OAuthClient) *OAuthClientUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OAuthClientUpdate) SetUpdatedAt(v time.Time) *OAuthClientUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *OAuthClientUpdate) SetDeletedAt(v int64) *OAuthClientUpdate {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *OAuthClientUpdate) SetNillableDeletedAt(v *int64) *OAuthClientUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *OAuthClientUpdate) AddDeletedAt(v int64) *OAuthClientUpdate {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *OAuthClientUpdate) SetUserID(v string) *OAuthClientUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *OAuthClientUpdate) SetNillableUserID(v *string) *OAuthClientUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *OAuthClientUpdate) SetName(v string) *OAuthClientUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *OAuthClientUpdate) SetNillableName(v *string) *OAuthClientUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetSecretHash sets the "secret_hash" field.
+func (_u *OAuthClientUpdate) SetSecretHash(v string) *OAuthClientUpdate {
+	_u.mutation.SetSecretHash(v)
+	return _u
+}
+
+// SetNillableSecretHash sets the "secret_hash" field if the given value is not nil.
+func (_u *OAuthClientUpdate) SetNillableSecretHash(v *string) *OAuthClientUpdate {
+	if v != nil {
+		_u.SetSecretHash(*v)
+	}
+	return _u
+}
+
+// ClearSecretHash clears the value of the "secret_hash" field.
+func (_u *OAuthClientUpdate) ClearSecretHash() *OAuthClientUpdate {
+	_u.mutation.ClearSecretHash()
+	return _u
+}
+
+// SetRedirectUris sets the "redirect_uris" field.
+func (_u *OAuthClientUpdate) SetRedirectUris(v []string) *OAuthClientUpdate {
+	_u.mutation.SetRedirectUris(v)
+	return _u
+}
+
+// AppendRedirectUris appends value to the "redirect_uris" field.
+func (_u *OAuthClientUpdate) AppendRedirectUris(v []string) *OAuthClientUpdate {
+	_u.mutation.AppendRedirectUris(v)
+	return _u
+}
+
+// ClearRedirectUris clears the value of the "redirect_uris" field.
+func (_u *OAuthClientUpdate) ClearRedirectUris() *OAuthClientUpdate {
+	_u.mutation.ClearRedirectUris()
+	return _u
+}
+
+// SetGrantTypes sets the "grant_types" field.
+func (_u *OAuthClientUpdate) SetGrantTypes(v []string) *OAuthClientUpdate {
+	_u.mutation.SetGrantTypes(v)
+	return _u
+}
+
+// AppendGrantTypes appends value to the "grant_types" field.
+func (_u *OAuthClientUpdate) AppendGrantTypes(v []string) *OAuthClientUpdate {
+	_u.mutation.AppendGrantTypes(v)
+	return _u
+}
+
+// SetScopes sets the "scopes" field.
+func (_u *OAuthClientUpdate) SetScopes(v []string) *OAuthClientUpdate {
+	_u.mutation.SetScopes(v)
+	return _u
+}
+
+// AppendScopes appends value to the "scopes" field.
+func (_u *OAuthClientUpdate) AppendScopes(v []string) *OAuthClientUpdate {
+	_u.mutation.AppendScopes(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *OAuthClientUpdate) SetUser(v *User) *OAuthClientUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the OAuthClientMutation object of the builder.
+func (_u *OAuthClientUpdate) Mutation() *OAuthClientMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *OAuthClientUpdate) ClearUser() *OAuthClientUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *OAuthClientUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OAuthClientUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *OAuthClientUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OAuthClientUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OAuthClientUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if oauthclient.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized oauthclient.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := oauthclient.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OAuthClientUpdate) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := oauthclient.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "OAuthClient.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := oauthclient.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "OAuthClient.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SecretHash(); ok {
+		if err := oauthclient.SecretHashValidator(v); err != nil {
+			return &ValidationError{Name: "secret_hash", err: fmt.Errorf(`ent: validator failed for field "OAuthClient.secret_hash": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OAuthClient.user"`)
+	}
+	return nil
+}
+
+func (_u *OAuthClientUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oauthclient.Table, oauthclient.Columns, sqlgraph.NewFieldSpec(oauthclient.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(oauthclient.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(oauthclient.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(oauthclient.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(oauthclient.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SecretHash(); ok {
+		_spec.SetField(oauthclient.FieldSecretHash, field.TypeString, value)
+	}
+	if _u.mutation.SecretHashCleared() {
+		_spec.ClearField(oauthclient.FieldSecretHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.RedirectUris(); ok {
+		_spec.SetField(oauthclient.FieldRedirectUris, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRedirectUris(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthclient.FieldRedirectUris, value)
+		})
+	}
+	if _u.mutation.RedirectUrisCleared() {
+		_spec.ClearField(oauthclient.FieldRedirectUris, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.GrantTypes(); ok {
+		_spec.SetField(oauthclient.FieldGrantTypes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedGrantTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthclient.FieldGrantTypes, value)
+		})
+	}
+	if value, ok := _u.mutation.Scopes(); ok {
+		_spec.SetField(oauthclient.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthclient.FieldScopes, value)
+		})
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   oauthclient.UserTable,
+			Columns: []string{oauthclient.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   oauthclient.UserTable,
+			Columns: []string{oauthclient.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oauthclient.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// OAuthClientUpdateOne is the builder for updating a single OAuthClient entity.
+type OAuthClientUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OAuthClientMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OAuthClientUpdateOne) SetUpdatedAt(v time.Time) *OAuthClientUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *OAuthClientUpdateOne) SetDeletedAt(v int64) *OAuthClientUpdateOne {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *OAuthClientUpdateOne) SetNillableDeletedAt(v *int64) *OAuthClientUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *OAuthClientUpdateOne) AddDeletedAt(v int64) *OAuthClientUpdateOne {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *OAuthClientUpdateOne) SetUserID(v string) *OAuthClientUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *OAuthClientUpdateOne) SetNillableUserID(v *string) *OAuthClientUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *OAuthClientUpdateOne) SetName(v string) *OAuthClientUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *OAuthClientUpdateOne) SetNillableName(v *string) *OAuthClientUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetSecretHash sets the "secret_hash" field.
+func (_u *OAuthClientUpdateOne) SetSecretHash(v string) *OAuthClientUpdateOne {
+	_u.mutation.SetSecretHash(v)
+	return _u
+}
+
+// SetNillableSecretHash sets the "secret_hash" field if the given value is not nil.
+func (_u *OAuthClientUpdateOne) SetNillableSecretHash(v *string) *OAuthClientUpdateOne {
+	if v != nil {
+		_u.SetSecretHash(*v)
+	}
+	return _u
+}
+
+// ClearSecretHash clears the value of the "secret_hash" field.
+func (_u *OAuthClientUpdateOne) ClearSecretHash() *OAuthClientUpdateOne {
+	_u.mutation.ClearSecretHash()
+	return _u
+}
+
+// SetRedirectUris sets the "redirect_uris" field.
+func (_u *OAuthClientUpdateOne) SetRedirectUris(v []string) *OAuthClientUpdateOne {
+	_u.mutation.SetRedirectUris(v)
+	return _u
+}
+
+// AppendRedirectUris appends value to the "redirect_uris" field.
+func (_u *OAuthClientUpdateOne) AppendRedirectUris(v []string) *OAuthClientUpdateOne {
+	_u.mutation.AppendRedirectUris(v)
+	return _u
+}
+
+// ClearRedirectUris clears the value of the "redirect_uris" field.
+func (_u *OAuthClientUpdateOne) ClearRedirectUris() *OAuthClientUpdateOne {
+	_u.mutation.ClearRedirectUris()
+	return _u
+}
+
+// SetGrantTypes sets the "grant_types" field.
+func (_u *OAuthClientUpdateOne) SetGrantTypes(v []string) *OAuthClientUpdateOne {
+	_u.mutation.SetGrantTypes(v)
+	return _u
+}
+
+// AppendGrantTypes appends value to the "grant_types" field.
+func (_u *OAuthClientUpdateOne) AppendGrantTypes(v []string) *OAuthClientUpdateOne {
+	_u.mutation.AppendGrantTypes(v)
+	return _u
+}
+
+// SetScopes sets the "scopes" field.
+func (_u *OAuthClientUpdateOne) SetScopes(v []string) *OAuthClientUpdateOne {
+	_u.mutation.SetScopes(v)
+	return _u
+}
+
+// AppendScopes appends value to the "scopes" field.
+func (_u *OAuthClientUpdateOne) AppendScopes(v []string) *OAuthClientUpdateOne {
+	_u.mutation.AppendScopes(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *OAuthClientUpdateOne) SetUser(v *User) *OAuthClientUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the OAuthClientMutation object of the builder.
+func (_u *OAuthClientUpdateOne) Mutation() *OAuthClientMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *OAuthClientUpdateOne) ClearUser() *OAuthClientUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the OAuthClientUpdate builder.
+func (_u *OAuthClientUpdateOne) Where(ps ...predicate.OAuthClient) *OAuthClientUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *OAuthClientUpdateOne) Select(field string, fields ...string) *OAuthClientUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated OAuthClient entity.
+func (_u *OAuthClientUpdateOne) Save(ctx context.Context) (*OAuthClient, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OAuthClientUpdateOne) SaveX(ctx context.Context) *OAuthClient {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *OAuthClientUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OAuthClientUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OAuthClientUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if oauthclient.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized oauthclient.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := oauthclient.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OAuthClientUpdateOne) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := oauthclient.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "OAuthClient.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := oauthclient.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "OAuthClient.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SecretHash(); ok {
+		if err := oauthclient.SecretHashValidator(v); err != nil {
+			return &ValidationError{Name: "secret_hash", err: fmt.Errorf(`ent: validator failed for field "OAuthClient.secret_hash": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OAuthClient.user"`)
+	}
+	return nil
+}
+
+func (_u *OAuthClientUpdateOne) sqlSave(ctx context.Context) (_node *OAuthClient, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oauthclient.Table, oauthclient.Columns, sqlgraph.NewFieldSpec(oauthclient.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OAuthClient.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, oauthclient.FieldID)
+		for _, f := range fields {
+			if !oauthclient.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != oauthclient.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(oauthclient.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(oauthclient.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(oauthclient.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(oauthclient.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SecretHash(); ok {
+		_spec.SetField(oauthclient.FieldSecretHash, field.TypeString, value)
+	}
+	if _u.mutation.SecretHashCleared() {
+		_spec.ClearField(oauthclient.FieldSecretHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.RedirectUris(); ok {
+		_spec.SetField(oauthclient.FieldRedirectUris, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRedirectUris(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthclient.FieldRedirectUris, value)
+		})
+	}
+	if _u.mutation.RedirectUrisCleared() {
+		_spec.ClearField(oauthclient.FieldRedirectUris, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.GrantTypes(); ok {
+		_spec.SetField(oauthclient.FieldGrantTypes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedGrantTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthclient.FieldGrantTypes, value)
+		})
+	}
+	if value, ok := _u.mutation.Scopes(); ok {
+		_spec.SetField(oauthclient.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthclient.FieldScopes, value)
+		})
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   oauthclient.UserTable,
+			Columns: []string{oauthclient.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   oauthclient.UserTable,
+			Columns: []string{oauthclient.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &OAuthClient{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oauthclient.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
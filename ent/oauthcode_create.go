@@ -0,0 +1,444 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/liukeshao/echo-template/ent/oauthcode"
+)
+
+// OAuthCodeCreate is the builder for creating a OAuthCode entity.
+type OAuthCodeCreate struct {
+	config
+	mutation *OAuthCodeMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *OAuthCodeCreate) SetCreatedAt(v time.Time) *OAuthCodeCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *OAuthCodeCreate) SetNillableCreatedAt(v *time.Time) *OAuthCodeCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *OAuthCodeCreate) SetUpdatedAt(v time.Time) *OAuthCodeCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *OAuthCodeCreate) SetNillableUpdatedAt(v *time.Time) *OAuthCodeCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *OAuthCodeCreate) SetDeletedAt(v int64) *OAuthCodeCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *OAuthCodeCreate) SetNillableDeletedAt(v *int64) *OAuthCodeCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetClientID sets the "client_id" field.
+func (_c *OAuthCodeCreate) SetClientID(v string) *OAuthCodeCreate {
+	_c.mutation.SetClientID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *OAuthCodeCreate) SetUserID(v string) *OAuthCodeCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (_c *OAuthCodeCreate) SetCodeHash(v string) *OAuthCodeCreate {
+	_c.mutation.SetCodeHash(v)
+	return _c
+}
+
+// SetRedirectURI sets the "redirect_uri" field.
+func (_c *OAuthCodeCreate) SetRedirectURI(v string) *OAuthCodeCreate {
+	_c.mutation.SetRedirectURI(v)
+	return _c
+}
+
+// SetScope sets the "scope" field.
+func (_c *OAuthCodeCreate) SetScope(v string) *OAuthCodeCreate {
+	_c.mutation.SetScope(v)
+	return _c
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (_c *OAuthCodeCreate) SetNillableScope(v *string) *OAuthCodeCreate {
+	if v != nil {
+		_c.SetScope(*v)
+	}
+	return _c
+}
+
+// SetCodeChallenge sets the "code_challenge" field.
+func (_c *OAuthCodeCreate) SetCodeChallenge(v string) *OAuthCodeCreate {
+	_c.mutation.SetCodeChallenge(v)
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *OAuthCodeCreate) SetExpiresAt(v time.Time) *OAuthCodeCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetUsedAt sets the "used_at" field.
+func (_c *OAuthCodeCreate) SetUsedAt(v time.Time) *OAuthCodeCreate {
+	_c.mutation.SetUsedAt(v)
+	return _c
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (_c *OAuthCodeCreate) SetNillableUsedAt(v *time.Time) *OAuthCodeCreate {
+	if v != nil {
+		_c.SetUsedAt(*v)
+	}
+	return _c
+}
+
+// SetFamilyID sets the "family_id" field.
+func (_c *OAuthCodeCreate) SetFamilyID(v string) *OAuthCodeCreate {
+	_c.mutation.SetFamilyID(v)
+	return _c
+}
+
+// SetNillableFamilyID sets the "family_id" field if the given value is not nil.
+func (_c *OAuthCodeCreate) SetNillableFamilyID(v *string) *OAuthCodeCreate {
+	if v != nil {
+		_c.SetFamilyID(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *OAuthCodeCreate) SetID(v string) *OAuthCodeCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the OAuthCodeMutation object of the builder.
+func (_c *OAuthCodeCreate) Mutation() *OAuthCodeMutation {
+	return _c.mutation
+}
+
+// Save creates the OAuthCode in the database.
+func (_c *OAuthCodeCreate) Save(ctx context.Context) (*OAuthCode, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *OAuthCodeCreate) SaveX(ctx context.Context) *OAuthCode {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OAuthCodeCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OAuthCodeCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *OAuthCodeCreate) defaults() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if oauthcode.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized oauthcode.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := oauthcode.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if oauthcode.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized oauthcode.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := oauthcode.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.DeletedAt(); !ok {
+		v := oauthcode.DefaultDeletedAt
+		_c.mutation.SetDeletedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *OAuthCodeCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "OAuthCode.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "OAuthCode.updated_at"`)}
+	}
+	if _, ok := _c.mutation.DeletedAt(); !ok {
+		return &ValidationError{Name: "deleted_at", err: errors.New(`ent: missing required field "OAuthCode.deleted_at"`)}
+	}
+	if _, ok := _c.mutation.ClientID(); !ok {
+		return &ValidationError{Name: "client_id", err: errors.New(`ent: missing required field "OAuthCode.client_id"`)}
+	}
+	if v, ok := _c.mutation.ClientID(); ok {
+		if err := oauthcode.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "OAuthCode.client_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "OAuthCode.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := oauthcode.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "OAuthCode.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CodeHash(); !ok {
+		return &ValidationError{Name: "code_hash", err: errors.New(`ent: missing required field "OAuthCode.code_hash"`)}
+	}
+	if v, ok := _c.mutation.CodeHash(); ok {
+		if err := oauthcode.CodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "code_hash", err: fmt.Errorf(`ent: validator failed for field "OAuthCode.code_hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RedirectURI(); !ok {
+		return &ValidationError{Name: "redirect_uri", err: errors.New(`ent: missing required field "OAuthCode.redirect_uri"`)}
+	}
+	if v, ok := _c.mutation.RedirectURI(); ok {
+		if err := oauthcode.RedirectURIValidator(v); err != nil {
+			return &ValidationError{Name: "redirect_uri", err: fmt.Errorf(`ent: validator failed for field "OAuthCode.redirect_uri": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Scope(); ok {
+		if err := oauthcode.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "OAuthCode.scope": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CodeChallenge(); !ok {
+		return &ValidationError{Name: "code_challenge", err: errors.New(`ent: missing required field "OAuthCode.code_challenge"`)}
+	}
+	if v, ok := _c.mutation.CodeChallenge(); ok {
+		if err := oauthcode.CodeChallengeValidator(v); err != nil {
+			return &ValidationError{Name: "code_challenge", err: fmt.Errorf(`ent: validator failed for field "OAuthCode.code_challenge": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "OAuthCode.expires_at"`)}
+	}
+	if v, ok := _c.mutation.FamilyID(); ok {
+		if err := oauthcode.FamilyIDValidator(v); err != nil {
+			return &ValidationError{Name: "family_id", err: fmt.Errorf(`ent: validator failed for field "OAuthCode.family_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := oauthcode.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "OAuthCode.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *OAuthCodeCreate) sqlSave(ctx context.Context) (*OAuthCode, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected OAuthCode.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *OAuthCodeCreate) createSpec() (*OAuthCode, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OAuthCode{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(oauthcode.Table, sqlgraph.NewFieldSpec(oauthcode.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(oauthcode.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(oauthcode.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(oauthcode.FieldDeletedAt, field.TypeInt64, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := _c.mutation.ClientID(); ok {
+		_spec.SetField(oauthcode.FieldClientID, field.TypeString, value)
+		_node.ClientID = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(oauthcode.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.CodeHash(); ok {
+		_spec.SetField(oauthcode.FieldCodeHash, field.TypeString, value)
+		_node.CodeHash = value
+	}
+	if value, ok := _c.mutation.RedirectURI(); ok {
+		_spec.SetField(oauthcode.FieldRedirectURI, field.TypeString, value)
+		_node.RedirectURI = value
+	}
+	if value, ok := _c.mutation.Scope(); ok {
+		_spec.SetField(oauthcode.FieldScope, field.TypeString, value)
+		_node.Scope = value
+	}
+	if value, ok := _c.mutation.CodeChallenge(); ok {
+		_spec.SetField(oauthcode.FieldCodeChallenge, field.TypeString, value)
+		_node.CodeChallenge = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(oauthcode.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.UsedAt(); ok {
+		_spec.SetField(oauthcode.FieldUsedAt, field.TypeTime, value)
+		_node.UsedAt = &value
+	}
+	if value, ok := _c.mutation.FamilyID(); ok {
+		_spec.SetField(oauthcode.FieldFamilyID, field.TypeString, value)
+		_node.FamilyID = value
+	}
+	return _node, _spec
+}
+
+// OAuthCodeCreateBulk is the builder for creating many OAuthCode entities in bulk.
+type OAuthCodeCreateBulk struct {
+	config
+	err      error
+	builders []*OAuthCodeCreate
+}
+
+// Save creates the OAuthCode entities in the database.
+func (_c *OAuthCodeCreateBulk) Save(ctx context.Context) ([]*OAuthCode, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*OAuthCode, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OAuthCodeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *OAuthCodeCreateBulk) SaveX(ctx context.Context) []*OAuthCode {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OAuthCodeCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OAuthCodeCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,724 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/liukeshao/echo-template/ent/oauthcode"
+	"github.com/liukeshao/echo-template/ent/predicate"
+)
+
+// OAuthCodeUpdate is the builder for updating OAuthCode entities.
+type OAuthCodeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OAuthCodeMutation
+}
+
+// Where appends a list predicates to the OAuthCodeUpdate builder.
+func (_u *OAuthCodeUpdate) Where(ps ...predicate.OAuthCode) *OAuthCodeUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OAuthCodeUpdate) SetUpdatedAt(v time.Time) *OAuthCodeUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *OAuthCodeUpdate) SetDeletedAt(v int64) *OAuthCodeUpdate {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *OAuthCodeUpdate) SetNillableDeletedAt(v *int64) *OAuthCodeUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *OAuthCodeUpdate) AddDeletedAt(v int64) *OAuthCodeUpdate {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// SetClientID sets the "client_id" field.
+func (_u *OAuthCodeUpdate) SetClientID(v string) *OAuthCodeUpdate {
+	_u.mutation.SetClientID(v)
+	return _u
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (_u *OAuthCodeUpdate) SetNillableClientID(v *string) *OAuthCodeUpdate {
+	if v != nil {
+		_u.SetClientID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *OAuthCodeUpdate) SetUserID(v string) *OAuthCodeUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *OAuthCodeUpdate) SetNillableUserID(v *string) *OAuthCodeUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (_u *OAuthCodeUpdate) SetCodeHash(v string) *OAuthCodeUpdate {
+	_u.mutation.SetCodeHash(v)
+	return _u
+}
+
+// SetNillableCodeHash sets the "code_hash" field if the given value is not nil.
+func (_u *OAuthCodeUpdate) SetNillableCodeHash(v *string) *OAuthCodeUpdate {
+	if v != nil {
+		_u.SetCodeHash(*v)
+	}
+	return _u
+}
+
+// SetRedirectURI sets the "redirect_uri" field.
+func (_u *OAuthCodeUpdate) SetRedirectURI(v string) *OAuthCodeUpdate {
+	_u.mutation.SetRedirectURI(v)
+	return _u
+}
+
+// SetNillableRedirectURI sets the "redirect_uri" field if the given value is not nil.
+func (_u *OAuthCodeUpdate) SetNillableRedirectURI(v *string) *OAuthCodeUpdate {
+	if v != nil {
+		_u.SetRedirectURI(*v)
+	}
+	return _u
+}
+
+// SetScope sets the "scope" field.
+func (_u *OAuthCodeUpdate) SetScope(v string) *OAuthCodeUpdate {
+	_u.mutation.SetScope(v)
+	return _u
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (_u *OAuthCodeUpdate) SetNillableScope(v *string) *OAuthCodeUpdate {
+	if v != nil {
+		_u.SetScope(*v)
+	}
+	return _u
+}
+
+// ClearScope clears the value of the "scope" field.
+func (_u *OAuthCodeUpdate) ClearScope() *OAuthCodeUpdate {
+	_u.mutation.ClearScope()
+	return _u
+}
+
+// SetCodeChallenge sets the "code_challenge" field.
+func (_u *OAuthCodeUpdate) SetCodeChallenge(v string) *OAuthCodeUpdate {
+	_u.mutation.SetCodeChallenge(v)
+	return _u
+}
+
+// SetNillableCodeChallenge sets the "code_challenge" field if the given value is not nil.
+func (_u *OAuthCodeUpdate) SetNillableCodeChallenge(v *string) *OAuthCodeUpdate {
+	if v != nil {
+		_u.SetCodeChallenge(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *OAuthCodeUpdate) SetExpiresAt(v time.Time) *OAuthCodeUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *OAuthCodeUpdate) SetNillableExpiresAt(v *time.Time) *OAuthCodeUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetUsedAt sets the "used_at" field.
+func (_u *OAuthCodeUpdate) SetUsedAt(v time.Time) *OAuthCodeUpdate {
+	_u.mutation.SetUsedAt(v)
+	return _u
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (_u *OAuthCodeUpdate) SetNillableUsedAt(v *time.Time) *OAuthCodeUpdate {
+	if v != nil {
+		_u.SetUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (_u *OAuthCodeUpdate) ClearUsedAt() *OAuthCodeUpdate {
+	_u.mutation.ClearUsedAt()
+	return _u
+}
+
+// SetFamilyID sets the "family_id" field.
+func (_u *OAuthCodeUpdate) SetFamilyID(v string) *OAuthCodeUpdate {
+	_u.mutation.SetFamilyID(v)
+	return _u
+}
+
+// SetNillableFamilyID sets the "family_id" field if the given value is not nil.
+func (_u *OAuthCodeUpdate) SetNillableFamilyID(v *string) *OAuthCodeUpdate {
+	if v != nil {
+		_u.SetFamilyID(*v)
+	}
+	return _u
+}
+
+// ClearFamilyID clears the value of the "family_id" field.
+func (_u *OAuthCodeUpdate) ClearFamilyID() *OAuthCodeUpdate {
+	_u.mutation.ClearFamilyID()
+	return _u
+}
+
+// Mutation returns the OAuthCodeMutation object of the builder.
+func (_u *OAuthCodeUpdate) Mutation() *OAuthCodeMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *OAuthCodeUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OAuthCodeUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *OAuthCodeUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OAuthCodeUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OAuthCodeUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if oauthcode.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized oauthcode.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := oauthcode.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OAuthCodeUpdate) check() error {
+	if v, ok := _u.mutation.ClientID(); ok {
+		if err := oauthcode.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "OAuthCode.client_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := oauthcode.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "OAuthCode.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CodeHash(); ok {
+		if err := oauthcode.CodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "code_hash", err: fmt.Errorf(`ent: validator failed for field "OAuthCode.code_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RedirectURI(); ok {
+		if err := oauthcode.RedirectURIValidator(v); err != nil {
+			return &ValidationError{Name: "redirect_uri", err: fmt.Errorf(`ent: validator failed for field "OAuthCode.redirect_uri": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Scope(); ok {
+		if err := oauthcode.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "OAuthCode.scope": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CodeChallenge(); ok {
+		if err := oauthcode.CodeChallengeValidator(v); err != nil {
+			return &ValidationError{Name: "code_challenge", err: fmt.Errorf(`ent: validator failed for field "OAuthCode.code_challenge": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FamilyID(); ok {
+		if err := oauthcode.FamilyIDValidator(v); err != nil {
+			return &ValidationError{Name: "family_id", err: fmt.Errorf(`ent: validator failed for field "OAuthCode.family_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *OAuthCodeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oauthcode.Table, oauthcode.Columns, sqlgraph.NewFieldSpec(oauthcode.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(oauthcode.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(oauthcode.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(oauthcode.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ClientID(); ok {
+		_spec.SetField(oauthcode.FieldClientID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(oauthcode.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CodeHash(); ok {
+		_spec.SetField(oauthcode.FieldCodeHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RedirectURI(); ok {
+		_spec.SetField(oauthcode.FieldRedirectURI, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Scope(); ok {
+		_spec.SetField(oauthcode.FieldScope, field.TypeString, value)
+	}
+	if _u.mutation.ScopeCleared() {
+		_spec.ClearField(oauthcode.FieldScope, field.TypeString)
+	}
+	if value, ok := _u.mutation.CodeChallenge(); ok {
+		_spec.SetField(oauthcode.FieldCodeChallenge, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(oauthcode.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UsedAt(); ok {
+		_spec.SetField(oauthcode.FieldUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UsedAtCleared() {
+		_spec.ClearField(oauthcode.FieldUsedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FamilyID(); ok {
+		_spec.SetField(oauthcode.FieldFamilyID, field.TypeString, value)
+	}
+	if _u.mutation.FamilyIDCleared() {
+		_spec.ClearField(oauthcode.FieldFamilyID, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oauthcode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// OAuthCodeUpdateOne is the builder for updating a single OAuthCode entity.
+type OAuthCodeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OAuthCodeMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OAuthCodeUpdateOne) SetUpdatedAt(v time.Time) *OAuthCodeUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *OAuthCodeUpdateOne) SetDeletedAt(v int64) *OAuthCodeUpdateOne {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *OAuthCodeUpdateOne) SetNillableDeletedAt(v *int64) *OAuthCodeUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *OAuthCodeUpdateOne) AddDeletedAt(v int64) *OAuthCodeUpdateOne {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// SetClientID sets the "client_id" field.
+func (_u *OAuthCodeUpdateOne) SetClientID(v string) *OAuthCodeUpdateOne {
+	_u.mutation.SetClientID(v)
+	return _u
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (_u *OAuthCodeUpdateOne) SetNillableClientID(v *string) *OAuthCodeUpdateOne {
+	if v != nil {
+		_u.SetClientID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *OAuthCodeUpdateOne) SetUserID(v string) *OAuthCodeUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *OAuthCodeUpdateOne) SetNillableUserID(v *string) *OAuthCodeUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (_u *OAuthCodeUpdateOne) SetCodeHash(v string) *OAuthCodeUpdateOne {
+	_u.mutation.SetCodeHash(v)
+	return _u
+}
+
+// SetNillableCodeHash sets the "code_hash" field if the given value is not nil.
+func (_u *OAuthCodeUpdateOne) SetNillableCodeHash(v *string) *OAuthCodeUpdateOne {
+	if v != nil {
+		_u.SetCodeHash(*v)
+	}
+	return _u
+}
+
+// SetRedirectURI sets the "redirect_uri" field.
+func (_u *OAuthCodeUpdateOne) SetRedirectURI(v string) *OAuthCodeUpdateOne {
+	_u.mutation.SetRedirectURI(v)
+	return _u
+}
+
+// SetNillableRedirectURI sets the "redirect_uri" field if the given value is not nil.
+func (_u *OAuthCodeUpdateOne) SetNillableRedirectURI(v *string) *OAuthCodeUpdateOne {
+	if v != nil {
+		_u.SetRedirectURI(*v)
+	}
+	return _u
+}
+
+// SetScope sets the "scope" field.
+func (_u *OAuthCodeUpdateOne) SetScope(v string) *OAuthCodeUpdateOne {
+	_u.mutation.SetScope(v)
+	return _u
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (_u *OAuthCodeUpdateOne) SetNillableScope(v *string) *OAuthCodeUpdateOne {
+	if v != nil {
+		_u.SetScope(*v)
+	}
+	return _u
+}
+
+// ClearScope clears the value of the "scope" field.
+func (_u *OAuthCodeUpdateOne) ClearScope() *OAuthCodeUpdateOne {
+	_u.mutation.ClearScope()
+	return _u
+}
+
+// SetCodeChallenge sets the "code_challenge" field.
+func (_u *OAuthCodeUpdateOne) SetCodeChallenge(v string) *OAuthCodeUpdateOne {
+	_u.mutation.SetCodeChallenge(v)
+	return _u
+}
+
+// SetNillableCodeChallenge sets the "code_challenge" field if the given value is not nil.
+func (_u *OAuthCodeUpdateOne) SetNillableCodeChallenge(v *string) *OAuthCodeUpdateOne {
+	if v != nil {
+		_u.SetCodeChallenge(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *OAuthCodeUpdateOne) SetExpiresAt(v time.Time) *OAuthCodeUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *OAuthCodeUpdateOne) SetNillableExpiresAt(v *time.Time) *OAuthCodeUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetUsedAt sets the "used_at" field.
+func (_u *OAuthCodeUpdateOne) SetUsedAt(v time.Time) *OAuthCodeUpdateOne {
+	_u.mutation.SetUsedAt(v)
+	return _u
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (_u *OAuthCodeUpdateOne) SetNillableUsedAt(v *time.Time) *OAuthCodeUpdateOne {
+	if v != nil {
+		_u.SetUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (_u *OAuthCodeUpdateOne) ClearUsedAt() *OAuthCodeUpdateOne {
+	_u.mutation.ClearUsedAt()
+	return _u
+}
+
+// SetFamilyID sets the "family_id" field.
+func (_u *OAuthCodeUpdateOne) SetFamilyID(v string) *OAuthCodeUpdateOne {
+	_u.mutation.SetFamilyID(v)
+	return _u
+}
+
+// SetNillableFamilyID sets the "family_id" field if the given value is not nil.
+func (_u *OAuthCodeUpdateOne) SetNillableFamilyID(v *string) *OAuthCodeUpdateOne {
+	if v != nil {
+		_u.SetFamilyID(*v)
+	}
+	return _u
+}
+
+// ClearFamilyID clears the value of the "family_id" field.
+func (_u *OAuthCodeUpdateOne) ClearFamilyID() *OAuthCodeUpdateOne {
+	_u.mutation.ClearFamilyID()
+	return _u
+}
+
+// Mutation returns the OAuthCodeMutation object of the builder.
+func (_u *OAuthCodeUpdateOne) Mutation() *OAuthCodeMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the OAuthCodeUpdate builder.
+func (_u *OAuthCodeUpdateOne) Where(ps ...predicate.OAuthCode) *OAuthCodeUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *OAuthCodeUpdateOne) Select(field string, fields ...string) *OAuthCodeUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated OAuthCode entity.
+func (_u *OAuthCodeUpdateOne) Save(ctx context.Context) (*OAuthCode, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OAuthCodeUpdateOne) SaveX(ctx context.Context) *OAuthCode {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *OAuthCodeUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OAuthCodeUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OAuthCodeUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if oauthcode.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized oauthcode.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := oauthcode.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OAuthCodeUpdateOne) check() error {
+	if v, ok := _u.mutation.ClientID(); ok {
+		if err := oauthcode.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "OAuthCode.client_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := oauthcode.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "OAuthCode.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CodeHash(); ok {
+		if err := oauthcode.CodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "code_hash", err: fmt.Errorf(`ent: validator failed for field "OAuthCode.code_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RedirectURI(); ok {
+		if err := oauthcode.RedirectURIValidator(v); err != nil {
+			return &ValidationError{Name: "redirect_uri", err: fmt.Errorf(`ent: validator failed for field "OAuthCode.redirect_uri": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Scope(); ok {
+		if err := oauthcode.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "OAuthCode.scope": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CodeChallenge(); ok {
+		if err := oauthcode.CodeChallengeValidator(v); err != nil {
+			return &ValidationError{Name: "code_challenge", err: fmt.Errorf(`ent: validator failed for field "OAuthCode.code_challenge": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FamilyID(); ok {
+		if err := oauthcode.FamilyIDValidator(v); err != nil {
+			return &ValidationError{Name: "family_id", err: fmt.Errorf(`ent: validator failed for field "OAuthCode.family_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *OAuthCodeUpdateOne) sqlSave(ctx context.Context) (_node *OAuthCode, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oauthcode.Table, oauthcode.Columns, sqlgraph.NewFieldSpec(oauthcode.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OAuthCode.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, oauthcode.FieldID)
+		for _, f := range fields {
+			if !oauthcode.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != oauthcode.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(oauthcode.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(oauthcode.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(oauthcode.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ClientID(); ok {
+		_spec.SetField(oauthcode.FieldClientID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(oauthcode.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CodeHash(); ok {
+		_spec.SetField(oauthcode.FieldCodeHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RedirectURI(); ok {
+		_spec.SetField(oauthcode.FieldRedirectURI, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Scope(); ok {
+		_spec.SetField(oauthcode.FieldScope, field.TypeString, value)
+	}
+	if _u.mutation.ScopeCleared() {
+		_spec.ClearField(oauthcode.FieldScope, field.TypeString)
+	}
+	if value, ok := _u.mutation.CodeChallenge(); ok {
+		_spec.SetField(oauthcode.FieldCodeChallenge, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(oauthcode.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UsedAt(); ok {
+		_spec.SetField(oauthcode.FieldUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UsedAtCleared() {
+		_spec.ClearField(oauthcode.FieldUsedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FamilyID(); ok {
+		_spec.SetField(oauthcode.FieldFamilyID, field.TypeString, value)
+	}
+	if _u.mutation.FamilyIDCleared() {
+		_spec.ClearField(oauthcode.FieldFamilyID, field.TypeString)
+	}
+	_node = &OAuthCode{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oauthcode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
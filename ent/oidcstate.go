@@ -0,0 +1,195 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/liukeshao/echo-template/ent/oidcstate"
+)
+
+// OIDCState is the model entity for the OIDCState schema.
+type OIDCState struct {
+	config `json:"-"`
+	// ID of the ent.
+	// 唯一标识符，ULID格式
+	ID string `json:"id,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 逻辑删除时间戳（毫秒），0表示未删除
+	DeletedAt int64 `json:"deleted_at,omitempty"`
+	// 发起登录的身份提供方名称
+	Provider string `json:"provider,omitempty"`
+	// state参数的HMAC-SHA256哈希值
+	StateHash string `json:"-"`
+	// 写入ID令牌的nonce，用于防止令牌重放
+	Nonce string `json:"-"`
+	// PKCE code_verifier
+	CodeVerifier string `json:"-"`
+	// 登录流程过期时间
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// 回调使用时间，为空表示仍然有效
+	UsedAt       *time.Time `json:"used_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OIDCState) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case oidcstate.FieldDeletedAt:
+			values[i] = new(sql.NullInt64)
+		case oidcstate.FieldID, oidcstate.FieldProvider, oidcstate.FieldStateHash, oidcstate.FieldNonce, oidcstate.FieldCodeVerifier:
+			values[i] = new(sql.NullString)
+		case oidcstate.FieldCreatedAt, oidcstate.FieldUpdatedAt, oidcstate.FieldExpiresAt, oidcstate.FieldUsedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OIDCState fields.
+func (_m *OIDCState) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case oidcstate.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case oidcstate.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case oidcstate.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case oidcstate.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = value.Int64
+			}
+		case oidcstate.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				_m.Provider = value.String
+			}
+		case oidcstate.FieldStateHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field state_hash", values[i])
+			} else if value.Valid {
+				_m.StateHash = value.String
+			}
+		case oidcstate.FieldNonce:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field nonce", values[i])
+			} else if value.Valid {
+				_m.Nonce = value.String
+			}
+		case oidcstate.FieldCodeVerifier:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code_verifier", values[i])
+			} else if value.Valid {
+				_m.CodeVerifier = value.String
+			}
+		case oidcstate.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Time
+			}
+		case oidcstate.FieldUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field used_at", values[i])
+			} else if value.Valid {
+				_m.UsedAt = new(time.Time)
+				*_m.UsedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OIDCState.
+// This includes values selected through modifiers, order, etc.
+func (_m *OIDCState) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this OIDCState.
+// Note that you need to call OIDCState.Unwrap() before calling this method if this OIDCState
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *OIDCState) Update() *OIDCStateUpdateOne {
+	return NewOIDCStateClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the OIDCState entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *OIDCState) Unwrap() *OIDCState {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OIDCState is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *OIDCState) String() string {
+	var builder strings.Builder
+	builder.WriteString("OIDCState(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DeletedAt))
+	builder.WriteString(", ")
+	builder.WriteString("provider=")
+	builder.WriteString(_m.Provider)
+	builder.WriteString(", ")
+	builder.WriteString("state_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("nonce=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("code_verifier=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.UsedAt; v != nil {
+		builder.WriteString("used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OIDCStates is a parsable slice of OIDCState.
+type OIDCStates []*OIDCState
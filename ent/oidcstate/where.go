@@ -0,0 +1,595 @@
+// Code generated by ent, DO NOT EDIT.
+
+package oidcstate
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/liukeshao/echo-template/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v int64) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// Provider applies equality check predicate on the "provider" field. It's identical to ProviderEQ.
+func Provider(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEQ(FieldProvider, v))
+}
+
+// StateHash applies equality check predicate on the "state_hash" field. It's identical to StateHashEQ.
+func StateHash(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEQ(FieldStateHash, v))
+}
+
+// Nonce applies equality check predicate on the "nonce" field. It's identical to NonceEQ.
+func Nonce(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEQ(FieldNonce, v))
+}
+
+// CodeVerifier applies equality check predicate on the "code_verifier" field. It's identical to CodeVerifierEQ.
+func CodeVerifier(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEQ(FieldCodeVerifier, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// UsedAt applies equality check predicate on the "used_at" field. It's identical to UsedAtEQ.
+func UsedAt(v time.Time) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEQ(FieldUsedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v int64) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v int64) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...int64) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...int64) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v int64) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v int64) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v int64) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v int64) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEQ(FieldProvider, v))
+}
+
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldNEQ(FieldProvider, v))
+}
+
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldIn(FieldProvider, vs...))
+}
+
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldNotIn(FieldProvider, vs...))
+}
+
+// ProviderGT applies the GT predicate on the "provider" field.
+func ProviderGT(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldGT(FieldProvider, v))
+}
+
+// ProviderGTE applies the GTE predicate on the "provider" field.
+func ProviderGTE(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldGTE(FieldProvider, v))
+}
+
+// ProviderLT applies the LT predicate on the "provider" field.
+func ProviderLT(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldLT(FieldProvider, v))
+}
+
+// ProviderLTE applies the LTE predicate on the "provider" field.
+func ProviderLTE(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldLTE(FieldProvider, v))
+}
+
+// ProviderContains applies the Contains predicate on the "provider" field.
+func ProviderContains(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldContains(FieldProvider, v))
+}
+
+// ProviderHasPrefix applies the HasPrefix predicate on the "provider" field.
+func ProviderHasPrefix(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldHasPrefix(FieldProvider, v))
+}
+
+// ProviderHasSuffix applies the HasSuffix predicate on the "provider" field.
+func ProviderHasSuffix(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldHasSuffix(FieldProvider, v))
+}
+
+// ProviderEqualFold applies the EqualFold predicate on the "provider" field.
+func ProviderEqualFold(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEqualFold(FieldProvider, v))
+}
+
+// ProviderContainsFold applies the ContainsFold predicate on the "provider" field.
+func ProviderContainsFold(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldContainsFold(FieldProvider, v))
+}
+
+// StateHashEQ applies the EQ predicate on the "state_hash" field.
+func StateHashEQ(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEQ(FieldStateHash, v))
+}
+
+// StateHashNEQ applies the NEQ predicate on the "state_hash" field.
+func StateHashNEQ(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldNEQ(FieldStateHash, v))
+}
+
+// StateHashIn applies the In predicate on the "state_hash" field.
+func StateHashIn(vs ...string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldIn(FieldStateHash, vs...))
+}
+
+// StateHashNotIn applies the NotIn predicate on the "state_hash" field.
+func StateHashNotIn(vs ...string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldNotIn(FieldStateHash, vs...))
+}
+
+// StateHashGT applies the GT predicate on the "state_hash" field.
+func StateHashGT(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldGT(FieldStateHash, v))
+}
+
+// StateHashGTE applies the GTE predicate on the "state_hash" field.
+func StateHashGTE(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldGTE(FieldStateHash, v))
+}
+
+// StateHashLT applies the LT predicate on the "state_hash" field.
+func StateHashLT(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldLT(FieldStateHash, v))
+}
+
+// StateHashLTE applies the LTE predicate on the "state_hash" field.
+func StateHashLTE(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldLTE(FieldStateHash, v))
+}
+
+// StateHashContains applies the Contains predicate on the "state_hash" field.
+func StateHashContains(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldContains(FieldStateHash, v))
+}
+
+// StateHashHasPrefix applies the HasPrefix predicate on the "state_hash" field.
+func StateHashHasPrefix(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldHasPrefix(FieldStateHash, v))
+}
+
+// StateHashHasSuffix applies the HasSuffix predicate on the "state_hash" field.
+func StateHashHasSuffix(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldHasSuffix(FieldStateHash, v))
+}
+
+// StateHashEqualFold applies the EqualFold predicate on the "state_hash" field.
+func StateHashEqualFold(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEqualFold(FieldStateHash, v))
+}
+
+// StateHashContainsFold applies the ContainsFold predicate on the "state_hash" field.
+func StateHashContainsFold(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldContainsFold(FieldStateHash, v))
+}
+
+// NonceEQ applies the EQ predicate on the "nonce" field.
+func NonceEQ(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEQ(FieldNonce, v))
+}
+
+// NonceNEQ applies the NEQ predicate on the "nonce" field.
+func NonceNEQ(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldNEQ(FieldNonce, v))
+}
+
+// NonceIn applies the In predicate on the "nonce" field.
+func NonceIn(vs ...string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldIn(FieldNonce, vs...))
+}
+
+// NonceNotIn applies the NotIn predicate on the "nonce" field.
+func NonceNotIn(vs ...string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldNotIn(FieldNonce, vs...))
+}
+
+// NonceGT applies the GT predicate on the "nonce" field.
+func NonceGT(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldGT(FieldNonce, v))
+}
+
+// NonceGTE applies the GTE predicate on the "nonce" field.
+func NonceGTE(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldGTE(FieldNonce, v))
+}
+
+// NonceLT applies the LT predicate on the "nonce" field.
+func NonceLT(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldLT(FieldNonce, v))
+}
+
+// NonceLTE applies the LTE predicate on the "nonce" field.
+func NonceLTE(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldLTE(FieldNonce, v))
+}
+
+// NonceContains applies the Contains predicate on the "nonce" field.
+func NonceContains(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldContains(FieldNonce, v))
+}
+
+// NonceHasPrefix applies the HasPrefix predicate on the "nonce" field.
+func NonceHasPrefix(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldHasPrefix(FieldNonce, v))
+}
+
+// NonceHasSuffix applies the HasSuffix predicate on the "nonce" field.
+func NonceHasSuffix(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldHasSuffix(FieldNonce, v))
+}
+
+// NonceEqualFold applies the EqualFold predicate on the "nonce" field.
+func NonceEqualFold(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEqualFold(FieldNonce, v))
+}
+
+// NonceContainsFold applies the ContainsFold predicate on the "nonce" field.
+func NonceContainsFold(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldContainsFold(FieldNonce, v))
+}
+
+// CodeVerifierEQ applies the EQ predicate on the "code_verifier" field.
+func CodeVerifierEQ(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEQ(FieldCodeVerifier, v))
+}
+
+// CodeVerifierNEQ applies the NEQ predicate on the "code_verifier" field.
+func CodeVerifierNEQ(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldNEQ(FieldCodeVerifier, v))
+}
+
+// CodeVerifierIn applies the In predicate on the "code_verifier" field.
+func CodeVerifierIn(vs ...string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldIn(FieldCodeVerifier, vs...))
+}
+
+// CodeVerifierNotIn applies the NotIn predicate on the "code_verifier" field.
+func CodeVerifierNotIn(vs ...string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldNotIn(FieldCodeVerifier, vs...))
+}
+
+// CodeVerifierGT applies the GT predicate on the "code_verifier" field.
+func CodeVerifierGT(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldGT(FieldCodeVerifier, v))
+}
+
+// CodeVerifierGTE applies the GTE predicate on the "code_verifier" field.
+func CodeVerifierGTE(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldGTE(FieldCodeVerifier, v))
+}
+
+// CodeVerifierLT applies the LT predicate on the "code_verifier" field.
+func CodeVerifierLT(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldLT(FieldCodeVerifier, v))
+}
+
+// CodeVerifierLTE applies the LTE predicate on the "code_verifier" field.
+func CodeVerifierLTE(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldLTE(FieldCodeVerifier, v))
+}
+
+// CodeVerifierContains applies the Contains predicate on the "code_verifier" field.
+func CodeVerifierContains(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldContains(FieldCodeVerifier, v))
+}
+
+// CodeVerifierHasPrefix applies the HasPrefix predicate on the "code_verifier" field.
+func CodeVerifierHasPrefix(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldHasPrefix(FieldCodeVerifier, v))
+}
+
+// CodeVerifierHasSuffix applies the HasSuffix predicate on the "code_verifier" field.
+func CodeVerifierHasSuffix(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldHasSuffix(FieldCodeVerifier, v))
+}
+
+// CodeVerifierEqualFold applies the EqualFold predicate on the "code_verifier" field.
+func CodeVerifierEqualFold(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEqualFold(FieldCodeVerifier, v))
+}
+
+// CodeVerifierContainsFold applies the ContainsFold predicate on the "code_verifier" field.
+func CodeVerifierContainsFold(v string) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldContainsFold(FieldCodeVerifier, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// UsedAtEQ applies the EQ predicate on the "used_at" field.
+func UsedAtEQ(v time.Time) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldEQ(FieldUsedAt, v))
+}
+
+// UsedAtNEQ applies the NEQ predicate on the "used_at" field.
+func UsedAtNEQ(v time.Time) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldNEQ(FieldUsedAt, v))
+}
+
+// UsedAtIn applies the In predicate on the "used_at" field.
+func UsedAtIn(vs ...time.Time) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldIn(FieldUsedAt, vs...))
+}
+
+// UsedAtNotIn applies the NotIn predicate on the "used_at" field.
+func UsedAtNotIn(vs ...time.Time) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldNotIn(FieldUsedAt, vs...))
+}
+
+// UsedAtGT applies the GT predicate on the "used_at" field.
+func UsedAtGT(v time.Time) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldGT(FieldUsedAt, v))
+}
+
+// UsedAtGTE applies the GTE predicate on the "used_at" field.
+func UsedAtGTE(v time.Time) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldGTE(FieldUsedAt, v))
+}
+
+// UsedAtLT applies the LT predicate on the "used_at" field.
+func UsedAtLT(v time.Time) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldLT(FieldUsedAt, v))
+}
+
+// UsedAtLTE applies the LTE predicate on the "used_at" field.
+func UsedAtLTE(v time.Time) predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldLTE(FieldUsedAt, v))
+}
+
+// UsedAtIsNil applies the IsNil predicate on the "used_at" field.
+func UsedAtIsNil() predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldIsNull(FieldUsedAt))
+}
+
+// UsedAtNotNil applies the NotNil predicate on the "used_at" field.
+func UsedAtNotNil() predicate.OIDCState {
+	return predicate.OIDCState(sql.FieldNotNull(FieldUsedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.OIDCState) predicate.OIDCState {
+	return predicate.OIDCState(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.OIDCState) predicate.OIDCState {
+	return predicate.OIDCState(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.OIDCState) predicate.OIDCState {
+	return predicate.OIDCState(sql.NotPredicates(p))
+}
@@ -0,0 +1,380 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/liukeshao/echo-template/ent/oidcstate"
+)
+
+// OIDCStateCreate is the builder for creating a OIDCState entity.
+type OIDCStateCreate struct {
+	config
+	mutation *OIDCStateMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *OIDCStateCreate) SetCreatedAt(v time.Time) *OIDCStateCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *OIDCStateCreate) SetNillableCreatedAt(v *time.Time) *OIDCStateCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *OIDCStateCreate) SetUpdatedAt(v time.Time) *OIDCStateCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *OIDCStateCreate) SetNillableUpdatedAt(v *time.Time) *OIDCStateCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *OIDCStateCreate) SetDeletedAt(v int64) *OIDCStateCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *OIDCStateCreate) SetNillableDeletedAt(v *int64) *OIDCStateCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetProvider sets the "provider" field.
+func (_c *OIDCStateCreate) SetProvider(v string) *OIDCStateCreate {
+	_c.mutation.SetProvider(v)
+	return _c
+}
+
+// SetStateHash sets the "state_hash" field.
+func (_c *OIDCStateCreate) SetStateHash(v string) *OIDCStateCreate {
+	_c.mutation.SetStateHash(v)
+	return _c
+}
+
+// SetNonce sets the "nonce" field.
+func (_c *OIDCStateCreate) SetNonce(v string) *OIDCStateCreate {
+	_c.mutation.SetNonce(v)
+	return _c
+}
+
+// SetCodeVerifier sets the "code_verifier" field.
+func (_c *OIDCStateCreate) SetCodeVerifier(v string) *OIDCStateCreate {
+	_c.mutation.SetCodeVerifier(v)
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *OIDCStateCreate) SetExpiresAt(v time.Time) *OIDCStateCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetUsedAt sets the "used_at" field.
+func (_c *OIDCStateCreate) SetUsedAt(v time.Time) *OIDCStateCreate {
+	_c.mutation.SetUsedAt(v)
+	return _c
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (_c *OIDCStateCreate) SetNillableUsedAt(v *time.Time) *OIDCStateCreate {
+	if v != nil {
+		_c.SetUsedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *OIDCStateCreate) SetID(v string) *OIDCStateCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the OIDCStateMutation object of the builder.
+func (_c *OIDCStateCreate) Mutation() *OIDCStateMutation {
+	return _c.mutation
+}
+
+// Save creates the OIDCState in the database.
+func (_c *OIDCStateCreate) Save(ctx context.Context) (*OIDCState, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *OIDCStateCreate) SaveX(ctx context.Context) *OIDCState {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OIDCStateCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OIDCStateCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *OIDCStateCreate) defaults() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if oidcstate.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized oidcstate.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := oidcstate.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if oidcstate.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized oidcstate.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := oidcstate.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.DeletedAt(); !ok {
+		v := oidcstate.DefaultDeletedAt
+		_c.mutation.SetDeletedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *OIDCStateCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "OIDCState.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "OIDCState.updated_at"`)}
+	}
+	if _, ok := _c.mutation.DeletedAt(); !ok {
+		return &ValidationError{Name: "deleted_at", err: errors.New(`ent: missing required field "OIDCState.deleted_at"`)}
+	}
+	if _, ok := _c.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required field "OIDCState.provider"`)}
+	}
+	if v, ok := _c.mutation.Provider(); ok {
+		if err := oidcstate.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "OIDCState.provider": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.StateHash(); !ok {
+		return &ValidationError{Name: "state_hash", err: errors.New(`ent: missing required field "OIDCState.state_hash"`)}
+	}
+	if v, ok := _c.mutation.StateHash(); ok {
+		if err := oidcstate.StateHashValidator(v); err != nil {
+			return &ValidationError{Name: "state_hash", err: fmt.Errorf(`ent: validator failed for field "OIDCState.state_hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Nonce(); !ok {
+		return &ValidationError{Name: "nonce", err: errors.New(`ent: missing required field "OIDCState.nonce"`)}
+	}
+	if v, ok := _c.mutation.Nonce(); ok {
+		if err := oidcstate.NonceValidator(v); err != nil {
+			return &ValidationError{Name: "nonce", err: fmt.Errorf(`ent: validator failed for field "OIDCState.nonce": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CodeVerifier(); !ok {
+		return &ValidationError{Name: "code_verifier", err: errors.New(`ent: missing required field "OIDCState.code_verifier"`)}
+	}
+	if v, ok := _c.mutation.CodeVerifier(); ok {
+		if err := oidcstate.CodeVerifierValidator(v); err != nil {
+			return &ValidationError{Name: "code_verifier", err: fmt.Errorf(`ent: validator failed for field "OIDCState.code_verifier": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "OIDCState.expires_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := oidcstate.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "OIDCState.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *OIDCStateCreate) sqlSave(ctx context.Context) (*OIDCState, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected OIDCState.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *OIDCStateCreate) createSpec() (*OIDCState, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OIDCState{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(oidcstate.Table, sqlgraph.NewFieldSpec(oidcstate.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(oidcstate.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(oidcstate.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(oidcstate.FieldDeletedAt, field.TypeInt64, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := _c.mutation.Provider(); ok {
+		_spec.SetField(oidcstate.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := _c.mutation.StateHash(); ok {
+		_spec.SetField(oidcstate.FieldStateHash, field.TypeString, value)
+		_node.StateHash = value
+	}
+	if value, ok := _c.mutation.Nonce(); ok {
+		_spec.SetField(oidcstate.FieldNonce, field.TypeString, value)
+		_node.Nonce = value
+	}
+	if value, ok := _c.mutation.CodeVerifier(); ok {
+		_spec.SetField(oidcstate.FieldCodeVerifier, field.TypeString, value)
+		_node.CodeVerifier = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(oidcstate.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.UsedAt(); ok {
+		_spec.SetField(oidcstate.FieldUsedAt, field.TypeTime, value)
+		_node.UsedAt = &value
+	}
+	return _node, _spec
+}
+
+// OIDCStateCreateBulk is the builder for creating many OIDCState entities in bulk.
+type OIDCStateCreateBulk struct {
+	config
+	err      error
+	builders []*OIDCStateCreate
+}
+
+// Save creates the OIDCState entities in the database.
+func (_c *OIDCStateCreateBulk) Save(ctx context.Context) ([]*OIDCState, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*OIDCState, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OIDCStateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *OIDCStateCreateBulk) SaveX(ctx context.Context) []*OIDCState {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OIDCStateCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OIDCStateCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
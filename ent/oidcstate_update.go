@@ -0,0 +1,556 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/liukeshao/echo-template/ent/oidcstate"
+	"github.com/liukeshao/echo-template/ent/predicate"
+)
+
+// OIDCStateUpdate is the builder for updating OIDCState entities.
+type OIDCStateUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OIDCStateMutation
+}
+
+// Where appends a list predicates to the OIDCStateUpdate builder.
+func (_u *OIDCStateUpdate) Where(ps ...predicate.OIDCState) *OIDCStateUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OIDCStateUpdate) SetUpdatedAt(v time.Time) *OIDCStateUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *OIDCStateUpdate) SetDeletedAt(v int64) *OIDCStateUpdate {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *OIDCStateUpdate) SetNillableDeletedAt(v *int64) *OIDCStateUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *OIDCStateUpdate) AddDeletedAt(v int64) *OIDCStateUpdate {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// SetProvider sets the "provider" field.
+func (_u *OIDCStateUpdate) SetProvider(v string) *OIDCStateUpdate {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *OIDCStateUpdate) SetNillableProvider(v *string) *OIDCStateUpdate {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// SetStateHash sets the "state_hash" field.
+func (_u *OIDCStateUpdate) SetStateHash(v string) *OIDCStateUpdate {
+	_u.mutation.SetStateHash(v)
+	return _u
+}
+
+// SetNillableStateHash sets the "state_hash" field if the given value is not nil.
+func (_u *OIDCStateUpdate) SetNillableStateHash(v *string) *OIDCStateUpdate {
+	if v != nil {
+		_u.SetStateHash(*v)
+	}
+	return _u
+}
+
+// SetNonce sets the "nonce" field.
+func (_u *OIDCStateUpdate) SetNonce(v string) *OIDCStateUpdate {
+	_u.mutation.SetNonce(v)
+	return _u
+}
+
+// SetNillableNonce sets the "nonce" field if the given value is not nil.
+func (_u *OIDCStateUpdate) SetNillableNonce(v *string) *OIDCStateUpdate {
+	if v != nil {
+		_u.SetNonce(*v)
+	}
+	return _u
+}
+
+// SetCodeVerifier sets the "code_verifier" field.
+func (_u *OIDCStateUpdate) SetCodeVerifier(v string) *OIDCStateUpdate {
+	_u.mutation.SetCodeVerifier(v)
+	return _u
+}
+
+// SetNillableCodeVerifier sets the "code_verifier" field if the given value is not nil.
+func (_u *OIDCStateUpdate) SetNillableCodeVerifier(v *string) *OIDCStateUpdate {
+	if v != nil {
+		_u.SetCodeVerifier(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *OIDCStateUpdate) SetExpiresAt(v time.Time) *OIDCStateUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *OIDCStateUpdate) SetNillableExpiresAt(v *time.Time) *OIDCStateUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetUsedAt sets the "used_at" field.
+func (_u *OIDCStateUpdate) SetUsedAt(v time.Time) *OIDCStateUpdate {
+	_u.mutation.SetUsedAt(v)
+	return _u
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (_u *OIDCStateUpdate) SetNillableUsedAt(v *time.Time) *OIDCStateUpdate {
+	if v != nil {
+		_u.SetUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (_u *OIDCStateUpdate) ClearUsedAt() *OIDCStateUpdate {
+	_u.mutation.ClearUsedAt()
+	return _u
+}
+
+// Mutation returns the OIDCStateMutation object of the builder.
+func (_u *OIDCStateUpdate) Mutation() *OIDCStateMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *OIDCStateUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OIDCStateUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *OIDCStateUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OIDCStateUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OIDCStateUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if oidcstate.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized oidcstate.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := oidcstate.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OIDCStateUpdate) check() error {
+	if v, ok := _u.mutation.Provider(); ok {
+		if err := oidcstate.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "OIDCState.provider": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StateHash(); ok {
+		if err := oidcstate.StateHashValidator(v); err != nil {
+			return &ValidationError{Name: "state_hash", err: fmt.Errorf(`ent: validator failed for field "OIDCState.state_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Nonce(); ok {
+		if err := oidcstate.NonceValidator(v); err != nil {
+			return &ValidationError{Name: "nonce", err: fmt.Errorf(`ent: validator failed for field "OIDCState.nonce": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CodeVerifier(); ok {
+		if err := oidcstate.CodeVerifierValidator(v); err != nil {
+			return &ValidationError{Name: "code_verifier", err: fmt.Errorf(`ent: validator failed for field "OIDCState.code_verifier": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *OIDCStateUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oidcstate.Table, oidcstate.Columns, sqlgraph.NewFieldSpec(oidcstate.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(oidcstate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(oidcstate.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(oidcstate.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(oidcstate.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StateHash(); ok {
+		_spec.SetField(oidcstate.FieldStateHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Nonce(); ok {
+		_spec.SetField(oidcstate.FieldNonce, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CodeVerifier(); ok {
+		_spec.SetField(oidcstate.FieldCodeVerifier, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(oidcstate.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UsedAt(); ok {
+		_spec.SetField(oidcstate.FieldUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UsedAtCleared() {
+		_spec.ClearField(oidcstate.FieldUsedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oidcstate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// OIDCStateUpdateOne is the builder for updating a single OIDCState entity.
+type OIDCStateUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OIDCStateMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OIDCStateUpdateOne) SetUpdatedAt(v time.Time) *OIDCStateUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *OIDCStateUpdateOne) SetDeletedAt(v int64) *OIDCStateUpdateOne {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *OIDCStateUpdateOne) SetNillableDeletedAt(v *int64) *OIDCStateUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *OIDCStateUpdateOne) AddDeletedAt(v int64) *OIDCStateUpdateOne {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// SetProvider sets the "provider" field.
+func (_u *OIDCStateUpdateOne) SetProvider(v string) *OIDCStateUpdateOne {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *OIDCStateUpdateOne) SetNillableProvider(v *string) *OIDCStateUpdateOne {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// SetStateHash sets the "state_hash" field.
+func (_u *OIDCStateUpdateOne) SetStateHash(v string) *OIDCStateUpdateOne {
+	_u.mutation.SetStateHash(v)
+	return _u
+}
+
+// SetNillableStateHash sets the "state_hash" field if the given value is not nil.
+func (_u *OIDCStateUpdateOne) SetNillableStateHash(v *string) *OIDCStateUpdateOne {
+	if v != nil {
+		_u.SetStateHash(*v)
+	}
+	return _u
+}
+
+// SetNonce sets the "nonce" field.
+func (_u *OIDCStateUpdateOne) SetNonce(v string) *OIDCStateUpdateOne {
+	_u.mutation.SetNonce(v)
+	return _u
+}
+
+// SetNillableNonce sets the "nonce" field if the given value is not nil.
+func (_u *OIDCStateUpdateOne) SetNillableNonce(v *string) *OIDCStateUpdateOne {
+	if v != nil {
+		_u.SetNonce(*v)
+	}
+	return _u
+}
+
+// SetCodeVerifier sets the "code_verifier" field.
+func (_u *OIDCStateUpdateOne) SetCodeVerifier(v string) *OIDCStateUpdateOne {
+	_u.mutation.SetCodeVerifier(v)
+	return _u
+}
+
+// SetNillableCodeVerifier sets the "code_verifier" field if the given value is not nil.
+func (_u *OIDCStateUpdateOne) SetNillableCodeVerifier(v *string) *OIDCStateUpdateOne {
+	if v != nil {
+		_u.SetCodeVerifier(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *OIDCStateUpdateOne) SetExpiresAt(v time.Time) *OIDCStateUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *OIDCStateUpdateOne) SetNillableExpiresAt(v *time.Time) *OIDCStateUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetUsedAt sets the "used_at" field.
+func (_u *OIDCStateUpdateOne) SetUsedAt(v time.Time) *OIDCStateUpdateOne {
+	_u.mutation.SetUsedAt(v)
+	return _u
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (_u *OIDCStateUpdateOne) SetNillableUsedAt(v *time.Time) *OIDCStateUpdateOne {
+	if v != nil {
+		_u.SetUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (_u *OIDCStateUpdateOne) ClearUsedAt() *OIDCStateUpdateOne {
+	_u.mutation.ClearUsedAt()
+	return _u
+}
+
+// Mutation returns the OIDCStateMutation object of the builder.
+func (_u *OIDCStateUpdateOne) Mutation() *OIDCStateMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the OIDCStateUpdate builder.
+func (_u *OIDCStateUpdateOne) Where(ps ...predicate.OIDCState) *OIDCStateUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *OIDCStateUpdateOne) Select(field string, fields ...string) *OIDCStateUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated OIDCState entity.
+func (_u *OIDCStateUpdateOne) Save(ctx context.Context) (*OIDCState, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OIDCStateUpdateOne) SaveX(ctx context.Context) *OIDCState {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *OIDCStateUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OIDCStateUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OIDCStateUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if oidcstate.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized oidcstate.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := oidcstate.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OIDCStateUpdateOne) check() error {
+	if v, ok := _u.mutation.Provider(); ok {
+		if err := oidcstate.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "OIDCState.provider": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StateHash(); ok {
+		if err := oidcstate.StateHashValidator(v); err != nil {
+			return &ValidationError{Name: "state_hash", err: fmt.Errorf(`ent: validator failed for field "OIDCState.state_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Nonce(); ok {
+		if err := oidcstate.NonceValidator(v); err != nil {
+			return &ValidationError{Name: "nonce", err: fmt.Errorf(`ent: validator failed for field "OIDCState.nonce": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CodeVerifier(); ok {
+		if err := oidcstate.CodeVerifierValidator(v); err != nil {
+			return &ValidationError{Name: "code_verifier", err: fmt.Errorf(`ent: validator failed for field "OIDCState.code_verifier": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *OIDCStateUpdateOne) sqlSave(ctx context.Context) (_node *OIDCState, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oidcstate.Table, oidcstate.Columns, sqlgraph.NewFieldSpec(oidcstate.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OIDCState.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, oidcstate.FieldID)
+		for _, f := range fields {
+			if !oidcstate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != oidcstate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(oidcstate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(oidcstate.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(oidcstate.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(oidcstate.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StateHash(); ok {
+		_spec.SetField(oidcstate.FieldStateHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Nonce(); ok {
+		_spec.SetField(oidcstate.FieldNonce, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CodeVerifier(); ok {
+		_spec.SetField(oidcstate.FieldCodeVerifier, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(oidcstate.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UsedAt(); ok {
+		_spec.SetField(oidcstate.FieldUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UsedAtCleared() {
+		_spec.ClearField(oidcstate.FieldUsedAt, field.TypeTime)
+	}
+	_node = &OIDCState{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oidcstate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
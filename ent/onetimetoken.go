@@ -0,0 +1,236 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/liukeshao/echo-template/ent/onetimetoken"
+	"github.com/liukeshao/echo-template/ent/user"
+)
+
+// OneTimeToken is the model entity for the OneTimeToken schema.
+type OneTimeToken struct {
+	config `json:"-"`
+	// ID of the ent.
+	// 唯一标识符，ULID格式
+	ID string `json:"id,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 逻辑删除时间戳（毫秒），0表示未删除
+	DeletedAt int64 `json:"deleted_at,omitempty"`
+	// 关联的用户ID
+	UserID string `json:"user_id,omitempty"`
+	// 令牌用途：verify_email-邮箱验证，reset_password-重置密码，mfa_challenge-两步验证登录，magic_link-邮件链接登录，change_email-确认修改邮箱，revert_email-撤销修改邮箱，oidc_login-外部账户登录换取令牌
+	Purpose onetimetoken.Purpose `json:"purpose,omitempty"`
+	// 令牌的HMAC-SHA256哈希值
+	TokenHash string `json:"-"`
+	// 发起请求的客户端持有的随机值的HMAC-SHA256哈希，为空表示不绑定客户端
+	NonceHash string `json:"-"`
+	// 修改邮箱相关令牌关联的邮箱：确认修改时为新邮箱，撤销修改时为原邮箱
+	Email string `json:"email,omitempty"`
+	// 令牌过期时间
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// 令牌使用或作废时间，为空表示仍然有效
+	UsedAt *time.Time `json:"used_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the OneTimeTokenQuery when eager-loading is set.
+	Edges        OneTimeTokenEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// OneTimeTokenEdges holds the relations/edges for other nodes in the graph.
+type OneTimeTokenEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OneTimeTokenEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OneTimeToken) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case onetimetoken.FieldDeletedAt:
+			values[i] = new(sql.NullInt64)
+		case onetimetoken.FieldID, onetimetoken.FieldUserID, onetimetoken.FieldPurpose, onetimetoken.FieldTokenHash, onetimetoken.FieldNonceHash, onetimetoken.FieldEmail:
+			values[i] = new(sql.NullString)
+		case onetimetoken.FieldCreatedAt, onetimetoken.FieldUpdatedAt, onetimetoken.FieldExpiresAt, onetimetoken.FieldUsedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OneTimeToken fields.
+func (_m *OneTimeToken) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case onetimetoken.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case onetimetoken.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case onetimetoken.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case onetimetoken.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = value.Int64
+			}
+		case onetimetoken.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = value.String
+			}
+		case onetimetoken.FieldPurpose:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field purpose", values[i])
+			} else if value.Valid {
+				_m.Purpose = onetimetoken.Purpose(value.String)
+			}
+		case onetimetoken.FieldTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_hash", values[i])
+			} else if value.Valid {
+				_m.TokenHash = value.String
+			}
+		case onetimetoken.FieldNonceHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field nonce_hash", values[i])
+			} else if value.Valid {
+				_m.NonceHash = value.String
+			}
+		case onetimetoken.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				_m.Email = value.String
+			}
+		case onetimetoken.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Time
+			}
+		case onetimetoken.FieldUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field used_at", values[i])
+			} else if value.Valid {
+				_m.UsedAt = new(time.Time)
+				*_m.UsedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OneTimeToken.
+// This includes values selected through modifiers, order, etc.
+func (_m *OneTimeToken) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the OneTimeToken entity.
+func (_m *OneTimeToken) QueryUser() *UserQuery {
+	return NewOneTimeTokenClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this OneTimeToken.
+// Note that you need to call OneTimeToken.Unwrap() before calling this method if this OneTimeToken
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *OneTimeToken) Update() *OneTimeTokenUpdateOne {
+	return NewOneTimeTokenClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the OneTimeToken entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *OneTimeToken) Unwrap() *OneTimeToken {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OneTimeToken is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *OneTimeToken) String() string {
+	var builder strings.Builder
+	builder.WriteString("OneTimeToken(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DeletedAt))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(_m.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("purpose=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Purpose))
+	builder.WriteString(", ")
+	builder.WriteString("token_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("nonce_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("email=")
+	builder.WriteString(_m.Email)
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.UsedAt; v != nil {
+		builder.WriteString("used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OneTimeTokens is a parsable slice of OneTimeToken.
+type OneTimeTokens []*OneTimeToken
@@ -0,0 +1,430 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/liukeshao/echo-template/ent/onetimetoken"
+	"github.com/liukeshao/echo-template/ent/user"
+)
+
+// OneTimeTokenCreate is the builder for creating a OneTimeToken entity.
+type OneTimeTokenCreate struct {
+	config
+	mutation *OneTimeTokenMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *OneTimeTokenCreate) SetCreatedAt(v time.Time) *OneTimeTokenCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *OneTimeTokenCreate) SetNillableCreatedAt(v *time.Time) *OneTimeTokenCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *OneTimeTokenCreate) SetUpdatedAt(v time.Time) *OneTimeTokenCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *OneTimeTokenCreate) SetNillableUpdatedAt(v *time.Time) *OneTimeTokenCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *OneTimeTokenCreate) SetDeletedAt(v int64) *OneTimeTokenCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *OneTimeTokenCreate) SetNillableDeletedAt(v *int64) *OneTimeTokenCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *OneTimeTokenCreate) SetUserID(v string) *OneTimeTokenCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetPurpose sets the "purpose" field.
+func (_c *OneTimeTokenCreate) SetPurpose(v onetimetoken.Purpose) *OneTimeTokenCreate {
+	_c.mutation.SetPurpose(v)
+	return _c
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_c *OneTimeTokenCreate) SetTokenHash(v string) *OneTimeTokenCreate {
+	_c.mutation.SetTokenHash(v)
+	return _c
+}
+
+// SetNonceHash sets the "nonce_hash" field.
+func (_c *OneTimeTokenCreate) SetNonceHash(v string) *OneTimeTokenCreate {
+	_c.mutation.SetNonceHash(v)
+	return _c
+}
+
+// SetNillableNonceHash sets the "nonce_hash" field if the given value is not nil.
+func (_c *OneTimeTokenCreate) SetNillableNonceHash(v *string) *OneTimeTokenCreate {
+	if v != nil {
+		_c.SetNonceHash(*v)
+	}
+	return _c
+}
+
+// SetEmail sets the "email" field.
+func (_c *OneTimeTokenCreate) SetEmail(v string) *OneTimeTokenCreate {
+	_c.mutation.SetEmail(v)
+	return _c
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_c *OneTimeTokenCreate) SetNillableEmail(v *string) *OneTimeTokenCreate {
+	if v != nil {
+		_c.SetEmail(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *OneTimeTokenCreate) SetExpiresAt(v time.Time) *OneTimeTokenCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetUsedAt sets the "used_at" field.
+func (_c *OneTimeTokenCreate) SetUsedAt(v time.Time) *OneTimeTokenCreate {
+	_c.mutation.SetUsedAt(v)
+	return _c
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (_c *OneTimeTokenCreate) SetNillableUsedAt(v *time.Time) *OneTimeTokenCreate {
+	if v != nil {
+		_c.SetUsedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *OneTimeTokenCreate) SetID(v string) *OneTimeTokenCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *OneTimeTokenCreate) SetUser(v *User) *OneTimeTokenCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the OneTimeTokenMutation object of the builder.
+func (_c *OneTimeTokenCreate) Mutation() *OneTimeTokenMutation {
+	return _c.mutation
+}
+
+// Save creates the OneTimeToken in the database.
+func (_c *OneTimeTokenCreate) Save(ctx context.Context) (*OneTimeToken, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *OneTimeTokenCreate) SaveX(ctx context.Context) *OneTimeToken {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OneTimeTokenCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OneTimeTokenCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *OneTimeTokenCreate) defaults() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if onetimetoken.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized onetimetoken.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := onetimetoken.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if onetimetoken.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized onetimetoken.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := onetimetoken.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.DeletedAt(); !ok {
+		v := onetimetoken.DefaultDeletedAt
+		_c.mutation.SetDeletedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *OneTimeTokenCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "OneTimeToken.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "OneTimeToken.updated_at"`)}
+	}
+	if _, ok := _c.mutation.DeletedAt(); !ok {
+		return &ValidationError{Name: "deleted_at", err: errors.New(`ent: missing required field "OneTimeToken.deleted_at"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "OneTimeToken.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := onetimetoken.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "OneTimeToken.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Purpose(); !ok {
+		return &ValidationError{Name: "purpose", err: errors.New(`ent: missing required field "OneTimeToken.purpose"`)}
+	}
+	if v, ok := _c.mutation.Purpose(); ok {
+		if err := onetimetoken.PurposeValidator(v); err != nil {
+			return &ValidationError{Name: "purpose", err: fmt.Errorf(`ent: validator failed for field "OneTimeToken.purpose": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TokenHash(); !ok {
+		return &ValidationError{Name: "token_hash", err: errors.New(`ent: missing required field "OneTimeToken.token_hash"`)}
+	}
+	if v, ok := _c.mutation.TokenHash(); ok {
+		if err := onetimetoken.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "OneTimeToken.token_hash": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.NonceHash(); ok {
+		if err := onetimetoken.NonceHashValidator(v); err != nil {
+			return &ValidationError{Name: "nonce_hash", err: fmt.Errorf(`ent: validator failed for field "OneTimeToken.nonce_hash": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Email(); ok {
+		if err := onetimetoken.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "OneTimeToken.email": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "OneTimeToken.expires_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := onetimetoken.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "OneTimeToken.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "OneTimeToken.user"`)}
+	}
+	return nil
+}
+
+func (_c *OneTimeTokenCreate) sqlSave(ctx context.Context) (*OneTimeToken, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected OneTimeToken.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *OneTimeTokenCreate) createSpec() (*OneTimeToken, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OneTimeToken{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(onetimetoken.Table, sqlgraph.NewFieldSpec(onetimetoken.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(onetimetoken.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(onetimetoken.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(onetimetoken.FieldDeletedAt, field.TypeInt64, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := _c.mutation.Purpose(); ok {
+		_spec.SetField(onetimetoken.FieldPurpose, field.TypeEnum, value)
+		_node.Purpose = value
+	}
+	if value, ok := _c.mutation.TokenHash(); ok {
+		_spec.SetField(onetimetoken.FieldTokenHash, field.TypeString, value)
+		_node.TokenHash = value
+	}
+	if value, ok := _c.mutation.NonceHash(); ok {
+		_spec.SetField(onetimetoken.FieldNonceHash, field.TypeString, value)
+		_node.NonceHash = value
+	}
+	if value, ok := _c.mutation.Email(); ok {
+		_spec.SetField(onetimetoken.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(onetimetoken.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.UsedAt(); ok {
+		_spec.SetField(onetimetoken.FieldUsedAt, field.TypeTime, value)
+		_node.UsedAt = &value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   onetimetoken.UserTable,
+			Columns: []string{onetimetoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OneTimeTokenCreateBulk is the builder for creating many OneTimeToken entities in bulk.
+type OneTimeTokenCreateBulk struct {
+	config
+	err      error
+	builders []*OneTimeTokenCreate
+}
+
+// Save creates the OneTimeToken entities in the database.
+func (_c *OneTimeTokenCreateBulk) Save(ctx context.Context) ([]*OneTimeToken, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*OneTimeToken, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OneTimeTokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *OneTimeTokenCreateBulk) SaveX(ctx context.Context) []*OneTimeToken {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OneTimeTokenCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OneTimeTokenCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
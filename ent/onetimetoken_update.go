@@ -0,0 +1,717 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/liukeshao/echo-template/ent/onetimetoken"
+	"github.com/liukeshao/echo-template/ent/predicate"
+	"github.com/liukeshao/echo-template/ent/user"
+)
+
+// OneTimeTokenUpdate is the builder for updating OneTimeToken entities.
+type OneTimeTokenUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OneTimeTokenMutation
+}
+
+// Where appends a list predicates to the OneTimeTokenUpdate builder.
+func (_u *OneTimeTokenUpdate) Where(ps ...predicate.OneTimeToken) *OneTimeTokenUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OneTimeTokenUpdate) SetUpdatedAt(v time.Time) *OneTimeTokenUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *OneTimeTokenUpdate) SetDeletedAt(v int64) *OneTimeTokenUpdate {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *OneTimeTokenUpdate) SetNillableDeletedAt(v *int64) *OneTimeTokenUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *OneTimeTokenUpdate) AddDeletedAt(v int64) *OneTimeTokenUpdate {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *OneTimeTokenUpdate) SetUserID(v string) *OneTimeTokenUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *OneTimeTokenUpdate) SetNillableUserID(v *string) *OneTimeTokenUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetPurpose sets the "purpose" field.
+func (_u *OneTimeTokenUpdate) SetPurpose(v onetimetoken.Purpose) *OneTimeTokenUpdate {
+	_u.mutation.SetPurpose(v)
+	return _u
+}
+
+// SetNillablePurpose sets the "purpose" field if the given value is not nil.
+func (_u *OneTimeTokenUpdate) SetNillablePurpose(v *onetimetoken.Purpose) *OneTimeTokenUpdate {
+	if v != nil {
+		_u.SetPurpose(*v)
+	}
+	return _u
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_u *OneTimeTokenUpdate) SetTokenHash(v string) *OneTimeTokenUpdate {
+	_u.mutation.SetTokenHash(v)
+	return _u
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (_u *OneTimeTokenUpdate) SetNillableTokenHash(v *string) *OneTimeTokenUpdate {
+	if v != nil {
+		_u.SetTokenHash(*v)
+	}
+	return _u
+}
+
+// SetNonceHash sets the "nonce_hash" field.
+func (_u *OneTimeTokenUpdate) SetNonceHash(v string) *OneTimeTokenUpdate {
+	_u.mutation.SetNonceHash(v)
+	return _u
+}
+
+// SetNillableNonceHash sets the "nonce_hash" field if the given value is not nil.
+func (_u *OneTimeTokenUpdate) SetNillableNonceHash(v *string) *OneTimeTokenUpdate {
+	if v != nil {
+		_u.SetNonceHash(*v)
+	}
+	return _u
+}
+
+// ClearNonceHash clears the value of the "nonce_hash" field.
+func (_u *OneTimeTokenUpdate) ClearNonceHash() *OneTimeTokenUpdate {
+	_u.mutation.ClearNonceHash()
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *OneTimeTokenUpdate) SetEmail(v string) *OneTimeTokenUpdate {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *OneTimeTokenUpdate) SetNillableEmail(v *string) *OneTimeTokenUpdate {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// ClearEmail clears the value of the "email" field.
+func (_u *OneTimeTokenUpdate) ClearEmail() *OneTimeTokenUpdate {
+	_u.mutation.ClearEmail()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *OneTimeTokenUpdate) SetExpiresAt(v time.Time) *OneTimeTokenUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *OneTimeTokenUpdate) SetNillableExpiresAt(v *time.Time) *OneTimeTokenUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetUsedAt sets the "used_at" field.
+func (_u *OneTimeTokenUpdate) SetUsedAt(v time.Time) *OneTimeTokenUpdate {
+	_u.mutation.SetUsedAt(v)
+	return _u
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (_u *OneTimeTokenUpdate) SetNillableUsedAt(v *time.Time) *OneTimeTokenUpdate {
+	if v != nil {
+		_u.SetUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (_u *OneTimeTokenUpdate) ClearUsedAt() *OneTimeTokenUpdate {
+	_u.mutation.ClearUsedAt()
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *OneTimeTokenUpdate) SetUser(v *User) *OneTimeTokenUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the OneTimeTokenMutation object of the builder.
+func (_u *OneTimeTokenUpdate) Mutation() *OneTimeTokenMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *OneTimeTokenUpdate) ClearUser() *OneTimeTokenUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *OneTimeTokenUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OneTimeTokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *OneTimeTokenUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OneTimeTokenUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OneTimeTokenUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if onetimetoken.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized onetimetoken.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := onetimetoken.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OneTimeTokenUpdate) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := onetimetoken.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "OneTimeToken.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Purpose(); ok {
+		if err := onetimetoken.PurposeValidator(v); err != nil {
+			return &ValidationError{Name: "purpose", err: fmt.Errorf(`ent: validator failed for field "OneTimeToken.purpose": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TokenHash(); ok {
+		if err := onetimetoken.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "OneTimeToken.token_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.NonceHash(); ok {
+		if err := onetimetoken.NonceHashValidator(v); err != nil {
+			return &ValidationError{Name: "nonce_hash", err: fmt.Errorf(`ent: validator failed for field "OneTimeToken.nonce_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Email(); ok {
+		if err := onetimetoken.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "OneTimeToken.email": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OneTimeToken.user"`)
+	}
+	return nil
+}
+
+func (_u *OneTimeTokenUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(onetimetoken.Table, onetimetoken.Columns, sqlgraph.NewFieldSpec(onetimetoken.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(onetimetoken.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(onetimetoken.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(onetimetoken.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Purpose(); ok {
+		_spec.SetField(onetimetoken.FieldPurpose, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.TokenHash(); ok {
+		_spec.SetField(onetimetoken.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.NonceHash(); ok {
+		_spec.SetField(onetimetoken.FieldNonceHash, field.TypeString, value)
+	}
+	if _u.mutation.NonceHashCleared() {
+		_spec.ClearField(onetimetoken.FieldNonceHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(onetimetoken.FieldEmail, field.TypeString, value)
+	}
+	if _u.mutation.EmailCleared() {
+		_spec.ClearField(onetimetoken.FieldEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(onetimetoken.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UsedAt(); ok {
+		_spec.SetField(onetimetoken.FieldUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UsedAtCleared() {
+		_spec.ClearField(onetimetoken.FieldUsedAt, field.TypeTime)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   onetimetoken.UserTable,
+			Columns: []string{onetimetoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   onetimetoken.UserTable,
+			Columns: []string{onetimetoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{onetimetoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// OneTimeTokenUpdateOne is the builder for updating a single OneTimeToken entity.
+type OneTimeTokenUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OneTimeTokenMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OneTimeTokenUpdateOne) SetUpdatedAt(v time.Time) *OneTimeTokenUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *OneTimeTokenUpdateOne) SetDeletedAt(v int64) *OneTimeTokenUpdateOne {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *OneTimeTokenUpdateOne) SetNillableDeletedAt(v *int64) *OneTimeTokenUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *OneTimeTokenUpdateOne) AddDeletedAt(v int64) *OneTimeTokenUpdateOne {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *OneTimeTokenUpdateOne) SetUserID(v string) *OneTimeTokenUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *OneTimeTokenUpdateOne) SetNillableUserID(v *string) *OneTimeTokenUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetPurpose sets the "purpose" field.
+func (_u *OneTimeTokenUpdateOne) SetPurpose(v onetimetoken.Purpose) *OneTimeTokenUpdateOne {
+	_u.mutation.SetPurpose(v)
+	return _u
+}
+
+// SetNillablePurpose sets the "purpose" field if the given value is not nil.
+func (_u *OneTimeTokenUpdateOne) SetNillablePurpose(v *onetimetoken.Purpose) *OneTimeTokenUpdateOne {
+	if v != nil {
+		_u.SetPurpose(*v)
+	}
+	return _u
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_u *OneTimeTokenUpdateOne) SetTokenHash(v string) *OneTimeTokenUpdateOne {
+	_u.mutation.SetTokenHash(v)
+	return _u
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (_u *OneTimeTokenUpdateOne) SetNillableTokenHash(v *string) *OneTimeTokenUpdateOne {
+	if v != nil {
+		_u.SetTokenHash(*v)
+	}
+	return _u
+}
+
+// SetNonceHash sets the "nonce_hash" field.
+func (_u *OneTimeTokenUpdateOne) SetNonceHash(v string) *OneTimeTokenUpdateOne {
+	_u.mutation.SetNonceHash(v)
+	return _u
+}
+
+// SetNillableNonceHash sets the "nonce_hash" field if the given value is not nil.
+func (_u *OneTimeTokenUpdateOne) SetNillableNonceHash(v *string) *OneTimeTokenUpdateOne {
+	if v != nil {
+		_u.SetNonceHash(*v)
+	}
+	return _u
+}
+
+// ClearNonceHash clears the value of the "nonce_hash" field.
+func (_u *OneTimeTokenUpdateOne) ClearNonceHash() *OneTimeTokenUpdateOne {
+	_u.mutation.ClearNonceHash()
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *OneTimeTokenUpdateOne) SetEmail(v string) *OneTimeTokenUpdateOne {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *OneTimeTokenUpdateOne) SetNillableEmail(v *string) *OneTimeTokenUpdateOne {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// ClearEmail clears the value of the "email" field.
+func (_u *OneTimeTokenUpdateOne) ClearEmail() *OneTimeTokenUpdateOne {
+	_u.mutation.ClearEmail()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *OneTimeTokenUpdateOne) SetExpiresAt(v time.Time) *OneTimeTokenUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *OneTimeTokenUpdateOne) SetNillableExpiresAt(v *time.Time) *OneTimeTokenUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetUsedAt sets the "used_at" field.
+func (_u *OneTimeTokenUpdateOne) SetUsedAt(v time.Time) *OneTimeTokenUpdateOne {
+	_u.mutation.SetUsedAt(v)
+	return _u
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (_u *OneTimeTokenUpdateOne) SetNillableUsedAt(v *time.Time) *OneTimeTokenUpdateOne {
+	if v != nil {
+		_u.SetUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (_u *OneTimeTokenUpdateOne) ClearUsedAt() *OneTimeTokenUpdateOne {
+	_u.mutation.ClearUsedAt()
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *OneTimeTokenUpdateOne) SetUser(v *User) *OneTimeTokenUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the OneTimeTokenMutation object of the builder.
+func (_u *OneTimeTokenUpdateOne) Mutation() *OneTimeTokenMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *OneTimeTokenUpdateOne) ClearUser() *OneTimeTokenUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the OneTimeTokenUpdate builder.
+func (_u *OneTimeTokenUpdateOne) Where(ps ...predicate.OneTimeToken) *OneTimeTokenUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *OneTimeTokenUpdateOne) Select(field string, fields ...string) *OneTimeTokenUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated OneTimeToken entity.
+func (_u *OneTimeTokenUpdateOne) Save(ctx context.Context) (*OneTimeToken, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OneTimeTokenUpdateOne) SaveX(ctx context.Context) *OneTimeToken {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *OneTimeTokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OneTimeTokenUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OneTimeTokenUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if onetimetoken.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized onetimetoken.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := onetimetoken.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OneTimeTokenUpdateOne) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := onetimetoken.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "OneTimeToken.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Purpose(); ok {
+		if err := onetimetoken.PurposeValidator(v); err != nil {
+			return &ValidationError{Name: "purpose", err: fmt.Errorf(`ent: validator failed for field "OneTimeToken.purpose": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TokenHash(); ok {
+		if err := onetimetoken.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "OneTimeToken.token_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.NonceHash(); ok {
+		if err := onetimetoken.NonceHashValidator(v); err != nil {
+			return &ValidationError{Name: "nonce_hash", err: fmt.Errorf(`ent: validator failed for field "OneTimeToken.nonce_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Email(); ok {
+		if err := onetimetoken.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "OneTimeToken.email": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OneTimeToken.user"`)
+	}
+	return nil
+}
+
+func (_u *OneTimeTokenUpdateOne) sqlSave(ctx context.Context) (_node *OneTimeToken, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(onetimetoken.Table, onetimetoken.Columns, sqlgraph.NewFieldSpec(onetimetoken.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OneTimeToken.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, onetimetoken.FieldID)
+		for _, f := range fields {
+			if !onetimetoken.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != onetimetoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(onetimetoken.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(onetimetoken.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(onetimetoken.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Purpose(); ok {
+		_spec.SetField(onetimetoken.FieldPurpose, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.TokenHash(); ok {
+		_spec.SetField(onetimetoken.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.NonceHash(); ok {
+		_spec.SetField(onetimetoken.FieldNonceHash, field.TypeString, value)
+	}
+	if _u.mutation.NonceHashCleared() {
+		_spec.ClearField(onetimetoken.FieldNonceHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(onetimetoken.FieldEmail, field.TypeString, value)
+	}
+	if _u.mutation.EmailCleared() {
+		_spec.ClearField(onetimetoken.FieldEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(onetimetoken.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UsedAt(); ok {
+		_spec.SetField(onetimetoken.FieldUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UsedAtCleared() {
+		_spec.ClearField(onetimetoken.FieldUsedAt, field.TypeTime)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   onetimetoken.UserTable,
+			Columns: []string{onetimetoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   onetimetoken.UserTable,
+			Columns: []string{onetimetoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &OneTimeToken{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{onetimetoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,289 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/liukeshao/echo-template/ent/passkey"
+	"github.com/liukeshao/echo-template/ent/user"
+)
+
+// Passkey is the model entity for the Passkey schema.
+type Passkey struct {
+	config `json:"-"`
+	// ID of the ent.
+	// 唯一标识符，ULID格式
+	ID string `json:"id,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 逻辑删除时间戳（毫秒），0表示未删除
+	DeletedAt int64 `json:"deleted_at,omitempty"`
+	// 关联的用户ID
+	UserID string `json:"user_id,omitempty"`
+	// 凭据名称，便于用户区分不同设备
+	Name string `json:"name,omitempty"`
+	// 认证器生成的凭据ID，base64url编码
+	CredentialID string `json:"credential_id,omitempty"`
+	// COSE格式的凭据公钥
+	PublicKey []byte `json:"public_key,omitempty"`
+	// 注册时的证明格式
+	AttestationType string `json:"attestation_type,omitempty"`
+	// 认证器型号标识
+	Aaguid []byte `json:"aaguid,omitempty"`
+	// 认证器支持的传输方式：usb/nfc/ble/internal/hybrid
+	Transports []string `json:"transports,omitempty"`
+	// 认证器签名计数，用于检测克隆的认证器
+	SignCount uint32 `json:"sign_count,omitempty"`
+	// 凭据是否可以在设备间同步
+	BackupEligible bool `json:"backup_eligible,omitempty"`
+	// 凭据是否已经同步到其他设备
+	BackupState bool `json:"backup_state,omitempty"`
+	// 最后一次用于登录的时间
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PasskeyQuery when eager-loading is set.
+	Edges        PasskeyEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PasskeyEdges holds the relations/edges for other nodes in the graph.
+type PasskeyEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PasskeyEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Passkey) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case passkey.FieldPublicKey, passkey.FieldAaguid, passkey.FieldTransports:
+			values[i] = new([]byte)
+		case passkey.FieldBackupEligible, passkey.FieldBackupState:
+			values[i] = new(sql.NullBool)
+		case passkey.FieldDeletedAt, passkey.FieldSignCount:
+			values[i] = new(sql.NullInt64)
+		case passkey.FieldID, passkey.FieldUserID, passkey.FieldName, passkey.FieldCredentialID, passkey.FieldAttestationType:
+			values[i] = new(sql.NullString)
+		case passkey.FieldCreatedAt, passkey.FieldUpdatedAt, passkey.FieldLastUsedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Passkey fields.
+func (_m *Passkey) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case passkey.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case passkey.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case passkey.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case passkey.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = value.Int64
+			}
+		case passkey.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = value.String
+			}
+		case passkey.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case passkey.FieldCredentialID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field credential_id", values[i])
+			} else if value.Valid {
+				_m.CredentialID = value.String
+			}
+		case passkey.FieldPublicKey:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field public_key", values[i])
+			} else if value != nil {
+				_m.PublicKey = *value
+			}
+		case passkey.FieldAttestationType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field attestation_type", values[i])
+			} else if value.Valid {
+				_m.AttestationType = value.String
+			}
+		case passkey.FieldAaguid:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field aaguid", values[i])
+			} else if value != nil {
+				_m.Aaguid = *value
+			}
+		case passkey.FieldTransports:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field transports", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Transports); err != nil {
+					return fmt.Errorf("unmarshal field transports: %w", err)
+				}
+			}
+		case passkey.FieldSignCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sign_count", values[i])
+			} else if value.Valid {
+				_m.SignCount = uint32(value.Int64)
+			}
+		case passkey.FieldBackupEligible:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field backup_eligible", values[i])
+			} else if value.Valid {
+				_m.BackupEligible = value.Bool
+			}
+		case passkey.FieldBackupState:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field backup_state", values[i])
+			} else if value.Valid {
+				_m.BackupState = value.Bool
+			}
+		case passkey.FieldLastUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_at", values[i])
+			} else if value.Valid {
+				_m.LastUsedAt = new(time.Time)
+				*_m.LastUsedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Passkey.
+// This includes values selected through modifiers, order, etc.
+func (_m *Passkey) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the Passkey entity.
+func (_m *Passkey) QueryUser() *UserQuery {
+	return NewPasskeyClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this Passkey.
+// Note that you need to call Passkey.Unwrap() before calling this method if this Passkey
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Passkey) Update() *PasskeyUpdateOne {
+	return NewPasskeyClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Passkey entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Passkey) Unwrap() *Passkey {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Passkey is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Passkey) String() string {
+	var builder strings.Builder
+	builder.WriteString("Passkey(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DeletedAt))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(_m.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("credential_id=")
+	builder.WriteString(_m.CredentialID)
+	builder.WriteString(", ")
+	builder.WriteString("public_key=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PublicKey))
+	builder.WriteString(", ")
+	builder.WriteString("attestation_type=")
+	builder.WriteString(_m.AttestationType)
+	builder.WriteString(", ")
+	builder.WriteString("aaguid=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Aaguid))
+	builder.WriteString(", ")
+	builder.WriteString("transports=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Transports))
+	builder.WriteString(", ")
+	builder.WriteString("sign_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SignCount))
+	builder.WriteString(", ")
+	builder.WriteString("backup_eligible=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BackupEligible))
+	builder.WriteString(", ")
+	builder.WriteString("backup_state=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BackupState))
+	builder.WriteString(", ")
+	if v := _m.LastUsedAt; v != nil {
+		builder.WriteString("last_used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Passkeys is a parsable slice of Passkey.
+type Passkeys []*Passkey
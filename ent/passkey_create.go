@@ -0,0 +1,507 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/liukeshao/echo-template/ent/passkey"
+	"github.com/liukeshao/echo-template/ent/user"
+)
+
+// PasskeyCreate is the builder for creating a Passkey entity.
+type PasskeyCreate struct {
+	config
+	mutation *PasskeyMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PasskeyCreate) SetCreatedAt(v time.Time) *PasskeyCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PasskeyCreate) SetNillableCreatedAt(v *time.Time) *PasskeyCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *PasskeyCreate) SetUpdatedAt(v time.Time) *PasskeyCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *PasskeyCreate) SetNillableUpdatedAt(v *time.Time) *PasskeyCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *PasskeyCreate) SetDeletedAt(v int64) *PasskeyCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *PasskeyCreate) SetNillableDeletedAt(v *int64) *PasskeyCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *PasskeyCreate) SetUserID(v string) *PasskeyCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *PasskeyCreate) SetName(v string) *PasskeyCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetCredentialID sets the "credential_id" field.
+func (_c *PasskeyCreate) SetCredentialID(v string) *PasskeyCreate {
+	_c.mutation.SetCredentialID(v)
+	return _c
+}
+
+// SetPublicKey sets the "public_key" field.
+func (_c *PasskeyCreate) SetPublicKey(v []byte) *PasskeyCreate {
+	_c.mutation.SetPublicKey(v)
+	return _c
+}
+
+// SetAttestationType sets the "attestation_type" field.
+func (_c *PasskeyCreate) SetAttestationType(v string) *PasskeyCreate {
+	_c.mutation.SetAttestationType(v)
+	return _c
+}
+
+// SetNillableAttestationType sets the "attestation_type" field if the given value is not nil.
+func (_c *PasskeyCreate) SetNillableAttestationType(v *string) *PasskeyCreate {
+	if v != nil {
+		_c.SetAttestationType(*v)
+	}
+	return _c
+}
+
+// SetAaguid sets the "aaguid" field.
+func (_c *PasskeyCreate) SetAaguid(v []byte) *PasskeyCreate {
+	_c.mutation.SetAaguid(v)
+	return _c
+}
+
+// SetTransports sets the "transports" field.
+func (_c *PasskeyCreate) SetTransports(v []string) *PasskeyCreate {
+	_c.mutation.SetTransports(v)
+	return _c
+}
+
+// SetSignCount sets the "sign_count" field.
+func (_c *PasskeyCreate) SetSignCount(v uint32) *PasskeyCreate {
+	_c.mutation.SetSignCount(v)
+	return _c
+}
+
+// SetNillableSignCount sets the "sign_count" field if the given value is not nil.
+func (_c *PasskeyCreate) SetNillableSignCount(v *uint32) *PasskeyCreate {
+	if v != nil {
+		_c.SetSignCount(*v)
+	}
+	return _c
+}
+
+// SetBackupEligible sets the "backup_eligible" field.
+func (_c *PasskeyCreate) SetBackupEligible(v bool) *PasskeyCreate {
+	_c.mutation.SetBackupEligible(v)
+	return _c
+}
+
+// SetNillableBackupEligible sets the "backup_eligible" field if the given value is not nil.
+func (_c *PasskeyCreate) SetNillableBackupEligible(v *bool) *PasskeyCreate {
+	if v != nil {
+		_c.SetBackupEligible(*v)
+	}
+	return _c
+}
+
+// SetBackupState sets the "backup_state" field.
+func (_c *PasskeyCreate) SetBackupState(v bool) *PasskeyCreate {
+	_c.mutation.SetBackupState(v)
+	return _c
+}
+
+// SetNillableBackupState sets the "backup_state" field if the given value is not nil.
+func (_c *PasskeyCreate) SetNillableBackupState(v *bool) *PasskeyCreate {
+	if v != nil {
+		_c.SetBackupState(*v)
+	}
+	return _c
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_c *PasskeyCreate) SetLastUsedAt(v time.Time) *PasskeyCreate {
+	_c.mutation.SetLastUsedAt(v)
+	return _c
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_c *PasskeyCreate) SetNillableLastUsedAt(v *time.Time) *PasskeyCreate {
+	if v != nil {
+		_c.SetLastUsedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PasskeyCreate) SetID(v string) *PasskeyCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *PasskeyCreate) SetUser(v *User) *PasskeyCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the PasskeyMutation object of the builder.
+func (_c *PasskeyCreate) Mutation() *PasskeyMutation {
+	return _c.mutation
+}
+
+// Save creates the Passkey in the database.
+func (_c *PasskeyCreate) Save(ctx context.Context) (*Passkey, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PasskeyCreate) SaveX(ctx context.Context) *Passkey {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PasskeyCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PasskeyCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PasskeyCreate) defaults() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if passkey.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized passkey.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := passkey.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if passkey.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized passkey.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := passkey.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.DeletedAt(); !ok {
+		v := passkey.DefaultDeletedAt
+		_c.mutation.SetDeletedAt(v)
+	}
+	if _, ok := _c.mutation.SignCount(); !ok {
+		v := passkey.DefaultSignCount
+		_c.mutation.SetSignCount(v)
+	}
+	if _, ok := _c.mutation.BackupEligible(); !ok {
+		v := passkey.DefaultBackupEligible
+		_c.mutation.SetBackupEligible(v)
+	}
+	if _, ok := _c.mutation.BackupState(); !ok {
+		v := passkey.DefaultBackupState
+		_c.mutation.SetBackupState(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PasskeyCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Passkey.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Passkey.updated_at"`)}
+	}
+	if _, ok := _c.mutation.DeletedAt(); !ok {
+		return &ValidationError{Name: "deleted_at", err: errors.New(`ent: missing required field "Passkey.deleted_at"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Passkey.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := passkey.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Passkey.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Passkey.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := passkey.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Passkey.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CredentialID(); !ok {
+		return &ValidationError{Name: "credential_id", err: errors.New(`ent: missing required field "Passkey.credential_id"`)}
+	}
+	if v, ok := _c.mutation.CredentialID(); ok {
+		if err := passkey.CredentialIDValidator(v); err != nil {
+			return &ValidationError{Name: "credential_id", err: fmt.Errorf(`ent: validator failed for field "Passkey.credential_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PublicKey(); !ok {
+		return &ValidationError{Name: "public_key", err: errors.New(`ent: missing required field "Passkey.public_key"`)}
+	}
+	if v, ok := _c.mutation.PublicKey(); ok {
+		if err := passkey.PublicKeyValidator(v); err != nil {
+			return &ValidationError{Name: "public_key", err: fmt.Errorf(`ent: validator failed for field "Passkey.public_key": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.AttestationType(); ok {
+		if err := passkey.AttestationTypeValidator(v); err != nil {
+			return &ValidationError{Name: "attestation_type", err: fmt.Errorf(`ent: validator failed for field "Passkey.attestation_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SignCount(); !ok {
+		return &ValidationError{Name: "sign_count", err: errors.New(`ent: missing required field "Passkey.sign_count"`)}
+	}
+	if _, ok := _c.mutation.BackupEligible(); !ok {
+		return &ValidationError{Name: "backup_eligible", err: errors.New(`ent: missing required field "Passkey.backup_eligible"`)}
+	}
+	if _, ok := _c.mutation.BackupState(); !ok {
+		return &ValidationError{Name: "backup_state", err: errors.New(`ent: missing required field "Passkey.backup_state"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := passkey.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Passkey.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Passkey.user"`)}
+	}
+	return nil
+}
+
+func (_c *PasskeyCreate) sqlSave(ctx context.Context) (*Passkey, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Passkey.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PasskeyCreate) createSpec() (*Passkey, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Passkey{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(passkey.Table, sqlgraph.NewFieldSpec(passkey.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(passkey.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(passkey.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(passkey.FieldDeletedAt, field.TypeInt64, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(passkey.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.CredentialID(); ok {
+		_spec.SetField(passkey.FieldCredentialID, field.TypeString, value)
+		_node.CredentialID = value
+	}
+	if value, ok := _c.mutation.PublicKey(); ok {
+		_spec.SetField(passkey.FieldPublicKey, field.TypeBytes, value)
+		_node.PublicKey = value
+	}
+	if value, ok := _c.mutation.AttestationType(); ok {
+		_spec.SetField(passkey.FieldAttestationType, field.TypeString, value)
+		_node.AttestationType = value
+	}
+	if value, ok := _c.mutation.Aaguid(); ok {
+		_spec.SetField(passkey.FieldAaguid, field.TypeBytes, value)
+		_node.Aaguid = value
+	}
+	if value, ok := _c.mutation.Transports(); ok {
+		_spec.SetField(passkey.FieldTransports, field.TypeJSON, value)
+		_node.Transports = value
+	}
+	if value, ok := _c.mutation.SignCount(); ok {
+		_spec.SetField(passkey.FieldSignCount, field.TypeUint32, value)
+		_node.SignCount = value
+	}
+	if value, ok := _c.mutation.BackupEligible(); ok {
+		_spec.SetField(passkey.FieldBackupEligible, field.TypeBool, value)
+		_node.BackupEligible = value
+	}
+	if value, ok := _c.mutation.BackupState(); ok {
+		_spec.SetField(passkey.FieldBackupState, field.TypeBool, value)
+		_node.BackupState = value
+	}
+	if value, ok := _c.mutation.LastUsedAt(); ok {
+		_spec.SetField(passkey.FieldLastUsedAt, field.TypeTime, value)
+		_node.LastUsedAt = &value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   passkey.UserTable,
+			Columns: []string{passkey.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PasskeyCreateBulk is the builder for creating many Passkey entities in bulk.
+type PasskeyCreateBulk struct {
+	config
+	err      error
+	builders []*PasskeyCreate
+}
+
+// Save creates the Passkey entities in the database.
+func (_c *PasskeyCreateBulk) Save(ctx context.Context) ([]*Passkey, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Passkey, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PasskeyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PasskeyCreateBulk) SaveX(ctx context.Context) []*Passkey {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PasskeyCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PasskeyCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,866 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/liukeshao/echo-template/ent/passkey"
+	"github.com/liukeshao/echo-template/ent/predicate"
+	"github.com/liukeshao/echo-template/ent/user"
+)
+
+// PasskeyUpdate is the builder for updating Passkey entities.
+type PasskeyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PasskeyMutation
+}
+
+// Where appends a list predicates to the PasskeyUpdate builder.
+func (_u *PasskeyUpdate) Where(ps ...predicate.Passkey) *PasskeyUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PasskeyUpdate) SetUpdatedAt(v time.Time) *PasskeyUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *PasskeyUpdate) SetDeletedAt(v int64) *PasskeyUpdate {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *PasskeyUpdate) SetNillableDeletedAt(v *int64) *PasskeyUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *PasskeyUpdate) AddDeletedAt(v int64) *PasskeyUpdate {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *PasskeyUpdate) SetUserID(v string) *PasskeyUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *PasskeyUpdate) SetNillableUserID(v *string) *PasskeyUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *PasskeyUpdate) SetName(v string) *PasskeyUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *PasskeyUpdate) SetNillableName(v *string) *PasskeyUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetCredentialID sets the "credential_id" field.
+func (_u *PasskeyUpdate) SetCredentialID(v string) *PasskeyUpdate {
+	_u.mutation.SetCredentialID(v)
+	return _u
+}
+
+// SetNillableCredentialID sets the "credential_id" field if the given value is not nil.
+func (_u *PasskeyUpdate) SetNillableCredentialID(v *string) *PasskeyUpdate {
+	if v != nil {
+		_u.SetCredentialID(*v)
+	}
+	return _u
+}
+
+// SetPublicKey sets the "public_key" field.
+func (_u *PasskeyUpdate) SetPublicKey(v []byte) *PasskeyUpdate {
+	_u.mutation.SetPublicKey(v)
+	return _u
+}
+
+// SetAttestationType sets the "attestation_type" field.
+func (_u *PasskeyUpdate) SetAttestationType(v string) *PasskeyUpdate {
+	_u.mutation.SetAttestationType(v)
+	return _u
+}
+
+// SetNillableAttestationType sets the "attestation_type" field if the given value is not nil.
+func (_u *PasskeyUpdate) SetNillableAttestationType(v *string) *PasskeyUpdate {
+	if v != nil {
+		_u.SetAttestationType(*v)
+	}
+	return _u
+}
+
+// ClearAttestationType clears the value of the "attestation_type" field.
+func (_u *PasskeyUpdate) ClearAttestationType() *PasskeyUpdate {
+	_u.mutation.ClearAttestationType()
+	return _u
+}
+
+// SetAaguid sets the "aaguid" field.
+func (_u *PasskeyUpdate) SetAaguid(v []byte) *PasskeyUpdate {
+	_u.mutation.SetAaguid(v)
+	return _u
+}
+
+// ClearAaguid clears the value of the "aaguid" field.
+func (_u *PasskeyUpdate) ClearAaguid() *PasskeyUpdate {
+	_u.mutation.ClearAaguid()
+	return _u
+}
+
+// SetTransports sets the "transports" field.
+func (_u *PasskeyUpdate) SetTransports(v []string) *PasskeyUpdate {
+	_u.mutation.SetTransports(v)
+	return _u
+}
+
+// AppendTransports appends value to the "transports" field.
+func (_u *PasskeyUpdate) AppendTransports(v []string) *PasskeyUpdate {
+	_u.mutation.AppendTransports(v)
+	return _u
+}
+
+// ClearTransports clears the value of the "transports" field.
+func (_u *PasskeyUpdate) ClearTransports() *PasskeyUpdate {
+	_u.mutation.ClearTransports()
+	return _u
+}
+
+// SetSignCount sets the "sign_count" field.
+func (_u *PasskeyUpdate) SetSignCount(v uint32) *PasskeyUpdate {
+	_u.mutation.ResetSignCount()
+	_u.mutation.SetSignCount(v)
+	return _u
+}
+
+// SetNillableSignCount sets the "sign_count" field if the given value is not nil.
+func (_u *PasskeyUpdate) SetNillableSignCount(v *uint32) *PasskeyUpdate {
+	if v != nil {
+		_u.SetSignCount(*v)
+	}
+	return _u
+}
+
+// AddSignCount adds value to the "sign_count" field.
+func (_u *PasskeyUpdate) AddSignCount(v int32) *PasskeyUpdate {
+	_u.mutation.AddSignCount(v)
+	return _u
+}
+
+// SetBackupEligible sets the "backup_eligible" field.
+func (_u *PasskeyUpdate) SetBackupEligible(v bool) *PasskeyUpdate {
+	_u.mutation.SetBackupEligible(v)
+	return _u
+}
+
+// SetNillableBackupEligible sets the "backup_eligible" field if the given value is not nil.
+func (_u *PasskeyUpdate) SetNillableBackupEligible(v *bool) *PasskeyUpdate {
+	if v != nil {
+		_u.SetBackupEligible(*v)
+	}
+	return _u
+}
+
+// SetBackupState sets the "backup_state" field.
+func (_u *PasskeyUpdate) SetBackupState(v bool) *PasskeyUpdate {
+	_u.mutation.SetBackupState(v)
+	return _u
+}
+
+// SetNillableBackupState sets the "backup_state" field if the given value is not nil.
+func (_u *PasskeyUpdate) SetNillableBackupState(v *bool) *PasskeyUpdate {
+	if v != nil {
+		_u.SetBackupState(*v)
+	}
+	return _u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_u *PasskeyUpdate) SetLastUsedAt(v time.Time) *PasskeyUpdate {
+	_u.mutation.SetLastUsedAt(v)
+	return _u
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_u *PasskeyUpdate) SetNillableLastUsedAt(v *time.Time) *PasskeyUpdate {
+	if v != nil {
+		_u.SetLastUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (_u *PasskeyUpdate) ClearLastUsedAt() *PasskeyUpdate {
+	_u.mutation.ClearLastUsedAt()
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *PasskeyUpdate) SetUser(v *User) *PasskeyUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the PasskeyMutation object of the builder.
+func (_u *PasskeyUpdate) Mutation() *PasskeyMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *PasskeyUpdate) ClearUser() *PasskeyUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PasskeyUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PasskeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PasskeyUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PasskeyUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PasskeyUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if passkey.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized passkey.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := passkey.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PasskeyUpdate) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := passkey.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Passkey.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := passkey.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Passkey.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CredentialID(); ok {
+		if err := passkey.CredentialIDValidator(v); err != nil {
+			return &ValidationError{Name: "credential_id", err: fmt.Errorf(`ent: validator failed for field "Passkey.credential_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PublicKey(); ok {
+		if err := passkey.PublicKeyValidator(v); err != nil {
+			return &ValidationError{Name: "public_key", err: fmt.Errorf(`ent: validator failed for field "Passkey.public_key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AttestationType(); ok {
+		if err := passkey.AttestationTypeValidator(v); err != nil {
+			return &ValidationError{Name: "attestation_type", err: fmt.Errorf(`ent: validator failed for field "Passkey.attestation_type": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Passkey.user"`)
+	}
+	return nil
+}
+
+func (_u *PasskeyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(passkey.Table, passkey.Columns, sqlgraph.NewFieldSpec(passkey.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(passkey.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(passkey.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(passkey.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(passkey.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CredentialID(); ok {
+		_spec.SetField(passkey.FieldCredentialID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PublicKey(); ok {
+		_spec.SetField(passkey.FieldPublicKey, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.AttestationType(); ok {
+		_spec.SetField(passkey.FieldAttestationType, field.TypeString, value)
+	}
+	if _u.mutation.AttestationTypeCleared() {
+		_spec.ClearField(passkey.FieldAttestationType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Aaguid(); ok {
+		_spec.SetField(passkey.FieldAaguid, field.TypeBytes, value)
+	}
+	if _u.mutation.AaguidCleared() {
+		_spec.ClearField(passkey.FieldAaguid, field.TypeBytes)
+	}
+	if value, ok := _u.mutation.Transports(); ok {
+		_spec.SetField(passkey.FieldTransports, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTransports(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, passkey.FieldTransports, value)
+		})
+	}
+	if _u.mutation.TransportsCleared() {
+		_spec.ClearField(passkey.FieldTransports, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.SignCount(); ok {
+		_spec.SetField(passkey.FieldSignCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedSignCount(); ok {
+		_spec.AddField(passkey.FieldSignCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.BackupEligible(); ok {
+		_spec.SetField(passkey.FieldBackupEligible, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.BackupState(); ok {
+		_spec.SetField(passkey.FieldBackupState, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.LastUsedAt(); ok {
+		_spec.SetField(passkey.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastUsedAtCleared() {
+		_spec.ClearField(passkey.FieldLastUsedAt, field.TypeTime)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   passkey.UserTable,
+			Columns: []string{passkey.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   passkey.UserTable,
+			Columns: []string{passkey.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{passkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PasskeyUpdateOne is the builder for updating a single Passkey entity.
+type PasskeyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PasskeyMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PasskeyUpdateOne) SetUpdatedAt(v time.Time) *PasskeyUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *PasskeyUpdateOne) SetDeletedAt(v int64) *PasskeyUpdateOne {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *PasskeyUpdateOne) SetNillableDeletedAt(v *int64) *PasskeyUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *PasskeyUpdateOne) AddDeletedAt(v int64) *PasskeyUpdateOne {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *PasskeyUpdateOne) SetUserID(v string) *PasskeyUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *PasskeyUpdateOne) SetNillableUserID(v *string) *PasskeyUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *PasskeyUpdateOne) SetName(v string) *PasskeyUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *PasskeyUpdateOne) SetNillableName(v *string) *PasskeyUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetCredentialID sets the "credential_id" field.
+func (_u *PasskeyUpdateOne) SetCredentialID(v string) *PasskeyUpdateOne {
+	_u.mutation.SetCredentialID(v)
+	return _u
+}
+
+// SetNillableCredentialID sets the "credential_id" field if the given value is not nil.
+func (_u *PasskeyUpdateOne) SetNillableCredentialID(v *string) *PasskeyUpdateOne {
+	if v != nil {
+		_u.SetCredentialID(*v)
+	}
+	return _u
+}
+
+// SetPublicKey sets the "public_key" field.
+func (_u *PasskeyUpdateOne) SetPublicKey(v []byte) *PasskeyUpdateOne {
+	_u.mutation.SetPublicKey(v)
+	return _u
+}
+
+// SetAttestationType sets the "attestation_type" field.
+func (_u *PasskeyUpdateOne) SetAttestationType(v string) *PasskeyUpdateOne {
+	_u.mutation.SetAttestationType(v)
+	return _u
+}
+
+// SetNillableAttestationType sets the "attestation_type" field if the given value is not nil.
+func (_u *PasskeyUpdateOne) SetNillableAttestationType(v *string) *PasskeyUpdateOne {
+	if v != nil {
+		_u.SetAttestationType(*v)
+	}
+	return _u
+}
+
+// ClearAttestationType clears the value of the "attestation_type" field.
+func (_u *PasskeyUpdateOne) ClearAttestationType() *PasskeyUpdateOne {
+	_u.mutation.ClearAttestationType()
+	return _u
+}
+
+// SetAaguid sets the "aaguid" field.
+func (_u *PasskeyUpdateOne) SetAaguid(v []byte) *PasskeyUpdateOne {
+	_u.mutation.SetAaguid(v)
+	return _u
+}
+
+// ClearAaguid clears the value of the "aaguid" field.
+func (_u *PasskeyUpdateOne) ClearAaguid() *PasskeyUpdateOne {
+	_u.mutation.ClearAaguid()
+	return _u
+}
+
+// SetTransports sets the "transports" field.
+func (_u *PasskeyUpdateOne) SetTransports(v []string) *PasskeyUpdateOne {
+	_u.mutation.SetTransports(v)
+	return _u
+}
+
+// AppendTransports appends value to the "transports" field.
+func (_u *PasskeyUpdateOne) AppendTransports(v []string) *PasskeyUpdateOne {
+	_u.mutation.AppendTransports(v)
+	return _u
+}
+
+// ClearTransports clears the value of the "transports" field.
+func (_u *PasskeyUpdateOne) ClearTransports() *PasskeyUpdateOne {
+	_u.mutation.ClearTransports()
+	return _u
+}
+
+// SetSignCount sets the "sign_count" field.
+func (_u *PasskeyUpdateOne) SetSignCount(v uint32) *PasskeyUpdateOne {
+	_u.mutation.ResetSignCount()
+	_u.mutation.SetSignCount(v)
+	return _u
+}
+
+// SetNillableSignCount sets the "sign_count" field if the given value is not nil.
+func (_u *PasskeyUpdateOne) SetNillableSignCount(v *uint32) *PasskeyUpdateOne {
+	if v != nil {
+		_u.SetSignCount(*v)
+	}
+	return _u
+}
+
+// AddSignCount adds value to the "sign_count" field.
+func (_u *PasskeyUpdateOne) AddSignCount(v int32) *PasskeyUpdateOne {
+	_u.mutation.AddSignCount(v)
+	return _u
+}
+
+// SetBackupEligible sets the "backup_eligible" field.
+func (_u *PasskeyUpdateOne) SetBackupEligible(v bool) *PasskeyUpdateOne {
+	_u.mutation.SetBackupEligible(v)
+	return _u
+}
+
+// SetNillableBackupEligible sets the "backup_eligible" field if the given value is not nil.
+func (_u *PasskeyUpdateOne) SetNillableBackupEligible(v *bool) *PasskeyUpdateOne {
+	if v != nil {
+		_u.SetBackupEligible(*v)
+	}
+	return _u
+}
+
+// SetBackupState sets the "backup_state" field.
+func (_u *PasskeyUpdateOne) SetBackupState(v bool) *PasskeyUpdateOne {
+	_u.mutation.SetBackupState(v)
+	return _u
+}
+
+// SetNillableBackupState sets the "backup_state" field if the given value is not nil.
+func (_u *PasskeyUpdateOne) SetNillableBackupState(v *bool) *PasskeyUpdateOne {
+	if v != nil {
+		_u.SetBackupState(*v)
+	}
+	return _u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_u *PasskeyUpdateOne) SetLastUsedAt(v time.Time) *PasskeyUpdateOne {
+	_u.mutation.SetLastUsedAt(v)
+	return _u
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_u *PasskeyUpdateOne) SetNillableLastUsedAt(v *time.Time) *PasskeyUpdateOne {
+	if v != nil {
+		_u.SetLastUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (_u *PasskeyUpdateOne) ClearLastUsedAt() *PasskeyUpdateOne {
+	_u.mutation.ClearLastUsedAt()
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *PasskeyUpdateOne) SetUser(v *User) *PasskeyUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the PasskeyMutation object of the builder.
+func (_u *PasskeyUpdateOne) Mutation() *PasskeyMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *PasskeyUpdateOne) ClearUser() *PasskeyUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the PasskeyUpdate builder.
+func (_u *PasskeyUpdateOne) Where(ps ...predicate.Passkey) *PasskeyUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PasskeyUpdateOne) Select(field string, fields ...string) *PasskeyUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Passkey entity.
+func (_u *PasskeyUpdateOne) Save(ctx context.Context) (*Passkey, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PasskeyUpdateOne) SaveX(ctx context.Context) *Passkey {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PasskeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PasskeyUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PasskeyUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if passkey.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized passkey.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := passkey.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PasskeyUpdateOne) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := passkey.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Passkey.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := passkey.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Passkey.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CredentialID(); ok {
+		if err := passkey.CredentialIDValidator(v); err != nil {
+			return &ValidationError{Name: "credential_id", err: fmt.Errorf(`ent: validator failed for field "Passkey.credential_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PublicKey(); ok {
+		if err := passkey.PublicKeyValidator(v); err != nil {
+			return &ValidationError{Name: "public_key", err: fmt.Errorf(`ent: validator failed for field "Passkey.public_key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AttestationType(); ok {
+		if err := passkey.AttestationTypeValidator(v); err != nil {
+			return &ValidationError{Name: "attestation_type", err: fmt.Errorf(`ent: validator failed for field "Passkey.attestation_type": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Passkey.user"`)
+	}
+	return nil
+}
+
+func (_u *PasskeyUpdateOne) sqlSave(ctx context.Context) (_node *Passkey, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(passkey.Table, passkey.Columns, sqlgraph.NewFieldSpec(passkey.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Passkey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, passkey.FieldID)
+		for _, f := range fields {
+			if !passkey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != passkey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(passkey.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(passkey.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(passkey.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(passkey.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CredentialID(); ok {
+		_spec.SetField(passkey.FieldCredentialID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PublicKey(); ok {
+		_spec.SetField(passkey.FieldPublicKey, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.AttestationType(); ok {
+		_spec.SetField(passkey.FieldAttestationType, field.TypeString, value)
+	}
+	if _u.mutation.AttestationTypeCleared() {
+		_spec.ClearField(passkey.FieldAttestationType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Aaguid(); ok {
+		_spec.SetField(passkey.FieldAaguid, field.TypeBytes, value)
+	}
+	if _u.mutation.AaguidCleared() {
+		_spec.ClearField(passkey.FieldAaguid, field.TypeBytes)
+	}
+	if value, ok := _u.mutation.Transports(); ok {
+		_spec.SetField(passkey.FieldTransports, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTransports(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, passkey.FieldTransports, value)
+		})
+	}
+	if _u.mutation.TransportsCleared() {
+		_spec.ClearField(passkey.FieldTransports, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.SignCount(); ok {
+		_spec.SetField(passkey.FieldSignCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedSignCount(); ok {
+		_spec.AddField(passkey.FieldSignCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.BackupEligible(); ok {
+		_spec.SetField(passkey.FieldBackupEligible, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.BackupState(); ok {
+		_spec.SetField(passkey.FieldBackupState, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.LastUsedAt(); ok {
+		_spec.SetField(passkey.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastUsedAtCleared() {
+		_spec.ClearField(passkey.FieldLastUsedAt, field.TypeTime)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   passkey.UserTable,
+			Columns: []string{passkey.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   passkey.UserTable,
+			Columns: []string{passkey.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Passkey{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{passkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,380 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/liukeshao/echo-template/ent/passkeysession"
+)
+
+// PasskeySessionCreate is the builder for creating a PasskeySession entity.
+type PasskeySessionCreate struct {
+	config
+	mutation *PasskeySessionMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PasskeySessionCreate) SetCreatedAt(v time.Time) *PasskeySessionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PasskeySessionCreate) SetNillableCreatedAt(v *time.Time) *PasskeySessionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *PasskeySessionCreate) SetUpdatedAt(v time.Time) *PasskeySessionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *PasskeySessionCreate) SetNillableUpdatedAt(v *time.Time) *PasskeySessionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *PasskeySessionCreate) SetDeletedAt(v int64) *PasskeySessionCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *PasskeySessionCreate) SetNillableDeletedAt(v *int64) *PasskeySessionCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetCeremony sets the "ceremony" field.
+func (_c *PasskeySessionCreate) SetCeremony(v passkeysession.Ceremony) *PasskeySessionCreate {
+	_c.mutation.SetCeremony(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *PasskeySessionCreate) SetUserID(v string) *PasskeySessionCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *PasskeySessionCreate) SetNillableUserID(v *string) *PasskeySessionCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_c *PasskeySessionCreate) SetTokenHash(v string) *PasskeySessionCreate {
+	_c.mutation.SetTokenHash(v)
+	return _c
+}
+
+// SetData sets the "data" field.
+func (_c *PasskeySessionCreate) SetData(v []byte) *PasskeySessionCreate {
+	_c.mutation.SetData(v)
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *PasskeySessionCreate) SetExpiresAt(v time.Time) *PasskeySessionCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetUsedAt sets the "used_at" field.
+func (_c *PasskeySessionCreate) SetUsedAt(v time.Time) *PasskeySessionCreate {
+	_c.mutation.SetUsedAt(v)
+	return _c
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (_c *PasskeySessionCreate) SetNillableUsedAt(v *time.Time) *PasskeySessionCreate {
+	if v != nil {
+		_c.SetUsedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PasskeySessionCreate) SetID(v string) *PasskeySessionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the PasskeySessionMutation object of the builder.
+func (_c *PasskeySessionCreate) Mutation() *PasskeySessionMutation {
+	return _c.mutation
+}
+
+// Save creates the PasskeySession in the database.
+func (_c *PasskeySessionCreate) Save(ctx context.Context) (*PasskeySession, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PasskeySessionCreate) SaveX(ctx context.Context) *PasskeySession {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PasskeySessionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PasskeySessionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PasskeySessionCreate) defaults() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if passkeysession.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized passkeysession.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := passkeysession.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if passkeysession.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized passkeysession.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := passkeysession.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.DeletedAt(); !ok {
+		v := passkeysession.DefaultDeletedAt
+		_c.mutation.SetDeletedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PasskeySessionCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PasskeySession.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PasskeySession.updated_at"`)}
+	}
+	if _, ok := _c.mutation.DeletedAt(); !ok {
+		return &ValidationError{Name: "deleted_at", err: errors.New(`ent: missing required field "PasskeySession.deleted_at"`)}
+	}
+	if _, ok := _c.mutation.Ceremony(); !ok {
+		return &ValidationError{Name: "ceremony", err: errors.New(`ent: missing required field "PasskeySession.ceremony"`)}
+	}
+	if v, ok := _c.mutation.Ceremony(); ok {
+		if err := passkeysession.CeremonyValidator(v); err != nil {
+			return &ValidationError{Name: "ceremony", err: fmt.Errorf(`ent: validator failed for field "PasskeySession.ceremony": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := passkeysession.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "PasskeySession.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TokenHash(); !ok {
+		return &ValidationError{Name: "token_hash", err: errors.New(`ent: missing required field "PasskeySession.token_hash"`)}
+	}
+	if v, ok := _c.mutation.TokenHash(); ok {
+		if err := passkeysession.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "PasskeySession.token_hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Data(); !ok {
+		return &ValidationError{Name: "data", err: errors.New(`ent: missing required field "PasskeySession.data"`)}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "PasskeySession.expires_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := passkeysession.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "PasskeySession.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *PasskeySessionCreate) sqlSave(ctx context.Context) (*PasskeySession, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected PasskeySession.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PasskeySessionCreate) createSpec() (*PasskeySession, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PasskeySession{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(passkeysession.Table, sqlgraph.NewFieldSpec(passkeysession.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(passkeysession.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(passkeysession.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(passkeysession.FieldDeletedAt, field.TypeInt64, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := _c.mutation.Ceremony(); ok {
+		_spec.SetField(passkeysession.FieldCeremony, field.TypeEnum, value)
+		_node.Ceremony = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(passkeysession.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.TokenHash(); ok {
+		_spec.SetField(passkeysession.FieldTokenHash, field.TypeString, value)
+		_node.TokenHash = value
+	}
+	if value, ok := _c.mutation.Data(); ok {
+		_spec.SetField(passkeysession.FieldData, field.TypeBytes, value)
+		_node.Data = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(passkeysession.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.UsedAt(); ok {
+		_spec.SetField(passkeysession.FieldUsedAt, field.TypeTime, value)
+		_node.UsedAt = &value
+	}
+	return _node, _spec
+}
+
+// PasskeySessionCreateBulk is the builder for creating many PasskeySession entities in bulk.
+type PasskeySessionCreateBulk struct {
+	config
+	err      error
+	builders []*PasskeySessionCreate
+}
+
+// Save creates the PasskeySession entities in the database.
+func (_c *PasskeySessionCreateBulk) Save(ctx context.Context) ([]*PasskeySession, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PasskeySession, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PasskeySessionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PasskeySessionCreateBulk) SaveX(ctx context.Context) []*PasskeySession {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PasskeySessionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PasskeySessionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,548 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/liukeshao/echo-template/ent/passkeysession"
+	"github.com/liukeshao/echo-template/ent/predicate"
+)
+
+// PasskeySessionUpdate is the builder for updating PasskeySession entities.
+type PasskeySessionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PasskeySessionMutation
+}
+
+// Where appends a list predicates to the PasskeySessionUpdate builder.
+func (_u *PasskeySessionUpdate) Where(ps ...predicate.PasskeySession) *PasskeySessionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PasskeySessionUpdate) SetUpdatedAt(v time.Time) *PasskeySessionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *PasskeySessionUpdate) SetDeletedAt(v int64) *PasskeySessionUpdate {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *PasskeySessionUpdate) SetNillableDeletedAt(v *int64) *PasskeySessionUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *PasskeySessionUpdate) AddDeletedAt(v int64) *PasskeySessionUpdate {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// SetCeremony sets the "ceremony" field.
+func (_u *PasskeySessionUpdate) SetCeremony(v passkeysession.Ceremony) *PasskeySessionUpdate {
+	_u.mutation.SetCeremony(v)
+	return _u
+}
+
+// SetNillableCeremony sets the "ceremony" field if the given value is not nil.
+func (_u *PasskeySessionUpdate) SetNillableCeremony(v *passkeysession.Ceremony) *PasskeySessionUpdate {
+	if v != nil {
+		_u.SetCeremony(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *PasskeySessionUpdate) SetUserID(v string) *PasskeySessionUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *PasskeySessionUpdate) SetNillableUserID(v *string) *PasskeySessionUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *PasskeySessionUpdate) ClearUserID() *PasskeySessionUpdate {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_u *PasskeySessionUpdate) SetTokenHash(v string) *PasskeySessionUpdate {
+	_u.mutation.SetTokenHash(v)
+	return _u
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (_u *PasskeySessionUpdate) SetNillableTokenHash(v *string) *PasskeySessionUpdate {
+	if v != nil {
+		_u.SetTokenHash(*v)
+	}
+	return _u
+}
+
+// SetData sets the "data" field.
+func (_u *PasskeySessionUpdate) SetData(v []byte) *PasskeySessionUpdate {
+	_u.mutation.SetData(v)
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *PasskeySessionUpdate) SetExpiresAt(v time.Time) *PasskeySessionUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *PasskeySessionUpdate) SetNillableExpiresAt(v *time.Time) *PasskeySessionUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetUsedAt sets the "used_at" field.
+func (_u *PasskeySessionUpdate) SetUsedAt(v time.Time) *PasskeySessionUpdate {
+	_u.mutation.SetUsedAt(v)
+	return _u
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (_u *PasskeySessionUpdate) SetNillableUsedAt(v *time.Time) *PasskeySessionUpdate {
+	if v != nil {
+		_u.SetUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (_u *PasskeySessionUpdate) ClearUsedAt() *PasskeySessionUpdate {
+	_u.mutation.ClearUsedAt()
+	return _u
+}
+
+// Mutation returns the PasskeySessionMutation object of the builder.
+func (_u *PasskeySessionUpdate) Mutation() *PasskeySessionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PasskeySessionUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PasskeySessionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PasskeySessionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PasskeySessionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PasskeySessionUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if passkeysession.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized passkeysession.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := passkeysession.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PasskeySessionUpdate) check() error {
+	if v, ok := _u.mutation.Ceremony(); ok {
+		if err := passkeysession.CeremonyValidator(v); err != nil {
+			return &ValidationError{Name: "ceremony", err: fmt.Errorf(`ent: validator failed for field "PasskeySession.ceremony": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := passkeysession.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "PasskeySession.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TokenHash(); ok {
+		if err := passkeysession.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "PasskeySession.token_hash": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PasskeySessionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(passkeysession.Table, passkeysession.Columns, sqlgraph.NewFieldSpec(passkeysession.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(passkeysession.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(passkeysession.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(passkeysession.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Ceremony(); ok {
+		_spec.SetField(passkeysession.FieldCeremony, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(passkeysession.FieldUserID, field.TypeString, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(passkeysession.FieldUserID, field.TypeString)
+	}
+	if value, ok := _u.mutation.TokenHash(); ok {
+		_spec.SetField(passkeysession.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Data(); ok {
+		_spec.SetField(passkeysession.FieldData, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(passkeysession.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UsedAt(); ok {
+		_spec.SetField(passkeysession.FieldUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UsedAtCleared() {
+		_spec.ClearField(passkeysession.FieldUsedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{passkeysession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PasskeySessionUpdateOne is the builder for updating a single PasskeySession entity.
+type PasskeySessionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PasskeySessionMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PasskeySessionUpdateOne) SetUpdatedAt(v time.Time) *PasskeySessionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *PasskeySessionUpdateOne) SetDeletedAt(v int64) *PasskeySessionUpdateOne {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *PasskeySessionUpdateOne) SetNillableDeletedAt(v *int64) *PasskeySessionUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *PasskeySessionUpdateOne) AddDeletedAt(v int64) *PasskeySessionUpdateOne {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// SetCeremony sets the "ceremony" field.
+func (_u *PasskeySessionUpdateOne) SetCeremony(v passkeysession.Ceremony) *PasskeySessionUpdateOne {
+	_u.mutation.SetCeremony(v)
+	return _u
+}
+
+// SetNillableCeremony sets the "ceremony" field if the given value is not nil.
+func (_u *PasskeySessionUpdateOne) SetNillableCeremony(v *passkeysession.Ceremony) *PasskeySessionUpdateOne {
+	if v != nil {
+		_u.SetCeremony(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *PasskeySessionUpdateOne) SetUserID(v string) *PasskeySessionUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *PasskeySessionUpdateOne) SetNillableUserID(v *string) *PasskeySessionUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *PasskeySessionUpdateOne) ClearUserID() *PasskeySessionUpdateOne {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_u *PasskeySessionUpdateOne) SetTokenHash(v string) *PasskeySessionUpdateOne {
+	_u.mutation.SetTokenHash(v)
+	return _u
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (_u *PasskeySessionUpdateOne) SetNillableTokenHash(v *string) *PasskeySessionUpdateOne {
+	if v != nil {
+		_u.SetTokenHash(*v)
+	}
+	return _u
+}
+
+// SetData sets the "data" field.
+func (_u *PasskeySessionUpdateOne) SetData(v []byte) *PasskeySessionUpdateOne {
+	_u.mutation.SetData(v)
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *PasskeySessionUpdateOne) SetExpiresAt(v time.Time) *PasskeySessionUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *PasskeySessionUpdateOne) SetNillableExpiresAt(v *time.Time) *PasskeySessionUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetUsedAt sets the "used_at" field.
+func (_u *PasskeySessionUpdateOne) SetUsedAt(v time.Time) *PasskeySessionUpdateOne {
+	_u.mutation.SetUsedAt(v)
+	return _u
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (_u *PasskeySessionUpdateOne) SetNillableUsedAt(v *time.Time) *PasskeySessionUpdateOne {
+	if v != nil {
+		_u.SetUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (_u *PasskeySessionUpdateOne) ClearUsedAt() *PasskeySessionUpdateOne {
+	_u.mutation.ClearUsedAt()
+	return _u
+}
+
+// Mutation returns the PasskeySessionMutation object of the builder.
+func (_u *PasskeySessionUpdateOne) Mutation() *PasskeySessionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PasskeySessionUpdate builder.
+func (_u *PasskeySessionUpdateOne) Where(ps ...predicate.PasskeySession) *PasskeySessionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PasskeySessionUpdateOne) Select(field string, fields ...string) *PasskeySessionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PasskeySession entity.
+func (_u *PasskeySessionUpdateOne) Save(ctx context.Context) (*PasskeySession, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PasskeySessionUpdateOne) SaveX(ctx context.Context) *PasskeySession {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PasskeySessionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PasskeySessionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PasskeySessionUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if passkeysession.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized passkeysession.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := passkeysession.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PasskeySessionUpdateOne) check() error {
+	if v, ok := _u.mutation.Ceremony(); ok {
+		if err := passkeysession.CeremonyValidator(v); err != nil {
+			return &ValidationError{Name: "ceremony", err: fmt.Errorf(`ent: validator failed for field "PasskeySession.ceremony": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := passkeysession.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "PasskeySession.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TokenHash(); ok {
+		if err := passkeysession.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "PasskeySession.token_hash": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PasskeySessionUpdateOne) sqlSave(ctx context.Context) (_node *PasskeySession, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(passkeysession.Table, passkeysession.Columns, sqlgraph.NewFieldSpec(passkeysession.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PasskeySession.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, passkeysession.FieldID)
+		for _, f := range fields {
+			if !passkeysession.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != passkeysession.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(passkeysession.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(passkeysession.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(passkeysession.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Ceremony(); ok {
+		_spec.SetField(passkeysession.FieldCeremony, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(passkeysession.FieldUserID, field.TypeString, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(passkeysession.FieldUserID, field.TypeString)
+	}
+	if value, ok := _u.mutation.TokenHash(); ok {
+		_spec.SetField(passkeysession.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Data(); ok {
+		_spec.SetField(passkeysession.FieldData, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(passkeysession.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UsedAt(); ok {
+		_spec.SetField(passkeysession.FieldUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UsedAtCleared() {
+		_spec.ClearField(passkeysession.FieldUsedAt, field.TypeTime)
+	}
+	_node = &PasskeySession{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{passkeysession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
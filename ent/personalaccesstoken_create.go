@@ -0,0 +1,438 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/liukeshao/echo-template/ent/personalaccesstoken"
+	"github.com/liukeshao/echo-template/ent/user"
+)
+
+// PersonalAccessTokenCreate is the builder for creating a PersonalAccessToken entity.
+type PersonalAccessTokenCreate struct {
+	config
+	mutation *PersonalAccessTokenMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PersonalAccessTokenCreate) SetCreatedAt(v time.Time) *PersonalAccessTokenCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PersonalAccessTokenCreate) SetNillableCreatedAt(v *time.Time) *PersonalAccessTokenCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *PersonalAccessTokenCreate) SetUpdatedAt(v time.Time) *PersonalAccessTokenCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *PersonalAccessTokenCreate) SetNillableUpdatedAt(v *time.Time) *PersonalAccessTokenCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *PersonalAccessTokenCreate) SetDeletedAt(v int64) *PersonalAccessTokenCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *PersonalAccessTokenCreate) SetNillableDeletedAt(v *int64) *PersonalAccessTokenCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *PersonalAccessTokenCreate) SetUserID(v string) *PersonalAccessTokenCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *PersonalAccessTokenCreate) SetName(v string) *PersonalAccessTokenCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_c *PersonalAccessTokenCreate) SetTokenHash(v string) *PersonalAccessTokenCreate {
+	_c.mutation.SetTokenHash(v)
+	return _c
+}
+
+// SetTokenPrefix sets the "token_prefix" field.
+func (_c *PersonalAccessTokenCreate) SetTokenPrefix(v string) *PersonalAccessTokenCreate {
+	_c.mutation.SetTokenPrefix(v)
+	return _c
+}
+
+// SetScopes sets the "scopes" field.
+func (_c *PersonalAccessTokenCreate) SetScopes(v []string) *PersonalAccessTokenCreate {
+	_c.mutation.SetScopes(v)
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *PersonalAccessTokenCreate) SetExpiresAt(v time.Time) *PersonalAccessTokenCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *PersonalAccessTokenCreate) SetNillableExpiresAt(v *time.Time) *PersonalAccessTokenCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_c *PersonalAccessTokenCreate) SetLastUsedAt(v time.Time) *PersonalAccessTokenCreate {
+	_c.mutation.SetLastUsedAt(v)
+	return _c
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_c *PersonalAccessTokenCreate) SetNillableLastUsedAt(v *time.Time) *PersonalAccessTokenCreate {
+	if v != nil {
+		_c.SetLastUsedAt(*v)
+	}
+	return _c
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_c *PersonalAccessTokenCreate) SetRevokedAt(v time.Time) *PersonalAccessTokenCreate {
+	_c.mutation.SetRevokedAt(v)
+	return _c
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_c *PersonalAccessTokenCreate) SetNillableRevokedAt(v *time.Time) *PersonalAccessTokenCreate {
+	if v != nil {
+		_c.SetRevokedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PersonalAccessTokenCreate) SetID(v string) *PersonalAccessTokenCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *PersonalAccessTokenCreate) SetUser(v *User) *PersonalAccessTokenCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the PersonalAccessTokenMutation object of the builder.
+func (_c *PersonalAccessTokenCreate) Mutation() *PersonalAccessTokenMutation {
+	return _c.mutation
+}
+
+// Save creates the PersonalAccessToken in the database.
+func (_c *PersonalAccessTokenCreate) Save(ctx context.Context) (*PersonalAccessToken, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PersonalAccessTokenCreate) SaveX(ctx context.Context) *PersonalAccessToken {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PersonalAccessTokenCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PersonalAccessTokenCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PersonalAccessTokenCreate) defaults() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if personalaccesstoken.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized personalaccesstoken.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := personalaccesstoken.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if personalaccesstoken.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized personalaccesstoken.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := personalaccesstoken.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.DeletedAt(); !ok {
+		v := personalaccesstoken.DefaultDeletedAt
+		_c.mutation.SetDeletedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PersonalAccessTokenCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PersonalAccessToken.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PersonalAccessToken.updated_at"`)}
+	}
+	if _, ok := _c.mutation.DeletedAt(); !ok {
+		return &ValidationError{Name: "deleted_at", err: errors.New(`ent: missing required field "PersonalAccessToken.deleted_at"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "PersonalAccessToken.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := personalaccesstoken.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "PersonalAccessToken.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "PersonalAccessToken.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := personalaccesstoken.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "PersonalAccessToken.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TokenHash(); !ok {
+		return &ValidationError{Name: "token_hash", err: errors.New(`ent: missing required field "PersonalAccessToken.token_hash"`)}
+	}
+	if v, ok := _c.mutation.TokenHash(); ok {
+		if err := personalaccesstoken.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "PersonalAccessToken.token_hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TokenPrefix(); !ok {
+		return &ValidationError{Name: "token_prefix", err: errors.New(`ent: missing required field "PersonalAccessToken.token_prefix"`)}
+	}
+	if v, ok := _c.mutation.TokenPrefix(); ok {
+		if err := personalaccesstoken.TokenPrefixValidator(v); err != nil {
+			return &ValidationError{Name: "token_prefix", err: fmt.Errorf(`ent: validator failed for field "PersonalAccessToken.token_prefix": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Scopes(); !ok {
+		return &ValidationError{Name: "scopes", err: errors.New(`ent: missing required field "PersonalAccessToken.scopes"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := personalaccesstoken.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "PersonalAccessToken.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "PersonalAccessToken.user"`)}
+	}
+	return nil
+}
+
+func (_c *PersonalAccessTokenCreate) sqlSave(ctx context.Context) (*PersonalAccessToken, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected PersonalAccessToken.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PersonalAccessTokenCreate) createSpec() (*PersonalAccessToken, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PersonalAccessToken{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(personalaccesstoken.Table, sqlgraph.NewFieldSpec(personalaccesstoken.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(personalaccesstoken.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(personalaccesstoken.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(personalaccesstoken.FieldDeletedAt, field.TypeInt64, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(personalaccesstoken.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.TokenHash(); ok {
+		_spec.SetField(personalaccesstoken.FieldTokenHash, field.TypeString, value)
+		_node.TokenHash = value
+	}
+	if value, ok := _c.mutation.TokenPrefix(); ok {
+		_spec.SetField(personalaccesstoken.FieldTokenPrefix, field.TypeString, value)
+		_node.TokenPrefix = value
+	}
+	if value, ok := _c.mutation.Scopes(); ok {
+		_spec.SetField(personalaccesstoken.FieldScopes, field.TypeJSON, value)
+		_node.Scopes = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(personalaccesstoken.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := _c.mutation.LastUsedAt(); ok {
+		_spec.SetField(personalaccesstoken.FieldLastUsedAt, field.TypeTime, value)
+		_node.LastUsedAt = &value
+	}
+	if value, ok := _c.mutation.RevokedAt(); ok {
+		_spec.SetField(personalaccesstoken.FieldRevokedAt, field.TypeTime, value)
+		_node.RevokedAt = &value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   personalaccesstoken.UserTable,
+			Columns: []string{personalaccesstoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PersonalAccessTokenCreateBulk is the builder for creating many PersonalAccessToken entities in bulk.
+type PersonalAccessTokenCreateBulk struct {
+	config
+	err      error
+	builders []*PersonalAccessTokenCreate
+}
+
+// Save creates the PersonalAccessToken entities in the database.
+func (_c *PersonalAccessTokenCreateBulk) Save(ctx context.Context) ([]*PersonalAccessToken, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PersonalAccessToken, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PersonalAccessTokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PersonalAccessTokenCreateBulk) SaveX(ctx context.Context) []*PersonalAccessToken {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PersonalAccessTokenCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PersonalAccessTokenCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
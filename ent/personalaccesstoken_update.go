@@ -0,0 +1,748 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/liukeshao/echo-template/ent/personalaccesstoken"
+	"github.com/liukeshao/echo-template/ent/predicate"
+	"github.com/liukeshao/echo-template/ent/user"
+)
+
+// PersonalAccessTokenUpdate is the builder for updating PersonalAccessToken entities.
+type PersonalAccessTokenUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PersonalAccessTokenMutation
+}
+
+// Where appends a list predicates to the PersonalAccessTokenUpdate builder.
+func (_u *PersonalAccessTokenUpdate) Where(ps ...predicate.PersonalAccessToken) *PersonalAccessTokenUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PersonalAccessTokenUpdate) SetUpdatedAt(v time.Time) *PersonalAccessTokenUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *PersonalAccessTokenUpdate) SetDeletedAt(v int64) *PersonalAccessTokenUpdate {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *PersonalAccessTokenUpdate) SetNillableDeletedAt(v *int64) *PersonalAccessTokenUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *PersonalAccessTokenUpdate) AddDeletedAt(v int64) *PersonalAccessTokenUpdate {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *PersonalAccessTokenUpdate) SetUserID(v string) *PersonalAccessTokenUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *PersonalAccessTokenUpdate) SetNillableUserID(v *string) *PersonalAccessTokenUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *PersonalAccessTokenUpdate) SetName(v string) *PersonalAccessTokenUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *PersonalAccessTokenUpdate) SetNillableName(v *string) *PersonalAccessTokenUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_u *PersonalAccessTokenUpdate) SetTokenHash(v string) *PersonalAccessTokenUpdate {
+	_u.mutation.SetTokenHash(v)
+	return _u
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (_u *PersonalAccessTokenUpdate) SetNillableTokenHash(v *string) *PersonalAccessTokenUpdate {
+	if v != nil {
+		_u.SetTokenHash(*v)
+	}
+	return _u
+}
+
+// SetTokenPrefix sets the "token_prefix" field.
+func (_u *PersonalAccessTokenUpdate) SetTokenPrefix(v string) *PersonalAccessTokenUpdate {
+	_u.mutation.SetTokenPrefix(v)
+	return _u
+}
+
+// SetNillableTokenPrefix sets the "token_prefix" field if the given value is not nil.
+func (_u *PersonalAccessTokenUpdate) SetNillableTokenPrefix(v *string) *PersonalAccessTokenUpdate {
+	if v != nil {
+		_u.SetTokenPrefix(*v)
+	}
+	return _u
+}
+
+// SetScopes sets the "scopes" field.
+func (_u *PersonalAccessTokenUpdate) SetScopes(v []string) *PersonalAccessTokenUpdate {
+	_u.mutation.SetScopes(v)
+	return _u
+}
+
+// AppendScopes appends value to the "scopes" field.
+func (_u *PersonalAccessTokenUpdate) AppendScopes(v []string) *PersonalAccessTokenUpdate {
+	_u.mutation.AppendScopes(v)
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *PersonalAccessTokenUpdate) SetExpiresAt(v time.Time) *PersonalAccessTokenUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *PersonalAccessTokenUpdate) SetNillableExpiresAt(v *time.Time) *PersonalAccessTokenUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *PersonalAccessTokenUpdate) ClearExpiresAt() *PersonalAccessTokenUpdate {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_u *PersonalAccessTokenUpdate) SetLastUsedAt(v time.Time) *PersonalAccessTokenUpdate {
+	_u.mutation.SetLastUsedAt(v)
+	return _u
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_u *PersonalAccessTokenUpdate) SetNillableLastUsedAt(v *time.Time) *PersonalAccessTokenUpdate {
+	if v != nil {
+		_u.SetLastUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (_u *PersonalAccessTokenUpdate) ClearLastUsedAt() *PersonalAccessTokenUpdate {
+	_u.mutation.ClearLastUsedAt()
+	return _u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *PersonalAccessTokenUpdate) SetRevokedAt(v time.Time) *PersonalAccessTokenUpdate {
+	_u.mutation.SetRevokedAt(v)
+	return _u
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *PersonalAccessTokenUpdate) SetNillableRevokedAt(v *time.Time) *PersonalAccessTokenUpdate {
+	if v != nil {
+		_u.SetRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *PersonalAccessTokenUpdate) ClearRevokedAt() *PersonalAccessTokenUpdate {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *PersonalAccessTokenUpdate) SetUser(v *User) *PersonalAccessTokenUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the PersonalAccessTokenMutation object of the builder.
+func (_u *PersonalAccessTokenUpdate) Mutation() *PersonalAccessTokenMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *PersonalAccessTokenUpdate) ClearUser() *PersonalAccessTokenUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PersonalAccessTokenUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PersonalAccessTokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PersonalAccessTokenUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PersonalAccessTokenUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PersonalAccessTokenUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if personalaccesstoken.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized personalaccesstoken.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := personalaccesstoken.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PersonalAccessTokenUpdate) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := personalaccesstoken.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "PersonalAccessToken.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := personalaccesstoken.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "PersonalAccessToken.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TokenHash(); ok {
+		if err := personalaccesstoken.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "PersonalAccessToken.token_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TokenPrefix(); ok {
+		if err := personalaccesstoken.TokenPrefixValidator(v); err != nil {
+			return &ValidationError{Name: "token_prefix", err: fmt.Errorf(`ent: validator failed for field "PersonalAccessToken.token_prefix": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PersonalAccessToken.user"`)
+	}
+	return nil
+}
+
+func (_u *PersonalAccessTokenUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(personalaccesstoken.Table, personalaccesstoken.Columns, sqlgraph.NewFieldSpec(personalaccesstoken.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(personalaccesstoken.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(personalaccesstoken.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(personalaccesstoken.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(personalaccesstoken.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TokenHash(); ok {
+		_spec.SetField(personalaccesstoken.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TokenPrefix(); ok {
+		_spec.SetField(personalaccesstoken.FieldTokenPrefix, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Scopes(); ok {
+		_spec.SetField(personalaccesstoken.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, personalaccesstoken.FieldScopes, value)
+		})
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(personalaccesstoken.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(personalaccesstoken.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastUsedAt(); ok {
+		_spec.SetField(personalaccesstoken.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastUsedAtCleared() {
+		_spec.ClearField(personalaccesstoken.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(personalaccesstoken.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(personalaccesstoken.FieldRevokedAt, field.TypeTime)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   personalaccesstoken.UserTable,
+			Columns: []string{personalaccesstoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   personalaccesstoken.UserTable,
+			Columns: []string{personalaccesstoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{personalaccesstoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PersonalAccessTokenUpdateOne is the builder for updating a single PersonalAccessToken entity.
+type PersonalAccessTokenUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PersonalAccessTokenMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PersonalAccessTokenUpdateOne) SetUpdatedAt(v time.Time) *PersonalAccessTokenUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *PersonalAccessTokenUpdateOne) SetDeletedAt(v int64) *PersonalAccessTokenUpdateOne {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *PersonalAccessTokenUpdateOne) SetNillableDeletedAt(v *int64) *PersonalAccessTokenUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *PersonalAccessTokenUpdateOne) AddDeletedAt(v int64) *PersonalAccessTokenUpdateOne {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *PersonalAccessTokenUpdateOne) SetUserID(v string) *PersonalAccessTokenUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *PersonalAccessTokenUpdateOne) SetNillableUserID(v *string) *PersonalAccessTokenUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *PersonalAccessTokenUpdateOne) SetName(v string) *PersonalAccessTokenUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *PersonalAccessTokenUpdateOne) SetNillableName(v *string) *PersonalAccessTokenUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_u *PersonalAccessTokenUpdateOne) SetTokenHash(v string) *PersonalAccessTokenUpdateOne {
+	_u.mutation.SetTokenHash(v)
+	return _u
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (_u *PersonalAccessTokenUpdateOne) SetNillableTokenHash(v *string) *PersonalAccessTokenUpdateOne {
+	if v != nil {
+		_u.SetTokenHash(*v)
+	}
+	return _u
+}
+
+// SetTokenPrefix sets the "token_prefix" field.
+func (_u *PersonalAccessTokenUpdateOne) SetTokenPrefix(v string) *PersonalAccessTokenUpdateOne {
+	_u.mutation.SetTokenPrefix(v)
+	return _u
+}
+
+// SetNillableTokenPrefix sets the "token_prefix" field if the given value is not nil.
+func (_u *PersonalAccessTokenUpdateOne) SetNillableTokenPrefix(v *string) *PersonalAccessTokenUpdateOne {
+	if v != nil {
+		_u.SetTokenPrefix(*v)
+	}
+	return _u
+}
+
+// SetScopes sets the "scopes" field.
+func (_u *PersonalAccessTokenUpdateOne) SetScopes(v []string) *PersonalAccessTokenUpdateOne {
+	_u.mutation.SetScopes(v)
+	return _u
+}
+
+// AppendScopes appends value to the "scopes" field.
+func (_u *PersonalAccessTokenUpdateOne) AppendScopes(v []string) *PersonalAccessTokenUpdateOne {
+	_u.mutation.AppendScopes(v)
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *PersonalAccessTokenUpdateOne) SetExpiresAt(v time.Time) *PersonalAccessTokenUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *PersonalAccessTokenUpdateOne) SetNillableExpiresAt(v *time.Time) *PersonalAccessTokenUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *PersonalAccessTokenUpdateOne) ClearExpiresAt() *PersonalAccessTokenUpdateOne {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_u *PersonalAccessTokenUpdateOne) SetLastUsedAt(v time.Time) *PersonalAccessTokenUpdateOne {
+	_u.mutation.SetLastUsedAt(v)
+	return _u
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_u *PersonalAccessTokenUpdateOne) SetNillableLastUsedAt(v *time.Time) *PersonalAccessTokenUpdateOne {
+	if v != nil {
+		_u.SetLastUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (_u *PersonalAccessTokenUpdateOne) ClearLastUsedAt() *PersonalAccessTokenUpdateOne {
+	_u.mutation.ClearLastUsedAt()
+	return _u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *PersonalAccessTokenUpdateOne) SetRevokedAt(v time.Time) *PersonalAccessTokenUpdateOne {
+	_u.mutation.SetRevokedAt(v)
+	return _u
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *PersonalAccessTokenUpdateOne) SetNillableRevokedAt(v *time.Time) *PersonalAccessTokenUpdateOne {
+	if v != nil {
+		_u.SetRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *PersonalAccessTokenUpdateOne) ClearRevokedAt() *PersonalAccessTokenUpdateOne {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *PersonalAccessTokenUpdateOne) SetUser(v *User) *PersonalAccessTokenUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the PersonalAccessTokenMutation object of the builder.
+func (_u *PersonalAccessTokenUpdateOne) Mutation() *PersonalAccessTokenMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *PersonalAccessTokenUpdateOne) ClearUser() *PersonalAccessTokenUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the PersonalAccessTokenUpdate builder.
+func (_u *PersonalAccessTokenUpdateOne) Where(ps ...predicate.PersonalAccessToken) *PersonalAccessTokenUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PersonalAccessTokenUpdateOne) Select(field string, fields ...string) *PersonalAccessTokenUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PersonalAccessToken entity.
+func (_u *PersonalAccessTokenUpdateOne) Save(ctx context.Context) (*PersonalAccessToken, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PersonalAccessTokenUpdateOne) SaveX(ctx context.Context) *PersonalAccessToken {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PersonalAccessTokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PersonalAccessTokenUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PersonalAccessTokenUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if personalaccesstoken.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized personalaccesstoken.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := personalaccesstoken.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PersonalAccessTokenUpdateOne) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := personalaccesstoken.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "PersonalAccessToken.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := personalaccesstoken.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "PersonalAccessToken.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TokenHash(); ok {
+		if err := personalaccesstoken.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "PersonalAccessToken.token_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TokenPrefix(); ok {
+		if err := personalaccesstoken.TokenPrefixValidator(v); err != nil {
+			return &ValidationError{Name: "token_prefix", err: fmt.Errorf(`ent: validator failed for field "PersonalAccessToken.token_prefix": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PersonalAccessToken.user"`)
+	}
+	return nil
+}
+
+func (_u *PersonalAccessTokenUpdateOne) sqlSave(ctx context.Context) (_node *PersonalAccessToken, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(personalaccesstoken.Table, personalaccesstoken.Columns, sqlgraph.NewFieldSpec(personalaccesstoken.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PersonalAccessToken.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, personalaccesstoken.FieldID)
+		for _, f := range fields {
+			if !personalaccesstoken.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != personalaccesstoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(personalaccesstoken.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(personalaccesstoken.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(personalaccesstoken.FieldDeletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(personalaccesstoken.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TokenHash(); ok {
+		_spec.SetField(personalaccesstoken.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TokenPrefix(); ok {
+		_spec.SetField(personalaccesstoken.FieldTokenPrefix, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Scopes(); ok {
+		_spec.SetField(personalaccesstoken.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, personalaccesstoken.FieldScopes, value)
+		})
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(personalaccesstoken.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(personalaccesstoken.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastUsedAt(); ok {
+		_spec.SetField(personalaccesstoken.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastUsedAtCleared() {
+		_spec.ClearField(personalaccesstoken.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(personalaccesstoken.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(personalaccesstoken.FieldRevokedAt, field.TypeTime)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   personalaccesstoken.UserTable,
+			Columns: []string{personalaccesstoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   personalaccesstoken.UserTable,
+			Columns: []string{personalaccesstoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PersonalAccessToken{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{personalaccesstoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -6,6 +6,42 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Identity is the predicate function for identity builders.
+type Identity func(*sql.Selector)
+
+// Impersonation is the predicate function for impersonation builders.
+type Impersonation func(*sql.Selector)
+
+// OAuthClient is the predicate function for oauthclient builders.
+type OAuthClient func(*sql.Selector)
+
+// OAuthCode is the predicate function for oauthcode builders.
+type OAuthCode func(*sql.Selector)
+
+// OIDCState is the predicate function for oidcstate builders.
+type OIDCState func(*sql.Selector)
+
+// OneTimeToken is the predicate function for onetimetoken builders.
+type OneTimeToken func(*sql.Selector)
+
+// Passkey is the predicate function for passkey builders.
+type Passkey func(*sql.Selector)
+
+// PasskeySession is the predicate function for passkeysession builders.
+type PasskeySession func(*sql.Selector)
+
+// PasswordHistory is the predicate function for passwordhistory builders.
+type PasswordHistory func(*sql.Selector)
+
+// PersonalAccessToken is the predicate function for personalaccesstoken builders.
+type PersonalAccessToken func(*sql.Selector)
+
+// RecoveryCode is the predicate function for recoverycode builders.
+type RecoveryCode func(*sql.Selector)
+
+// SecurityEvent is the predicate function for securityevent builders.
+type SecurityEvent func(*sql.Selector)
+
 // Token is the predicate function for token builders.
 type Token func(*sql.Selector)
 
@@ -5,7 +5,19 @@ package runtime
 import (
 	"time"
 
+	"github.com/liukeshao/echo-template/ent/identity"
+	"github.com/liukeshao/echo-template/ent/impersonation"
+	"github.com/liukeshao/echo-template/ent/oauthclient"
+	"github.com/liukeshao/echo-template/ent/oauthcode"
+	"github.com/liukeshao/echo-template/ent/oidcstate"
+	"github.com/liukeshao/echo-template/ent/onetimetoken"
+	"github.com/liukeshao/echo-template/ent/passkey"
+	"github.com/liukeshao/echo-template/ent/passkeysession"
+	"github.com/liukeshao/echo-template/ent/passwordhistory"
+	"github.com/liukeshao/echo-template/ent/personalaccesstoken"
+	"github.com/liukeshao/echo-template/ent/recoverycode"
 	"github.com/liukeshao/echo-template/ent/schema"
+	"github.com/liukeshao/echo-template/ent/securityevent"
 	"github.com/liukeshao/echo-template/ent/token"
 	"github.com/liukeshao/echo-template/ent/user"
 )
@@ -14,6 +26,1176 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	identityMixin := schema.Identity{}.Mixin()
+	identityMixinHooks0 := identityMixin[0].Hooks()
+	identity.Hooks[0] = identityMixinHooks0[0]
+	identityMixinInters0 := identityMixin[0].Interceptors()
+	identity.Interceptors[0] = identityMixinInters0[0]
+	identityMixinFields0 := identityMixin[0].Fields()
+	_ = identityMixinFields0
+	identityFields := schema.Identity{}.Fields()
+	_ = identityFields
+	// identityDescCreatedAt is the schema descriptor for created_at field.
+	identityDescCreatedAt := identityMixinFields0[1].Descriptor()
+	// identity.DefaultCreatedAt holds the default value on creation for the created_at field.
+	identity.DefaultCreatedAt = identityDescCreatedAt.Default.(func() time.Time)
+	// identityDescUpdatedAt is the schema descriptor for updated_at field.
+	identityDescUpdatedAt := identityMixinFields0[2].Descriptor()
+	// identity.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	identity.DefaultUpdatedAt = identityDescUpdatedAt.Default.(func() time.Time)
+	// identity.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	identity.UpdateDefaultUpdatedAt = identityDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// identityDescDeletedAt is the schema descriptor for deleted_at field.
+	identityDescDeletedAt := identityMixinFields0[3].Descriptor()
+	// identity.DefaultDeletedAt holds the default value on creation for the deleted_at field.
+	identity.DefaultDeletedAt = identityDescDeletedAt.Default.(int64)
+	// identityDescUserID is the schema descriptor for user_id field.
+	identityDescUserID := identityFields[0].Descriptor()
+	// identity.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	identity.UserIDValidator = func() func(string) error {
+		validators := identityDescUserID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(user string) error {
+			for _, fn := range fns {
+				if err := fn(user); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// identityDescProvider is the schema descriptor for provider field.
+	identityDescProvider := identityFields[1].Descriptor()
+	// identity.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	identity.ProviderValidator = func() func(string) error {
+		validators := identityDescProvider.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(provider string) error {
+			for _, fn := range fns {
+				if err := fn(provider); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// identityDescSubject is the schema descriptor for subject field.
+	identityDescSubject := identityFields[2].Descriptor()
+	// identity.SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
+	identity.SubjectValidator = func() func(string) error {
+		validators := identityDescSubject.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(subject string) error {
+			for _, fn := range fns {
+				if err := fn(subject); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// identityDescEmail is the schema descriptor for email field.
+	identityDescEmail := identityFields[3].Descriptor()
+	// identity.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	identity.EmailValidator = identityDescEmail.Validators[0].(func(string) error)
+	// identityDescID is the schema descriptor for id field.
+	identityDescID := identityMixinFields0[0].Descriptor()
+	// identity.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	identity.IDValidator = func() func(string) error {
+		validators := identityDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+			validators[2].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	impersonationMixin := schema.Impersonation{}.Mixin()
+	impersonationMixinHooks0 := impersonationMixin[0].Hooks()
+	impersonation.Hooks[0] = impersonationMixinHooks0[0]
+	impersonationMixinInters0 := impersonationMixin[0].Interceptors()
+	impersonation.Interceptors[0] = impersonationMixinInters0[0]
+	impersonationMixinFields0 := impersonationMixin[0].Fields()
+	_ = impersonationMixinFields0
+	impersonationFields := schema.Impersonation{}.Fields()
+	_ = impersonationFields
+	// impersonationDescCreatedAt is the schema descriptor for created_at field.
+	impersonationDescCreatedAt := impersonationMixinFields0[1].Descriptor()
+	// impersonation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	impersonation.DefaultCreatedAt = impersonationDescCreatedAt.Default.(func() time.Time)
+	// impersonationDescUpdatedAt is the schema descriptor for updated_at field.
+	impersonationDescUpdatedAt := impersonationMixinFields0[2].Descriptor()
+	// impersonation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	impersonation.DefaultUpdatedAt = impersonationDescUpdatedAt.Default.(func() time.Time)
+	// impersonation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	impersonation.UpdateDefaultUpdatedAt = impersonationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// impersonationDescDeletedAt is the schema descriptor for deleted_at field.
+	impersonationDescDeletedAt := impersonationMixinFields0[3].Descriptor()
+	// impersonation.DefaultDeletedAt holds the default value on creation for the deleted_at field.
+	impersonation.DefaultDeletedAt = impersonationDescDeletedAt.Default.(int64)
+	// impersonationDescAdminID is the schema descriptor for admin_id field.
+	impersonationDescAdminID := impersonationFields[0].Descriptor()
+	// impersonation.AdminIDValidator is a validator for the "admin_id" field. It is called by the builders before save.
+	impersonation.AdminIDValidator = func() func(string) error {
+		validators := impersonationDescAdminID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(admin_id string) error {
+			for _, fn := range fns {
+				if err := fn(admin_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// impersonationDescUserID is the schema descriptor for user_id field.
+	impersonationDescUserID := impersonationFields[1].Descriptor()
+	// impersonation.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	impersonation.UserIDValidator = func() func(string) error {
+		validators := impersonationDescUserID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(user_id string) error {
+			for _, fn := range fns {
+				if err := fn(user_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// impersonationDescReason is the schema descriptor for reason field.
+	impersonationDescReason := impersonationFields[2].Descriptor()
+	// impersonation.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	impersonation.ReasonValidator = func() func(string) error {
+		validators := impersonationDescReason.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(reason string) error {
+			for _, fn := range fns {
+				if err := fn(reason); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// impersonationDescFamilyID is the schema descriptor for family_id field.
+	impersonationDescFamilyID := impersonationFields[3].Descriptor()
+	// impersonation.FamilyIDValidator is a validator for the "family_id" field. It is called by the builders before save.
+	impersonation.FamilyIDValidator = func() func(string) error {
+		validators := impersonationDescFamilyID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(family_id string) error {
+			for _, fn := range fns {
+				if err := fn(family_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// impersonationDescUserAgent is the schema descriptor for user_agent field.
+	impersonationDescUserAgent := impersonationFields[7].Descriptor()
+	// impersonation.UserAgentValidator is a validator for the "user_agent" field. It is called by the builders before save.
+	impersonation.UserAgentValidator = impersonationDescUserAgent.Validators[0].(func(string) error)
+	// impersonationDescIP is the schema descriptor for ip field.
+	impersonationDescIP := impersonationFields[8].Descriptor()
+	// impersonation.IPValidator is a validator for the "ip" field. It is called by the builders before save.
+	impersonation.IPValidator = impersonationDescIP.Validators[0].(func(string) error)
+	// impersonationDescID is the schema descriptor for id field.
+	impersonationDescID := impersonationMixinFields0[0].Descriptor()
+	// impersonation.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	impersonation.IDValidator = func() func(string) error {
+		validators := impersonationDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+			validators[2].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	oauthclientMixin := schema.OAuthClient{}.Mixin()
+	oauthclientMixinHooks0 := oauthclientMixin[0].Hooks()
+	oauthclient.Hooks[0] = oauthclientMixinHooks0[0]
+	oauthclientMixinInters0 := oauthclientMixin[0].Interceptors()
+	oauthclient.Interceptors[0] = oauthclientMixinInters0[0]
+	oauthclientMixinFields0 := oauthclientMixin[0].Fields()
+	_ = oauthclientMixinFields0
+	oauthclientFields := schema.OAuthClient{}.Fields()
+	_ = oauthclientFields
+	// oauthclientDescCreatedAt is the schema descriptor for created_at field.
+	oauthclientDescCreatedAt := oauthclientMixinFields0[1].Descriptor()
+	// oauthclient.DefaultCreatedAt holds the default value on creation for the created_at field.
+	oauthclient.DefaultCreatedAt = oauthclientDescCreatedAt.Default.(func() time.Time)
+	// oauthclientDescUpdatedAt is the schema descriptor for updated_at field.
+	oauthclientDescUpdatedAt := oauthclientMixinFields0[2].Descriptor()
+	// oauthclient.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	oauthclient.DefaultUpdatedAt = oauthclientDescUpdatedAt.Default.(func() time.Time)
+	// oauthclient.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	oauthclient.UpdateDefaultUpdatedAt = oauthclientDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// oauthclientDescDeletedAt is the schema descriptor for deleted_at field.
+	oauthclientDescDeletedAt := oauthclientMixinFields0[3].Descriptor()
+	// oauthclient.DefaultDeletedAt holds the default value on creation for the deleted_at field.
+	oauthclient.DefaultDeletedAt = oauthclientDescDeletedAt.Default.(int64)
+	// oauthclientDescUserID is the schema descriptor for user_id field.
+	oauthclientDescUserID := oauthclientFields[0].Descriptor()
+	// oauthclient.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	oauthclient.UserIDValidator = func() func(string) error {
+		validators := oauthclientDescUserID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(user string) error {
+			for _, fn := range fns {
+				if err := fn(user); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// oauthclientDescName is the schema descriptor for name field.
+	oauthclientDescName := oauthclientFields[1].Descriptor()
+	// oauthclient.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	oauthclient.NameValidator = func() func(string) error {
+		validators := oauthclientDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// oauthclientDescSecretHash is the schema descriptor for secret_hash field.
+	oauthclientDescSecretHash := oauthclientFields[2].Descriptor()
+	// oauthclient.SecretHashValidator is a validator for the "secret_hash" field. It is called by the builders before save.
+	oauthclient.SecretHashValidator = oauthclientDescSecretHash.Validators[0].(func(string) error)
+	// oauthclientDescID is the schema descriptor for id field.
+	oauthclientDescID := oauthclientMixinFields0[0].Descriptor()
+	// oauthclient.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	oauthclient.IDValidator = func() func(string) error {
+		validators := oauthclientDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+			validators[2].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	oauthcodeMixin := schema.OAuthCode{}.Mixin()
+	oauthcodeMixinHooks0 := oauthcodeMixin[0].Hooks()
+	oauthcode.Hooks[0] = oauthcodeMixinHooks0[0]
+	oauthcodeMixinInters0 := oauthcodeMixin[0].Interceptors()
+	oauthcode.Interceptors[0] = oauthcodeMixinInters0[0]
+	oauthcodeMixinFields0 := oauthcodeMixin[0].Fields()
+	_ = oauthcodeMixinFields0
+	oauthcodeFields := schema.OAuthCode{}.Fields()
+	_ = oauthcodeFields
+	// oauthcodeDescCreatedAt is the schema descriptor for created_at field.
+	oauthcodeDescCreatedAt := oauthcodeMixinFields0[1].Descriptor()
+	// oauthcode.DefaultCreatedAt holds the default value on creation for the created_at field.
+	oauthcode.DefaultCreatedAt = oauthcodeDescCreatedAt.Default.(func() time.Time)
+	// oauthcodeDescUpdatedAt is the schema descriptor for updated_at field.
+	oauthcodeDescUpdatedAt := oauthcodeMixinFields0[2].Descriptor()
+	// oauthcode.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	oauthcode.DefaultUpdatedAt = oauthcodeDescUpdatedAt.Default.(func() time.Time)
+	// oauthcode.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	oauthcode.UpdateDefaultUpdatedAt = oauthcodeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// oauthcodeDescDeletedAt is the schema descriptor for deleted_at field.
+	oauthcodeDescDeletedAt := oauthcodeMixinFields0[3].Descriptor()
+	// oauthcode.DefaultDeletedAt holds the default value on creation for the deleted_at field.
+	oauthcode.DefaultDeletedAt = oauthcodeDescDeletedAt.Default.(int64)
+	// oauthcodeDescClientID is the schema descriptor for client_id field.
+	oauthcodeDescClientID := oauthcodeFields[0].Descriptor()
+	// oauthcode.ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
+	oauthcode.ClientIDValidator = func() func(string) error {
+		validators := oauthcodeDescClientID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(client_id string) error {
+			for _, fn := range fns {
+				if err := fn(client_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// oauthcodeDescUserID is the schema descriptor for user_id field.
+	oauthcodeDescUserID := oauthcodeFields[1].Descriptor()
+	// oauthcode.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	oauthcode.UserIDValidator = func() func(string) error {
+		validators := oauthcodeDescUserID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(user_id string) error {
+			for _, fn := range fns {
+				if err := fn(user_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// oauthcodeDescCodeHash is the schema descriptor for code_hash field.
+	oauthcodeDescCodeHash := oauthcodeFields[2].Descriptor()
+	// oauthcode.CodeHashValidator is a validator for the "code_hash" field. It is called by the builders before save.
+	oauthcode.CodeHashValidator = func() func(string) error {
+		validators := oauthcodeDescCodeHash.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(code_hash string) error {
+			for _, fn := range fns {
+				if err := fn(code_hash); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// oauthcodeDescRedirectURI is the schema descriptor for redirect_uri field.
+	oauthcodeDescRedirectURI := oauthcodeFields[3].Descriptor()
+	// oauthcode.RedirectURIValidator is a validator for the "redirect_uri" field. It is called by the builders before save.
+	oauthcode.RedirectURIValidator = func() func(string) error {
+		validators := oauthcodeDescRedirectURI.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(redirect_uri string) error {
+			for _, fn := range fns {
+				if err := fn(redirect_uri); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// oauthcodeDescScope is the schema descriptor for scope field.
+	oauthcodeDescScope := oauthcodeFields[4].Descriptor()
+	// oauthcode.ScopeValidator is a validator for the "scope" field. It is called by the builders before save.
+	oauthcode.ScopeValidator = oauthcodeDescScope.Validators[0].(func(string) error)
+	// oauthcodeDescCodeChallenge is the schema descriptor for code_challenge field.
+	oauthcodeDescCodeChallenge := oauthcodeFields[5].Descriptor()
+	// oauthcode.CodeChallengeValidator is a validator for the "code_challenge" field. It is called by the builders before save.
+	oauthcode.CodeChallengeValidator = func() func(string) error {
+		validators := oauthcodeDescCodeChallenge.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(code_challenge string) error {
+			for _, fn := range fns {
+				if err := fn(code_challenge); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// oauthcodeDescFamilyID is the schema descriptor for family_id field.
+	oauthcodeDescFamilyID := oauthcodeFields[8].Descriptor()
+	// oauthcode.FamilyIDValidator is a validator for the "family_id" field. It is called by the builders before save.
+	oauthcode.FamilyIDValidator = oauthcodeDescFamilyID.Validators[0].(func(string) error)
+	// oauthcodeDescID is the schema descriptor for id field.
+	oauthcodeDescID := oauthcodeMixinFields0[0].Descriptor()
+	// oauthcode.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	oauthcode.IDValidator = func() func(string) error {
+		validators := oauthcodeDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+			validators[2].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	oidcstateMixin := schema.OIDCState{}.Mixin()
+	oidcstateMixinHooks0 := oidcstateMixin[0].Hooks()
+	oidcstate.Hooks[0] = oidcstateMixinHooks0[0]
+	oidcstateMixinInters0 := oidcstateMixin[0].Interceptors()
+	oidcstate.Interceptors[0] = oidcstateMixinInters0[0]
+	oidcstateMixinFields0 := oidcstateMixin[0].Fields()
+	_ = oidcstateMixinFields0
+	oidcstateFields := schema.OIDCState{}.Fields()
+	_ = oidcstateFields
+	// oidcstateDescCreatedAt is the schema descriptor for created_at field.
+	oidcstateDescCreatedAt := oidcstateMixinFields0[1].Descriptor()
+	// oidcstate.DefaultCreatedAt holds the default value on creation for the created_at field.
+	oidcstate.DefaultCreatedAt = oidcstateDescCreatedAt.Default.(func() time.Time)
+	// oidcstateDescUpdatedAt is the schema descriptor for updated_at field.
+	oidcstateDescUpdatedAt := oidcstateMixinFields0[2].Descriptor()
+	// oidcstate.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	oidcstate.DefaultUpdatedAt = oidcstateDescUpdatedAt.Default.(func() time.Time)
+	// oidcstate.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	oidcstate.UpdateDefaultUpdatedAt = oidcstateDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// oidcstateDescDeletedAt is the schema descriptor for deleted_at field.
+	oidcstateDescDeletedAt := oidcstateMixinFields0[3].Descriptor()
+	// oidcstate.DefaultDeletedAt holds the default value on creation for the deleted_at field.
+	oidcstate.DefaultDeletedAt = oidcstateDescDeletedAt.Default.(int64)
+	// oidcstateDescProvider is the schema descriptor for provider field.
+	oidcstateDescProvider := oidcstateFields[0].Descriptor()
+	// oidcstate.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	oidcstate.ProviderValidator = func() func(string) error {
+		validators := oidcstateDescProvider.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(provider string) error {
+			for _, fn := range fns {
+				if err := fn(provider); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// oidcstateDescStateHash is the schema descriptor for state_hash field.
+	oidcstateDescStateHash := oidcstateFields[1].Descriptor()
+	// oidcstate.StateHashValidator is a validator for the "state_hash" field. It is called by the builders before save.
+	oidcstate.StateHashValidator = func() func(string) error {
+		validators := oidcstateDescStateHash.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(state_hash string) error {
+			for _, fn := range fns {
+				if err := fn(state_hash); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// oidcstateDescNonce is the schema descriptor for nonce field.
+	oidcstateDescNonce := oidcstateFields[2].Descriptor()
+	// oidcstate.NonceValidator is a validator for the "nonce" field. It is called by the builders before save.
+	oidcstate.NonceValidator = func() func(string) error {
+		validators := oidcstateDescNonce.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(nonce string) error {
+			for _, fn := range fns {
+				if err := fn(nonce); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// oidcstateDescCodeVerifier is the schema descriptor for code_verifier field.
+	oidcstateDescCodeVerifier := oidcstateFields[3].Descriptor()
+	// oidcstate.CodeVerifierValidator is a validator for the "code_verifier" field. It is called by the builders before save.
+	oidcstate.CodeVerifierValidator = func() func(string) error {
+		validators := oidcstateDescCodeVerifier.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(code_verifier string) error {
+			for _, fn := range fns {
+				if err := fn(code_verifier); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// oidcstateDescID is the schema descriptor for id field.
+	oidcstateDescID := oidcstateMixinFields0[0].Descriptor()
+	// oidcstate.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	oidcstate.IDValidator = func() func(string) error {
+		validators := oidcstateDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+			validators[2].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	onetimetokenMixin := schema.OneTimeToken{}.Mixin()
+	onetimetokenMixinHooks0 := onetimetokenMixin[0].Hooks()
+	onetimetoken.Hooks[0] = onetimetokenMixinHooks0[0]
+	onetimetokenMixinInters0 := onetimetokenMixin[0].Interceptors()
+	onetimetoken.Interceptors[0] = onetimetokenMixinInters0[0]
+	onetimetokenMixinFields0 := onetimetokenMixin[0].Fields()
+	_ = onetimetokenMixinFields0
+	onetimetokenFields := schema.OneTimeToken{}.Fields()
+	_ = onetimetokenFields
+	// onetimetokenDescCreatedAt is the schema descriptor for created_at field.
+	onetimetokenDescCreatedAt := onetimetokenMixinFields0[1].Descriptor()
+	// onetimetoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	onetimetoken.DefaultCreatedAt = onetimetokenDescCreatedAt.Default.(func() time.Time)
+	// onetimetokenDescUpdatedAt is the schema descriptor for updated_at field.
+	onetimetokenDescUpdatedAt := onetimetokenMixinFields0[2].Descriptor()
+	// onetimetoken.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	onetimetoken.DefaultUpdatedAt = onetimetokenDescUpdatedAt.Default.(func() time.Time)
+	// onetimetoken.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	onetimetoken.UpdateDefaultUpdatedAt = onetimetokenDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// onetimetokenDescDeletedAt is the schema descriptor for deleted_at field.
+	onetimetokenDescDeletedAt := onetimetokenMixinFields0[3].Descriptor()
+	// onetimetoken.DefaultDeletedAt holds the default value on creation for the deleted_at field.
+	onetimetoken.DefaultDeletedAt = onetimetokenDescDeletedAt.Default.(int64)
+	// onetimetokenDescUserID is the schema descriptor for user_id field.
+	onetimetokenDescUserID := onetimetokenFields[0].Descriptor()
+	// onetimetoken.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	onetimetoken.UserIDValidator = func() func(string) error {
+		validators := onetimetokenDescUserID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(user string) error {
+			for _, fn := range fns {
+				if err := fn(user); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// onetimetokenDescTokenHash is the schema descriptor for token_hash field.
+	onetimetokenDescTokenHash := onetimetokenFields[2].Descriptor()
+	// onetimetoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	onetimetoken.TokenHashValidator = func() func(string) error {
+		validators := onetimetokenDescTokenHash.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(token_hash string) error {
+			for _, fn := range fns {
+				if err := fn(token_hash); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// onetimetokenDescNonceHash is the schema descriptor for nonce_hash field.
+	onetimetokenDescNonceHash := onetimetokenFields[3].Descriptor()
+	// onetimetoken.NonceHashValidator is a validator for the "nonce_hash" field. It is called by the builders before save.
+	onetimetoken.NonceHashValidator = onetimetokenDescNonceHash.Validators[0].(func(string) error)
+	// onetimetokenDescEmail is the schema descriptor for email field.
+	onetimetokenDescEmail := onetimetokenFields[4].Descriptor()
+	// onetimetoken.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	onetimetoken.EmailValidator = onetimetokenDescEmail.Validators[0].(func(string) error)
+	// onetimetokenDescID is the schema descriptor for id field.
+	onetimetokenDescID := onetimetokenMixinFields0[0].Descriptor()
+	// onetimetoken.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	onetimetoken.IDValidator = func() func(string) error {
+		validators := onetimetokenDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+			validators[2].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	passkeyMixin := schema.Passkey{}.Mixin()
+	passkeyMixinHooks0 := passkeyMixin[0].Hooks()
+	passkey.Hooks[0] = passkeyMixinHooks0[0]
+	passkeyMixinInters0 := passkeyMixin[0].Interceptors()
+	passkey.Interceptors[0] = passkeyMixinInters0[0]
+	passkeyMixinFields0 := passkeyMixin[0].Fields()
+	_ = passkeyMixinFields0
+	passkeyFields := schema.Passkey{}.Fields()
+	_ = passkeyFields
+	// passkeyDescCreatedAt is the schema descriptor for created_at field.
+	passkeyDescCreatedAt := passkeyMixinFields0[1].Descriptor()
+	// passkey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	passkey.DefaultCreatedAt = passkeyDescCreatedAt.Default.(func() time.Time)
+	// passkeyDescUpdatedAt is the schema descriptor for updated_at field.
+	passkeyDescUpdatedAt := passkeyMixinFields0[2].Descriptor()
+	// passkey.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	passkey.DefaultUpdatedAt = passkeyDescUpdatedAt.Default.(func() time.Time)
+	// passkey.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	passkey.UpdateDefaultUpdatedAt = passkeyDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// passkeyDescDeletedAt is the schema descriptor for deleted_at field.
+	passkeyDescDeletedAt := passkeyMixinFields0[3].Descriptor()
+	// passkey.DefaultDeletedAt holds the default value on creation for the deleted_at field.
+	passkey.DefaultDeletedAt = passkeyDescDeletedAt.Default.(int64)
+	// passkeyDescUserID is the schema descriptor for user_id field.
+	passkeyDescUserID := passkeyFields[0].Descriptor()
+	// passkey.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	passkey.UserIDValidator = func() func(string) error {
+		validators := passkeyDescUserID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(user string) error {
+			for _, fn := range fns {
+				if err := fn(user); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// passkeyDescName is the schema descriptor for name field.
+	passkeyDescName := passkeyFields[1].Descriptor()
+	// passkey.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	passkey.NameValidator = func() func(string) error {
+		validators := passkeyDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// passkeyDescCredentialID is the schema descriptor for credential_id field.
+	passkeyDescCredentialID := passkeyFields[2].Descriptor()
+	// passkey.CredentialIDValidator is a validator for the "credential_id" field. It is called by the builders before save.
+	passkey.CredentialIDValidator = func() func(string) error {
+		validators := passkeyDescCredentialID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(credential_id string) error {
+			for _, fn := range fns {
+				if err := fn(credential_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// passkeyDescPublicKey is the schema descriptor for public_key field.
+	passkeyDescPublicKey := passkeyFields[3].Descriptor()
+	// passkey.PublicKeyValidator is a validator for the "public_key" field. It is called by the builders before save.
+	passkey.PublicKeyValidator = passkeyDescPublicKey.Validators[0].(func([]byte) error)
+	// passkeyDescAttestationType is the schema descriptor for attestation_type field.
+	passkeyDescAttestationType := passkeyFields[4].Descriptor()
+	// passkey.AttestationTypeValidator is a validator for the "attestation_type" field. It is called by the builders before save.
+	passkey.AttestationTypeValidator = passkeyDescAttestationType.Validators[0].(func(string) error)
+	// passkeyDescSignCount is the schema descriptor for sign_count field.
+	passkeyDescSignCount := passkeyFields[7].Descriptor()
+	// passkey.DefaultSignCount holds the default value on creation for the sign_count field.
+	passkey.DefaultSignCount = passkeyDescSignCount.Default.(uint32)
+	// passkeyDescBackupEligible is the schema descriptor for backup_eligible field.
+	passkeyDescBackupEligible := passkeyFields[8].Descriptor()
+	// passkey.DefaultBackupEligible holds the default value on creation for the backup_eligible field.
+	passkey.DefaultBackupEligible = passkeyDescBackupEligible.Default.(bool)
+	// passkeyDescBackupState is the schema descriptor for backup_state field.
+	passkeyDescBackupState := passkeyFields[9].Descriptor()
+	// passkey.DefaultBackupState holds the default value on creation for the backup_state field.
+	passkey.DefaultBackupState = passkeyDescBackupState.Default.(bool)
+	// passkeyDescID is the schema descriptor for id field.
+	passkeyDescID := passkeyMixinFields0[0].Descriptor()
+	// passkey.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	passkey.IDValidator = func() func(string) error {
+		validators := passkeyDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+			validators[2].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	passkeysessionMixin := schema.PasskeySession{}.Mixin()
+	passkeysessionMixinHooks0 := passkeysessionMixin[0].Hooks()
+	passkeysession.Hooks[0] = passkeysessionMixinHooks0[0]
+	passkeysessionMixinInters0 := passkeysessionMixin[0].Interceptors()
+	passkeysession.Interceptors[0] = passkeysessionMixinInters0[0]
+	passkeysessionMixinFields0 := passkeysessionMixin[0].Fields()
+	_ = passkeysessionMixinFields0
+	passkeysessionFields := schema.PasskeySession{}.Fields()
+	_ = passkeysessionFields
+	// passkeysessionDescCreatedAt is the schema descriptor for created_at field.
+	passkeysessionDescCreatedAt := passkeysessionMixinFields0[1].Descriptor()
+	// passkeysession.DefaultCreatedAt holds the default value on creation for the created_at field.
+	passkeysession.DefaultCreatedAt = passkeysessionDescCreatedAt.Default.(func() time.Time)
+	// passkeysessionDescUpdatedAt is the schema descriptor for updated_at field.
+	passkeysessionDescUpdatedAt := passkeysessionMixinFields0[2].Descriptor()
+	// passkeysession.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	passkeysession.DefaultUpdatedAt = passkeysessionDescUpdatedAt.Default.(func() time.Time)
+	// passkeysession.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	passkeysession.UpdateDefaultUpdatedAt = passkeysessionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// passkeysessionDescDeletedAt is the schema descriptor for deleted_at field.
+	passkeysessionDescDeletedAt := passkeysessionMixinFields0[3].Descriptor()
+	// passkeysession.DefaultDeletedAt holds the default value on creation for the deleted_at field.
+	passkeysession.DefaultDeletedAt = passkeysessionDescDeletedAt.Default.(int64)
+	// passkeysessionDescUserID is the schema descriptor for user_id field.
+	passkeysessionDescUserID := passkeysessionFields[1].Descriptor()
+	// passkeysession.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	passkeysession.UserIDValidator = passkeysessionDescUserID.Validators[0].(func(string) error)
+	// passkeysessionDescTokenHash is the schema descriptor for token_hash field.
+	passkeysessionDescTokenHash := passkeysessionFields[2].Descriptor()
+	// passkeysession.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	passkeysession.TokenHashValidator = func() func(string) error {
+		validators := passkeysessionDescTokenHash.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(token_hash string) error {
+			for _, fn := range fns {
+				if err := fn(token_hash); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// passkeysessionDescID is the schema descriptor for id field.
+	passkeysessionDescID := passkeysessionMixinFields0[0].Descriptor()
+	// passkeysession.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	passkeysession.IDValidator = func() func(string) error {
+		validators := passkeysessionDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+			validators[2].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	passwordhistoryMixin := schema.PasswordHistory{}.Mixin()
+	passwordhistoryMixinHooks0 := passwordhistoryMixin[0].Hooks()
+	passwordhistory.Hooks[0] = passwordhistoryMixinHooks0[0]
+	passwordhistoryMixinInters0 := passwordhistoryMixin[0].Interceptors()
+	passwordhistory.Interceptors[0] = passwordhistoryMixinInters0[0]
+	passwordhistoryMixinFields0 := passwordhistoryMixin[0].Fields()
+	_ = passwordhistoryMixinFields0
+	passwordhistoryFields := schema.PasswordHistory{}.Fields()
+	_ = passwordhistoryFields
+	// passwordhistoryDescCreatedAt is the schema descriptor for created_at field.
+	passwordhistoryDescCreatedAt := passwordhistoryMixinFields0[1].Descriptor()
+	// passwordhistory.DefaultCreatedAt holds the default value on creation for the created_at field.
+	passwordhistory.DefaultCreatedAt = passwordhistoryDescCreatedAt.Default.(func() time.Time)
+	// passwordhistoryDescUpdatedAt is the schema descriptor for updated_at field.
+	passwordhistoryDescUpdatedAt := passwordhistoryMixinFields0[2].Descriptor()
+	// passwordhistory.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	passwordhistory.DefaultUpdatedAt = passwordhistoryDescUpdatedAt.Default.(func() time.Time)
+	// passwordhistory.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	passwordhistory.UpdateDefaultUpdatedAt = passwordhistoryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// passwordhistoryDescDeletedAt is the schema descriptor for deleted_at field.
+	passwordhistoryDescDeletedAt := passwordhistoryMixinFields0[3].Descriptor()
+	// passwordhistory.DefaultDeletedAt holds the default value on creation for the deleted_at field.
+	passwordhistory.DefaultDeletedAt = passwordhistoryDescDeletedAt.Default.(int64)
+	// passwordhistoryDescUserID is the schema descriptor for user_id field.
+	passwordhistoryDescUserID := passwordhistoryFields[0].Descriptor()
+	// passwordhistory.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	passwordhistory.UserIDValidator = func() func(string) error {
+		validators := passwordhistoryDescUserID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(user string) error {
+			for _, fn := range fns {
+				if err := fn(user); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// passwordhistoryDescPasswordHash is the schema descriptor for password_hash field.
+	passwordhistoryDescPasswordHash := passwordhistoryFields[1].Descriptor()
+	// passwordhistory.PasswordHashValidator is a validator for the "password_hash" field. It is called by the builders before save.
+	passwordhistory.PasswordHashValidator = func() func(string) error {
+		validators := passwordhistoryDescPasswordHash.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(password_hash string) error {
+			for _, fn := range fns {
+				if err := fn(password_hash); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// passwordhistoryDescID is the schema descriptor for id field.
+	passwordhistoryDescID := passwordhistoryMixinFields0[0].Descriptor()
+	// passwordhistory.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	passwordhistory.IDValidator = func() func(string) error {
+		validators := passwordhistoryDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+			validators[2].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	personalaccesstokenMixin := schema.PersonalAccessToken{}.Mixin()
+	personalaccesstokenMixinHooks0 := personalaccesstokenMixin[0].Hooks()
+	personalaccesstoken.Hooks[0] = personalaccesstokenMixinHooks0[0]
+	personalaccesstokenMixinInters0 := personalaccesstokenMixin[0].Interceptors()
+	personalaccesstoken.Interceptors[0] = personalaccesstokenMixinInters0[0]
+	personalaccesstokenMixinFields0 := personalaccesstokenMixin[0].Fields()
+	_ = personalaccesstokenMixinFields0
+	personalaccesstokenFields := schema.PersonalAccessToken{}.Fields()
+	_ = personalaccesstokenFields
+	// personalaccesstokenDescCreatedAt is the schema descriptor for created_at field.
+	personalaccesstokenDescCreatedAt := personalaccesstokenMixinFields0[1].Descriptor()
+	// personalaccesstoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	personalaccesstoken.DefaultCreatedAt = personalaccesstokenDescCreatedAt.Default.(func() time.Time)
+	// personalaccesstokenDescUpdatedAt is the schema descriptor for updated_at field.
+	personalaccesstokenDescUpdatedAt := personalaccesstokenMixinFields0[2].Descriptor()
+	// personalaccesstoken.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	personalaccesstoken.DefaultUpdatedAt = personalaccesstokenDescUpdatedAt.Default.(func() time.Time)
+	// personalaccesstoken.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	personalaccesstoken.UpdateDefaultUpdatedAt = personalaccesstokenDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// personalaccesstokenDescDeletedAt is the schema descriptor for deleted_at field.
+	personalaccesstokenDescDeletedAt := personalaccesstokenMixinFields0[3].Descriptor()
+	// personalaccesstoken.DefaultDeletedAt holds the default value on creation for the deleted_at field.
+	personalaccesstoken.DefaultDeletedAt = personalaccesstokenDescDeletedAt.Default.(int64)
+	// personalaccesstokenDescUserID is the schema descriptor for user_id field.
+	personalaccesstokenDescUserID := personalaccesstokenFields[0].Descriptor()
+	// personalaccesstoken.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	personalaccesstoken.UserIDValidator = func() func(string) error {
+		validators := personalaccesstokenDescUserID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(user string) error {
+			for _, fn := range fns {
+				if err := fn(user); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// personalaccesstokenDescName is the schema descriptor for name field.
+	personalaccesstokenDescName := personalaccesstokenFields[1].Descriptor()
+	// personalaccesstoken.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	personalaccesstoken.NameValidator = func() func(string) error {
+		validators := personalaccesstokenDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// personalaccesstokenDescTokenHash is the schema descriptor for token_hash field.
+	personalaccesstokenDescTokenHash := personalaccesstokenFields[2].Descriptor()
+	// personalaccesstoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	personalaccesstoken.TokenHashValidator = func() func(string) error {
+		validators := personalaccesstokenDescTokenHash.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(token_hash string) error {
+			for _, fn := range fns {
+				if err := fn(token_hash); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// personalaccesstokenDescTokenPrefix is the schema descriptor for token_prefix field.
+	personalaccesstokenDescTokenPrefix := personalaccesstokenFields[3].Descriptor()
+	// personalaccesstoken.TokenPrefixValidator is a validator for the "token_prefix" field. It is called by the builders before save.
+	personalaccesstoken.TokenPrefixValidator = func() func(string) error {
+		validators := personalaccesstokenDescTokenPrefix.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(token_prefix string) error {
+			for _, fn := range fns {
+				if err := fn(token_prefix); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// personalaccesstokenDescID is the schema descriptor for id field.
+	personalaccesstokenDescID := personalaccesstokenMixinFields0[0].Descriptor()
+	// personalaccesstoken.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	personalaccesstoken.IDValidator = func() func(string) error {
+		validators := personalaccesstokenDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+			validators[2].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	recoverycodeMixin := schema.RecoveryCode{}.Mixin()
+	recoverycodeMixinHooks0 := recoverycodeMixin[0].Hooks()
+	recoverycode.Hooks[0] = recoverycodeMixinHooks0[0]
+	recoverycodeMixinInters0 := recoverycodeMixin[0].Interceptors()
+	recoverycode.Interceptors[0] = recoverycodeMixinInters0[0]
+	recoverycodeMixinFields0 := recoverycodeMixin[0].Fields()
+	_ = recoverycodeMixinFields0
+	recoverycodeFields := schema.RecoveryCode{}.Fields()
+	_ = recoverycodeFields
+	// recoverycodeDescCreatedAt is the schema descriptor for created_at field.
+	recoverycodeDescCreatedAt := recoverycodeMixinFields0[1].Descriptor()
+	// recoverycode.DefaultCreatedAt holds the default value on creation for the created_at field.
+	recoverycode.DefaultCreatedAt = recoverycodeDescCreatedAt.Default.(func() time.Time)
+	// recoverycodeDescUpdatedAt is the schema descriptor for updated_at field.
+	recoverycodeDescUpdatedAt := recoverycodeMixinFields0[2].Descriptor()
+	// recoverycode.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	recoverycode.DefaultUpdatedAt = recoverycodeDescUpdatedAt.Default.(func() time.Time)
+	// recoverycode.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	recoverycode.UpdateDefaultUpdatedAt = recoverycodeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// recoverycodeDescDeletedAt is the schema descriptor for deleted_at field.
+	recoverycodeDescDeletedAt := recoverycodeMixinFields0[3].Descriptor()
+	// recoverycode.DefaultDeletedAt holds the default value on creation for the deleted_at field.
+	recoverycode.DefaultDeletedAt = recoverycodeDescDeletedAt.Default.(int64)
+	// recoverycodeDescUserID is the schema descriptor for user_id field.
+	recoverycodeDescUserID := recoverycodeFields[0].Descriptor()
+	// recoverycode.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	recoverycode.UserIDValidator = func() func(string) error {
+		validators := recoverycodeDescUserID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(user string) error {
+			for _, fn := range fns {
+				if err := fn(user); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// recoverycodeDescCodeHash is the schema descriptor for code_hash field.
+	recoverycodeDescCodeHash := recoverycodeFields[1].Descriptor()
+	// recoverycode.CodeHashValidator is a validator for the "code_hash" field. It is called by the builders before save.
+	recoverycode.CodeHashValidator = func() func(string) error {
+		validators := recoverycodeDescCodeHash.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(code_hash string) error {
+			for _, fn := range fns {
+				if err := fn(code_hash); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// recoverycodeDescID is the schema descriptor for id field.
+	recoverycodeDescID := recoverycodeMixinFields0[0].Descriptor()
+	// recoverycode.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	recoverycode.IDValidator = func() func(string) error {
+		validators := recoverycodeDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+			validators[2].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	securityeventMixin := schema.SecurityEvent{}.Mixin()
+	securityeventMixinHooks0 := securityeventMixin[0].Hooks()
+	securityevent.Hooks[0] = securityeventMixinHooks0[0]
+	securityeventMixinInters0 := securityeventMixin[0].Interceptors()
+	securityevent.Interceptors[0] = securityeventMixinInters0[0]
+	securityeventMixinFields0 := securityeventMixin[0].Fields()
+	_ = securityeventMixinFields0
+	securityeventFields := schema.SecurityEvent{}.Fields()
+	_ = securityeventFields
+	// securityeventDescCreatedAt is the schema descriptor for created_at field.
+	securityeventDescCreatedAt := securityeventMixinFields0[1].Descriptor()
+	// securityevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	securityevent.DefaultCreatedAt = securityeventDescCreatedAt.Default.(func() time.Time)
+	// securityeventDescUpdatedAt is the schema descriptor for updated_at field.
+	securityeventDescUpdatedAt := securityeventMixinFields0[2].Descriptor()
+	// securityevent.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	securityevent.DefaultUpdatedAt = securityeventDescUpdatedAt.Default.(func() time.Time)
+	// securityevent.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	securityevent.UpdateDefaultUpdatedAt = securityeventDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// securityeventDescDeletedAt is the schema descriptor for deleted_at field.
+	securityeventDescDeletedAt := securityeventMixinFields0[3].Descriptor()
+	// securityevent.DefaultDeletedAt holds the default value on creation for the deleted_at field.
+	securityevent.DefaultDeletedAt = securityeventDescDeletedAt.Default.(int64)
+	// securityeventDescUserID is the schema descriptor for user_id field.
+	securityeventDescUserID := securityeventFields[0].Descriptor()
+	// securityevent.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	securityevent.UserIDValidator = func() func(string) error {
+		validators := securityeventDescUserID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(user_id string) error {
+			for _, fn := range fns {
+				if err := fn(user_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// securityeventDescDetail is the schema descriptor for detail field.
+	securityeventDescDetail := securityeventFields[2].Descriptor()
+	// securityevent.DetailValidator is a validator for the "detail" field. It is called by the builders before save.
+	securityevent.DetailValidator = securityeventDescDetail.Validators[0].(func(string) error)
+	// securityeventDescIP is the schema descriptor for ip field.
+	securityeventDescIP := securityeventFields[3].Descriptor()
+	// securityevent.IPValidator is a validator for the "ip" field. It is called by the builders before save.
+	securityevent.IPValidator = securityeventDescIP.Validators[0].(func(string) error)
+	// securityeventDescUserAgent is the schema descriptor for user_agent field.
+	securityeventDescUserAgent := securityeventFields[4].Descriptor()
+	// securityevent.UserAgentValidator is a validator for the "user_agent" field. It is called by the builders before save.
+	securityevent.UserAgentValidator = securityeventDescUserAgent.Validators[0].(func(string) error)
+	// securityeventDescRequestID is the schema descriptor for request_id field.
+	securityeventDescRequestID := securityeventFields[5].Descriptor()
+	// securityevent.RequestIDValidator is a validator for the "request_id" field. It is called by the builders before save.
+	securityevent.RequestIDValidator = securityeventDescRequestID.Validators[0].(func(string) error)
+	// securityeventDescID is the schema descriptor for id field.
+	securityeventDescID := securityeventMixinFields0[0].Descriptor()
+	// securityevent.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	securityevent.IDValidator = func() func(string) error {
+		validators := securityeventDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+			validators[2].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	tokenMixin := schema.Token{}.Mixin()
 	tokenMixinHooks0 := tokenMixin[0].Hooks()
 	token.Hooks[0] = tokenMixinHooks0[0]
@@ -40,33 +1222,19 @@ func init() {
 	// tokenDescUserID is the schema descriptor for user_id field.
 	tokenDescUserID := tokenFields[0].Descriptor()
 	// token.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
-	token.UserIDValidator = func() func(string) error {
-		validators := tokenDescUserID.Validators
-		fns := [...]func(string) error{
-			validators[0].(func(string) error),
-			validators[1].(func(string) error),
-		}
-		return func(user string) error {
-			for _, fn := range fns {
-				if err := fn(user); err != nil {
-					return err
-				}
-			}
-			return nil
-		}
-	}()
-	// tokenDescToken is the schema descriptor for token field.
-	tokenDescToken := tokenFields[1].Descriptor()
-	// token.TokenValidator is a validator for the "token" field. It is called by the builders before save.
-	token.TokenValidator = func() func(string) error {
-		validators := tokenDescToken.Validators
+	token.UserIDValidator = tokenDescUserID.Validators[0].(func(string) error)
+	// tokenDescTokenHash is the schema descriptor for token_hash field.
+	tokenDescTokenHash := tokenFields[1].Descriptor()
+	// token.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	token.TokenHashValidator = func() func(string) error {
+		validators := tokenDescTokenHash.Validators
 		fns := [...]func(string) error{
 			validators[0].(func(string) error),
 			validators[1].(func(string) error),
 		}
-		return func(token string) error {
+		return func(token_hash string) error {
 			for _, fn := range fns {
-				if err := fn(token); err != nil {
+				if err := fn(token_hash); err != nil {
 					return err
 				}
 			}
@@ -77,6 +1245,30 @@ func init() {
 	tokenDescIsRevoked := tokenFields[4].Descriptor()
 	// token.DefaultIsRevoked holds the default value on creation for the is_revoked field.
 	token.DefaultIsRevoked = tokenDescIsRevoked.Default.(bool)
+	// tokenDescFamilyID is the schema descriptor for family_id field.
+	tokenDescFamilyID := tokenFields[6].Descriptor()
+	// token.FamilyIDValidator is a validator for the "family_id" field. It is called by the builders before save.
+	token.FamilyIDValidator = tokenDescFamilyID.Validators[0].(func(string) error)
+	// tokenDescClientID is the schema descriptor for client_id field.
+	tokenDescClientID := tokenFields[8].Descriptor()
+	// token.ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
+	token.ClientIDValidator = tokenDescClientID.Validators[0].(func(string) error)
+	// tokenDescScope is the schema descriptor for scope field.
+	tokenDescScope := tokenFields[9].Descriptor()
+	// token.ScopeValidator is a validator for the "scope" field. It is called by the builders before save.
+	token.ScopeValidator = tokenDescScope.Validators[0].(func(string) error)
+	// tokenDescImpersonatorID is the schema descriptor for impersonator_id field.
+	tokenDescImpersonatorID := tokenFields[11].Descriptor()
+	// token.ImpersonatorIDValidator is a validator for the "impersonator_id" field. It is called by the builders before save.
+	token.ImpersonatorIDValidator = tokenDescImpersonatorID.Validators[0].(func(string) error)
+	// tokenDescUserAgent is the schema descriptor for user_agent field.
+	tokenDescUserAgent := tokenFields[12].Descriptor()
+	// token.UserAgentValidator is a validator for the "user_agent" field. It is called by the builders before save.
+	token.UserAgentValidator = tokenDescUserAgent.Validators[0].(func(string) error)
+	// tokenDescIP is the schema descriptor for ip field.
+	tokenDescIP := tokenFields[13].Descriptor()
+	// token.IPValidator is a validator for the "ip" field. It is called by the builders before save.
+	token.IPValidator = tokenDescIP.Validators[0].(func(string) error)
 	// tokenDescID is the schema descriptor for id field.
 	tokenDescID := tokenMixinFields0[0].Descriptor()
 	// token.IDValidator is a validator for the "id" field. It is called by the builders before save.
@@ -155,8 +1347,12 @@ func init() {
 			return nil
 		}
 	}()
+	// userDescPendingEmail is the schema descriptor for pending_email field.
+	userDescPendingEmail := userFields[2].Descriptor()
+	// user.PendingEmailValidator is a validator for the "pending_email" field. It is called by the builders before save.
+	user.PendingEmailValidator = userDescPendingEmail.Validators[0].(func(string) error)
 	// userDescPasswordHash is the schema descriptor for password_hash field.
-	userDescPasswordHash := userFields[2].Descriptor()
+	userDescPasswordHash := userFields[3].Descriptor()
 	// user.PasswordHashValidator is a validator for the "password_hash" field. It is called by the builders before save.
 	user.PasswordHashValidator = func() func(string) error {
 		validators := userDescPasswordHash.Validators
@@ -173,6 +1369,18 @@ func init() {
 			return nil
 		}
 	}()
+	// userDescTotpSecret is the schema descriptor for totp_secret field.
+	userDescTotpSecret := userFields[7].Descriptor()
+	// user.TotpSecretValidator is a validator for the "totp_secret" field. It is called by the builders before save.
+	user.TotpSecretValidator = userDescTotpSecret.Validators[0].(func(string) error)
+	// userDescTwoFactorEnabled is the schema descriptor for two_factor_enabled field.
+	userDescTwoFactorEnabled := userFields[8].Descriptor()
+	// user.DefaultTwoFactorEnabled holds the default value on creation for the two_factor_enabled field.
+	user.DefaultTwoFactorEnabled = userDescTwoFactorEnabled.Default.(bool)
+	// userDescTotpLastStep is the schema descriptor for totp_last_step field.
+	userDescTotpLastStep := userFields[9].Descriptor()
+	// user.DefaultTotpLastStep holds the default value on creation for the totp_last_step field.
+	user.DefaultTotpLastStep = userDescTotpLastStep.Default.(int64)
 	// userDescID is the schema descriptor for id field.
 	userDescID := userMixinFields0[0].Descriptor()
 	// user.IDValidator is a validator for the "id" field. It is called by the builders before save.
@@ -195,6 +1403,6 @@ func init() {
 }
 
 const (
-	Version = "v0.14.4"                                         // Version of ent codegen.
-	Sum     = "h1:/DhDraSLXIkBhyiVoJeSshr4ZYi7femzhj6/TckzZuI=" // Sum of ent codegen.
+	Version = "v0.14.5"                                         // Version of ent codegen.
+	Sum     = "h1:Rj2WOYJtCkWyFo6a+5wB3EfBRP0rnx1fMk6gGA0UUe4=" // Sum of ent codegen.
 )
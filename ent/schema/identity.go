@@ -0,0 +1,80 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Identity holds the schema definition for the Identity entity.
+// 外部身份提供方（OIDC）账户与本地用户的关联
+type Identity struct {
+	ent.Schema
+}
+
+// Mixin 返回Identity实体使用的mixin
+func (Identity) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		DefaultMixin{},
+	}
+}
+
+// Fields of the Identity.
+func (Identity) Fields() []ent.Field {
+	return []ent.Field{
+		// 关联用户ID
+		field.String("user_id").
+			MaxLen(26).
+			NotEmpty().
+			Comment("关联的用户ID"),
+
+		// 身份提供方
+		field.String("provider").
+			MaxLen(64).
+			NotEmpty().
+			Comment("身份提供方名称，对应配置中的 oidc.providers.name"),
+
+		// 外部账户标识
+		field.String("subject").
+			MaxLen(255).
+			NotEmpty().
+			Comment("身份提供方签发的用户标识（sub）"),
+
+		// 外部账户邮箱
+		field.String("email").
+			MaxLen(255).
+			Optional().
+			Comment("最近一次登录时身份提供方返回的邮箱"),
+
+		// 最后登录时间
+		field.Time("last_login_at").
+			Optional().
+			Nillable().
+			Comment("最近一次通过该身份登录的时间"),
+	}
+}
+
+// Edges of the Identity.
+func (Identity) Edges() []ent.Edge {
+	return []ent.Edge{
+		// 外部身份属于一个用户
+		edge.From("user", User.Type).
+			Ref("identities").
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the Identity.
+func (Identity) Indexes() []ent.Index {
+	return []ent.Index{
+		// 同一身份提供方的用户标识唯一（包含删除状态）
+		index.Fields("provider", "subject", "deleted_at").
+			Unique(),
+
+		// 查询优化索引
+		index.Fields("user_id"),
+	}
+}
@@ -0,0 +1,85 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Impersonation holds the schema definition for the Impersonation entity.
+// 管理员代为登录用户账户的审计记录，用户或管理员被删除后仍然保留
+type Impersonation struct {
+	ent.Schema
+}
+
+// Mixin 返回Impersonation实体使用的mixin
+func (Impersonation) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		DefaultMixin{},
+	}
+}
+
+// Fields of the Impersonation.
+func (Impersonation) Fields() []ent.Field {
+	return []ent.Field{
+		// 发起代为登录的管理员ID
+		field.String("admin_id").
+			MaxLen(26).
+			NotEmpty().
+			Comment("发起代为登录的管理员ID"),
+
+		// 被代为登录的用户ID
+		field.String("user_id").
+			MaxLen(26).
+			NotEmpty().
+			Comment("被代为登录的用户ID"),
+
+		// 代为登录原因
+		field.String("reason").
+			MaxLen(500).
+			NotEmpty().
+			Comment("代为登录的原因，例如工单编号"),
+
+		// 代为登录签发的令牌族ID
+		field.String("family_id").
+			MaxLen(26).
+			NotEmpty().
+			Comment("代为登录签发的令牌族ID，用于结束代为登录时撤销令牌"),
+
+		// 开始时间
+		field.Time("started_at").
+			Comment("开始时间"),
+
+		// 令牌过期时间
+		field.Time("expires_at").
+			Comment("代为登录令牌的过期时间，未主动结束时即为结束时间"),
+
+		// 结束时间
+		field.Time("ended_at").
+			Optional().
+			Nillable().
+			Comment("主动结束的时间"),
+
+		// 客户端信息
+		field.String("user_agent").
+			MaxLen(512).
+			Optional().
+			Comment("管理员发起代为登录时的User-Agent"),
+		field.String("ip").
+			MaxLen(64).
+			Optional().
+			Comment("管理员发起代为登录时的IP地址"),
+	}
+}
+
+// Indexes of the Impersonation.
+func (Impersonation) Indexes() []ent.Index {
+	return []ent.Index{
+		// 按管理员和用户查询审计记录
+		index.Fields("admin_id", "started_at"),
+		index.Fields("user_id", "started_at"),
+
+		// 结束代为登录时按令牌族查找
+		index.Fields("family_id"),
+	}
+}
@@ -0,0 +1,78 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// OAuthClient holds the schema definition for the OAuthClient entity.
+// 注册到授权服务的第三方应用，实体ID即 client_id
+type OAuthClient struct {
+	ent.Schema
+}
+
+// Mixin 返回OAuthClient实体使用的mixin
+func (OAuthClient) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		DefaultMixin{},
+	}
+}
+
+// Fields of the OAuthClient.
+func (OAuthClient) Fields() []ent.Field {
+	return []ent.Field{
+		// 注册客户端的用户ID
+		field.String("user_id").
+			MaxLen(26).
+			NotEmpty().
+			Comment("注册客户端的用户ID"),
+
+		// 客户端名称
+		field.String("name").
+			MaxLen(100).
+			NotEmpty().
+			Comment("客户端名称，在授权确认页面展示给用户"),
+
+		// 客户端密钥哈希值
+		field.String("secret_hash").
+			MaxLen(64).
+			Optional().
+			Sensitive().
+			Comment("客户端密钥的HMAC-SHA256哈希值，公开客户端为空"),
+
+		// 回调地址
+		field.Strings("redirect_uris").
+			Optional().
+			Comment("允许的授权回调地址，需完全匹配"),
+
+		// 授权模式
+		field.Strings("grant_types").
+			Comment("允许使用的授权模式：authorization_code、client_credentials"),
+
+		// 授权范围
+		field.Strings("scopes").
+			Comment("允许申请的授权范围"),
+	}
+}
+
+// Edges of the OAuthClient.
+func (OAuthClient) Edges() []ent.Edge {
+	return []ent.Edge{
+		// 多个客户端属于一个用户
+		edge.From("user", User.Type).
+			Ref("oauth_clients").
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the OAuthClient.
+func (OAuthClient) Indexes() []ent.Index {
+	return []ent.Index{
+		// 用户ID索引
+		index.Fields("user_id"),
+	}
+}
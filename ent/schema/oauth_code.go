@@ -0,0 +1,90 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// OAuthCode holds the schema definition for the OAuthCode entity.
+// 用户同意授权后签发给客户端的授权码，只能换取一次令牌
+type OAuthCode struct {
+	ent.Schema
+}
+
+// Mixin 返回OAuthCode实体使用的mixin
+func (OAuthCode) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		DefaultMixin{},
+	}
+}
+
+// Fields of the OAuthCode.
+func (OAuthCode) Fields() []ent.Field {
+	return []ent.Field{
+		// 客户端ID
+		field.String("client_id").
+			MaxLen(26).
+			NotEmpty().
+			Comment("获得授权的OAuth客户端ID"),
+
+		// 授权用户ID
+		field.String("user_id").
+			MaxLen(26).
+			NotEmpty().
+			Comment("同意授权的用户ID"),
+
+		// 授权码哈希值
+		field.String("code_hash").
+			MaxLen(64).
+			NotEmpty().
+			Sensitive().
+			Comment("授权码的HMAC-SHA256哈希值"),
+
+		// 回调地址
+		field.String("redirect_uri").
+			MaxLen(2048).
+			NotEmpty().
+			Comment("授权请求中的回调地址，换取令牌时必须一致"),
+
+		// 授权范围
+		field.String("scope").
+			MaxLen(1024).
+			Optional().
+			Comment("用户同意的授权范围，以空格分隔"),
+
+		// PKCE 挑战值
+		field.String("code_challenge").
+			MaxLen(128).
+			NotEmpty().
+			Comment("PKCE code_challenge（S256）"),
+
+		// 过期时间
+		field.Time("expires_at").
+			Comment("授权码过期时间"),
+
+		// 使用时间
+		field.Time("used_at").
+			Optional().
+			Nillable().
+			Comment("换取令牌的时间，为空表示尚未使用"),
+
+		// 令牌族ID
+		field.String("family_id").
+			MaxLen(26).
+			Optional().
+			Comment("换取的令牌所属的令牌族，授权码被重放时据此撤销令牌"),
+	}
+}
+
+// Indexes of the OAuthCode.
+func (OAuthCode) Indexes() []ent.Index {
+	return []ent.Index{
+		// 授权码哈希唯一索引（包含删除状态）
+		index.Fields("code_hash", "deleted_at").
+			Unique(),
+
+		// 查询优化索引
+		index.Fields("expires_at"),
+	}
+}
@@ -0,0 +1,74 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// OIDCState holds the schema definition for the OIDCState entity.
+// OIDC授权码流程中跳转到身份提供方之前保存的 state、nonce 和 PKCE 校验值
+type OIDCState struct {
+	ent.Schema
+}
+
+// Mixin 返回OIDCState实体使用的mixin
+func (OIDCState) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		DefaultMixin{},
+	}
+}
+
+// Fields of the OIDCState.
+func (OIDCState) Fields() []ent.Field {
+	return []ent.Field{
+		// 身份提供方
+		field.String("provider").
+			MaxLen(64).
+			NotEmpty().
+			Comment("发起登录的身份提供方名称"),
+
+		// state 哈希值
+		field.String("state_hash").
+			MaxLen(64).
+			NotEmpty().
+			Sensitive().
+			Comment("state参数的HMAC-SHA256哈希值"),
+
+		// nonce
+		field.String("nonce").
+			MaxLen(64).
+			NotEmpty().
+			Sensitive().
+			Comment("写入ID令牌的nonce，用于防止令牌重放"),
+
+		// PKCE 校验值
+		field.String("code_verifier").
+			MaxLen(128).
+			NotEmpty().
+			Sensitive().
+			Comment("PKCE code_verifier"),
+
+		// 过期时间
+		field.Time("expires_at").
+			Comment("登录流程过期时间"),
+
+		// 使用时间
+		field.Time("used_at").
+			Optional().
+			Nillable().
+			Comment("回调使用时间，为空表示仍然有效"),
+	}
+}
+
+// Indexes of the OIDCState.
+func (OIDCState) Indexes() []ent.Index {
+	return []ent.Index{
+		// state 哈希唯一索引（包含删除状态）
+		index.Fields("state_hash", "deleted_at").
+			Unique(),
+
+		// 查询优化索引
+		index.Fields("expires_at"),
+	}
+}
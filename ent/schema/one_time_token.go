@@ -0,0 +1,92 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// OneTimeToken holds the schema definition for the OneTimeToken entity.
+// 邮箱验证、重置密码等场景使用的一次性令牌，只保存令牌的哈希值
+type OneTimeToken struct {
+	ent.Schema
+}
+
+// Mixin 返回OneTimeToken实体使用的mixin
+func (OneTimeToken) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		DefaultMixin{},
+	}
+}
+
+// Fields of the OneTimeToken.
+func (OneTimeToken) Fields() []ent.Field {
+	return []ent.Field{
+		// 关联用户ID
+		field.String("user_id").
+			MaxLen(26).
+			NotEmpty().
+			Comment("关联的用户ID"),
+
+		// 令牌用途
+		field.Enum("purpose").
+			Values("verify_email", "reset_password", "mfa_challenge", "magic_link", "change_email", "revert_email", "oidc_login").
+			Comment("令牌用途：verify_email-邮箱验证，reset_password-重置密码，mfa_challenge-两步验证登录，magic_link-邮件链接登录，change_email-确认修改邮箱，revert_email-撤销修改邮箱，oidc_login-外部账户登录换取令牌"),
+
+		// 令牌哈希值
+		field.String("token_hash").
+			MaxLen(64).
+			NotEmpty().
+			Sensitive().
+			Comment("令牌的HMAC-SHA256哈希值"),
+
+		// 绑定值哈希
+		field.String("nonce_hash").
+			MaxLen(64).
+			Optional().
+			Sensitive().
+			Comment("发起请求的客户端持有的随机值的HMAC-SHA256哈希，为空表示不绑定客户端"),
+
+		// 关联邮箱
+		field.String("email").
+			MaxLen(255).
+			Optional().
+			Comment("修改邮箱相关令牌关联的邮箱：确认修改时为新邮箱，撤销修改时为原邮箱"),
+
+		// 过期时间
+		field.Time("expires_at").
+			Comment("令牌过期时间"),
+
+		// 使用时间
+		field.Time("used_at").
+			Optional().
+			Nillable().
+			Comment("令牌使用或作废时间，为空表示仍然有效"),
+	}
+}
+
+// Edges of the OneTimeToken.
+func (OneTimeToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		// 令牌属于一个用户
+		edge.From("user", User.Type).
+			Ref("one_time_tokens").
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the OneTimeToken.
+func (OneTimeToken) Indexes() []ent.Index {
+	return []ent.Index{
+		// 令牌哈希唯一索引（包含删除状态）
+		index.Fields("token_hash", "deleted_at").
+			Unique(),
+
+		// 查询优化索引
+		index.Fields("user_id", "purpose"),
+		index.Fields("expires_at"),
+	}
+}
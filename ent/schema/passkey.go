@@ -0,0 +1,110 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Passkey holds the schema definition for the Passkey entity.
+// 用户注册的WebAuthn凭据，用于无密码登录
+type Passkey struct {
+	ent.Schema
+}
+
+// Mixin 返回Passkey实体使用的mixin
+func (Passkey) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		DefaultMixin{},
+	}
+}
+
+// Fields of the Passkey.
+func (Passkey) Fields() []ent.Field {
+	return []ent.Field{
+		// 关联用户ID
+		field.String("user_id").
+			MaxLen(26).
+			NotEmpty().
+			Comment("关联的用户ID"),
+
+		// 凭据名称
+		field.String("name").
+			MaxLen(64).
+			NotEmpty().
+			Comment("凭据名称，便于用户区分不同设备"),
+
+		// 凭据ID
+		field.String("credential_id").
+			MaxLen(1366).
+			NotEmpty().
+			Comment("认证器生成的凭据ID，base64url编码"),
+
+		// 公钥
+		field.Bytes("public_key").
+			NotEmpty().
+			Comment("COSE格式的凭据公钥"),
+
+		// 证明类型
+		field.String("attestation_type").
+			MaxLen(32).
+			Optional().
+			Comment("注册时的证明格式"),
+
+		// 认证器型号
+		field.Bytes("aaguid").
+			Optional().
+			Comment("认证器型号标识"),
+
+		// 支持的传输方式
+		field.Strings("transports").
+			Optional().
+			Comment("认证器支持的传输方式：usb/nfc/ble/internal/hybrid"),
+
+		// 签名计数
+		field.Uint32("sign_count").
+			Default(0).
+			Comment("认证器签名计数，用于检测克隆的认证器"),
+
+		// 是否可备份
+		field.Bool("backup_eligible").
+			Default(false).
+			Comment("凭据是否可以在设备间同步"),
+
+		// 是否已备份
+		field.Bool("backup_state").
+			Default(false).
+			Comment("凭据是否已经同步到其他设备"),
+
+		// 最后使用时间
+		field.Time("last_used_at").
+			Optional().
+			Nillable().
+			Comment("最后一次用于登录的时间"),
+	}
+}
+
+// Edges of the Passkey.
+func (Passkey) Edges() []ent.Edge {
+	return []ent.Edge{
+		// 凭据属于一个用户
+		edge.From("user", User.Type).
+			Ref("passkeys").
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the Passkey.
+func (Passkey) Indexes() []ent.Index {
+	return []ent.Index{
+		// 凭据ID唯一索引（包含删除状态）
+		index.Fields("credential_id", "deleted_at").
+			Unique(),
+
+		// 查询优化索引
+		index.Fields("user_id"),
+	}
+}
@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PasskeySession holds the schema definition for the PasskeySession entity.
+// WebAuthn注册与登录流程中开始和完成两步之间保存的挑战数据
+type PasskeySession struct {
+	ent.Schema
+}
+
+// Mixin 返回PasskeySession实体使用的mixin
+func (PasskeySession) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		DefaultMixin{},
+	}
+}
+
+// Fields of the PasskeySession.
+func (PasskeySession) Fields() []ent.Field {
+	return []ent.Field{
+		// 流程类型
+		field.Enum("ceremony").
+			Values("registration", "login").
+			Comment("流程类型：registration-注册凭据，login-登录"),
+
+		// 关联用户ID
+		field.String("user_id").
+			MaxLen(26).
+			Optional().
+			Comment("注册凭据的用户ID，无密码登录时为空"),
+
+		// 会话令牌哈希值
+		field.String("token_hash").
+			MaxLen(64).
+			NotEmpty().
+			Sensitive().
+			Comment("会话令牌的HMAC-SHA256哈希值"),
+
+		// 挑战数据
+		field.Bytes("data").
+			Sensitive().
+			Comment("JSON编码的WebAuthn会话数据"),
+
+		// 过期时间
+		field.Time("expires_at").
+			Comment("会话过期时间"),
+
+		// 使用时间
+		field.Time("used_at").
+			Optional().
+			Nillable().
+			Comment("会话使用时间，为空表示仍然有效"),
+	}
+}
+
+// Indexes of the PasskeySession.
+func (PasskeySession) Indexes() []ent.Index {
+	return []ent.Index{
+		// 令牌哈希唯一索引（包含删除状态）
+		index.Fields("token_hash", "deleted_at").
+			Unique(),
+
+		// 查询优化索引
+		index.Fields("expires_at"),
+	}
+}
@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PasswordHistory holds the schema definition for the PasswordHistory entity.
+// 用户曾经使用过的密码哈希，用于阻止修改密码时重复使用最近的密码
+type PasswordHistory struct {
+	ent.Schema
+}
+
+// Mixin 返回PasswordHistory实体使用的mixin
+func (PasswordHistory) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		DefaultMixin{},
+	}
+}
+
+// Fields of the PasswordHistory.
+func (PasswordHistory) Fields() []ent.Field {
+	return []ent.Field{
+		// 关联用户ID
+		field.String("user_id").
+			MaxLen(26).
+			NotEmpty().
+			Comment("关联的用户ID"),
+
+		// 密码哈希值
+		field.String("password_hash").
+			MaxLen(255).
+			NotEmpty().
+			Sensitive().
+			Comment("被替换的密码哈希值"),
+	}
+}
+
+// Edges of the PasswordHistory.
+func (PasswordHistory) Edges() []ent.Edge {
+	return []ent.Edge{
+		// 多条密码历史属于一个用户
+		edge.From("user", User.Type).
+			Ref("password_histories").
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the PasswordHistory.
+func (PasswordHistory) Indexes() []ent.Index {
+	return []ent.Index{
+		// 按用户查询最近的密码
+		index.Fields("user_id", "created_at"),
+	}
+}
@@ -0,0 +1,97 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PersonalAccessToken holds the schema definition for the PersonalAccessToken entity.
+// 用户为脚本和CI签发的长期令牌，只能访问声明了对应授权范围的接口
+type PersonalAccessToken struct {
+	ent.Schema
+}
+
+// Mixin 返回PersonalAccessToken实体使用的mixin
+func (PersonalAccessToken) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		DefaultMixin{},
+	}
+}
+
+// Fields of the PersonalAccessToken.
+func (PersonalAccessToken) Fields() []ent.Field {
+	return []ent.Field{
+		// 关联用户ID
+		field.String("user_id").
+			MaxLen(26).
+			NotEmpty().
+			Comment("关联的用户ID"),
+
+		// 令牌名称
+		field.String("name").
+			MaxLen(64).
+			NotEmpty().
+			Comment("令牌名称，便于用户区分不同用途"),
+
+		// 令牌哈希值
+		field.String("token_hash").
+			MaxLen(64).
+			NotEmpty().
+			Sensitive().
+			Comment("令牌的HMAC-SHA256哈希值"),
+
+		// 令牌前缀
+		field.String("token_prefix").
+			MaxLen(16).
+			NotEmpty().
+			Comment("令牌开头的若干字符，便于用户识别令牌"),
+
+		// 授权范围
+		field.Strings("scopes").
+			Comment("令牌的授权范围"),
+
+		// 过期时间
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			Comment("过期时间，为空表示永不过期"),
+
+		// 最后使用时间
+		field.Time("last_used_at").
+			Optional().
+			Nillable().
+			Comment("最后使用时间"),
+
+		// 撤销时间
+		field.Time("revoked_at").
+			Optional().
+			Nillable().
+			Comment("撤销时间，为空表示仍然有效"),
+	}
+}
+
+// Edges of the PersonalAccessToken.
+func (PersonalAccessToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		// 多个个人访问令牌属于一个用户
+		edge.From("user", User.Type).
+			Ref("personal_access_tokens").
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the PersonalAccessToken.
+func (PersonalAccessToken) Indexes() []ent.Index {
+	return []ent.Index{
+		// 令牌哈希唯一索引（包含删除状态）
+		index.Fields("token_hash", "deleted_at").
+			Unique(),
+
+		// 用户ID索引
+		index.Fields("user_id"),
+	}
+}
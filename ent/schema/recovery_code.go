@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RecoveryCode holds the schema definition for the RecoveryCode entity.
+// 两步验证的一次性恢复码，只保存哈希值
+type RecoveryCode struct {
+	ent.Schema
+}
+
+// Mixin 返回RecoveryCode实体使用的mixin
+func (RecoveryCode) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		DefaultMixin{},
+	}
+}
+
+// Fields of the RecoveryCode.
+func (RecoveryCode) Fields() []ent.Field {
+	return []ent.Field{
+		// 关联用户ID
+		field.String("user_id").
+			MaxLen(26).
+			NotEmpty().
+			Comment("关联的用户ID"),
+
+		// 恢复码哈希值
+		field.String("code_hash").
+			MaxLen(64).
+			NotEmpty().
+			Sensitive().
+			Comment("恢复码的HMAC-SHA256哈希值"),
+
+		// 使用时间
+		field.Time("used_at").
+			Optional().
+			Nillable().
+			Comment("恢复码使用时间，为空表示未使用"),
+	}
+}
+
+// Edges of the RecoveryCode.
+func (RecoveryCode) Edges() []ent.Edge {
+	return []ent.Edge{
+		// 恢复码属于一个用户
+		edge.From("user", User.Type).
+			Ref("recovery_codes").
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the RecoveryCode.
+func (RecoveryCode) Indexes() []ent.Index {
+	return []ent.Index{
+		// 查询优化索引
+		index.Fields("user_id", "code_hash"),
+	}
+}
@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/liukeshao/echo-template/pkg/types"
+)
+
+// SecurityEvent holds the schema definition for the SecurityEvent entity.
+// 用户账户的安全事件记录，例如登录、修改密码、撤销会话，超过保留期后由清理任务删除
+type SecurityEvent struct {
+	ent.Schema
+}
+
+// Mixin 返回SecurityEvent实体使用的mixin
+func (SecurityEvent) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		DefaultMixin{},
+	}
+}
+
+// Fields of the SecurityEvent.
+func (SecurityEvent) Fields() []ent.Field {
+	return []ent.Field{
+		// 关联用户ID
+		field.String("user_id").
+			MaxLen(26).
+			NotEmpty().
+			Comment("事件所属的用户ID"),
+
+		// 事件类型
+		field.Enum("type").
+			Values(types.SecurityEventTypes()...).
+			Comment("事件类型，例如 login_succeeded、password_changed"),
+
+		// 补充说明
+		field.String("detail").
+			MaxLen(255).
+			Optional().
+			Comment("事件补充说明，例如登录失败原因、被撤销的会话ID"),
+
+		// 客户端信息
+		field.String("ip").
+			MaxLen(64).
+			Optional().
+			Comment("客户端IP地址"),
+		field.String("user_agent").
+			MaxLen(512).
+			Optional().
+			Comment("客户端User-Agent"),
+		field.String("request_id").
+			MaxLen(64).
+			Optional().
+			Comment("请求ID，用于关联服务端日志"),
+	}
+}
+
+// Indexes of the SecurityEvent.
+func (SecurityEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		// 按用户分页查询事件
+		index.Fields("user_id", "created_at"),
+	}
+}
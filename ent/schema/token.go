@@ -25,15 +25,15 @@ func (Token) Fields() []ent.Field {
 		// 关联用户ID
 		field.String("user_id").
 			MaxLen(26).
-			NotEmpty().
-			Comment("关联的用户ID"),
+			Optional().
+			Comment("关联的用户ID，客户端凭据模式签发的令牌为空"),
 
-		// Token值（JWT token）
-		field.String("token").
-			MaxLen(1000).
+		// Token哈希值（不保存原始JWT）
+		field.String("token_hash").
+			MaxLen(64).
 			NotEmpty().
 			Sensitive().
-			Comment("JWT token值"),
+			Comment("JWT token的HMAC-SHA256哈希值"),
 
 		// Token类型
 		field.Enum("type").
@@ -54,6 +54,50 @@ func (Token) Fields() []ent.Field {
 			Optional().
 			Nillable().
 			Comment("最后使用时间"),
+
+		// 令牌族ID
+		field.String("family_id").
+			MaxLen(26).
+			Optional().
+			Comment("令牌族ID，同一次登录及其后续轮换签发的令牌共享同一个族"),
+
+		// 轮换时间
+		field.Time("rotated_at").
+			Optional().
+			Nillable().
+			Comment("refresh token被轮换的时间，非空表示已换发新令牌"),
+
+		// OAuth授权信息
+		field.String("client_id").
+			MaxLen(26).
+			Optional().
+			Comment("获得授权的OAuth客户端ID，第一方登录签发的令牌为空"),
+		field.String("scope").
+			MaxLen(1024).
+			Optional().
+			Comment("OAuth授权范围，以空格分隔"),
+
+		// 最近认证时间
+		field.Time("auth_time").
+			Optional().
+			Nillable().
+			Comment("用户最近一次输入密码或验证码完成认证的时间，登录时设置，重新验证身份后更新"),
+
+		// 代为登录的管理员
+		field.String("impersonator_id").
+			MaxLen(26).
+			Optional().
+			Comment("代为登录的管理员ID，非空表示该令牌由管理员代替用户登录签发"),
+
+		// 客户端信息
+		field.String("user_agent").
+			MaxLen(512).
+			Optional().
+			Comment("签发令牌时客户端的User-Agent"),
+		field.String("ip").
+			MaxLen(64).
+			Optional().
+			Comment("签发令牌时客户端的IP地址"),
 	}
 }
 
@@ -64,16 +108,15 @@ func (Token) Edges() []ent.Edge {
 		edge.From("user", User.Type).
 			Ref("tokens").
 			Field("user_id").
-			Unique().
-			Required(),
+			Unique(),
 	}
 }
 
 // Indexes of the Token.
 func (Token) Indexes() []ent.Index {
 	return []ent.Index{
-		// Token值唯一索引（包含删除状态）
-		index.Fields("token", "deleted_at").
+		// Token哈希唯一索引（包含删除状态）
+		index.Fields("token_hash", "deleted_at").
 			Unique(),
 
 		// 用户ID索引
@@ -91,5 +134,11 @@ func (Token) Indexes() []ent.Index {
 
 		// 复合索引（用户ID + 删除状态）
 		index.Fields("user_id", "deleted_at"),
+
+		// 令牌族索引（用于整族撤销）
+		index.Fields("family_id"),
+
+		// OAuth客户端索引（用于删除客户端时撤销令牌）
+		index.Fields("client_id"),
 	}
 }
@@ -35,6 +35,12 @@ func (User) Fields() []ent.Field {
 			NotEmpty().
 			Comment("用户邮箱"),
 
+		// 待确认的新邮箱
+		field.String("pending_email").
+			MaxLen(255).
+			Optional().
+			Comment("申请修改但尚未确认的新邮箱，为空表示没有待确认的修改"),
+
 		// 密码哈希
 		field.String("password_hash").
 			MaxLen(255).
@@ -48,11 +54,34 @@ func (User) Fields() []ent.Field {
 			Default("active").
 			Comment("用户状态：active-活跃，inactive-非活跃，suspended-停用"),
 
+		// 用户角色
+		field.Enum("role").
+			Values(types.UserRoles()...).
+			Default("user").
+			Comment("用户角色：user-普通用户，admin-管理员"),
+
 		// 最后登录时间
 		field.Time("last_login_at").
 			Optional().
 			Nillable().
 			Comment("最后登录时间"),
+
+		// 两步验证TOTP密钥
+		field.String("totp_secret").
+			MaxLen(64).
+			Optional().
+			Sensitive().
+			Comment("两步验证TOTP密钥，base32编码"),
+
+		// 是否已开启两步验证
+		field.Bool("two_factor_enabled").
+			Default(false).
+			Comment("是否已开启两步验证"),
+
+		// 最后一次使用的TOTP时间步
+		field.Int64("totp_last_step").
+			Default(0).
+			Comment("最后一次验证通过的TOTP时间步，防止验证码重放"),
 	}
 }
 
@@ -61,6 +90,27 @@ func (User) Edges() []ent.Edge {
 	return []ent.Edge{
 		// 一个用户可以有多个token
 		edge.To("tokens", Token.Type),
+
+		// 一个用户可以有多个一次性令牌
+		edge.To("one_time_tokens", OneTimeToken.Type),
+
+		// 一个用户可以有多个两步验证恢复码
+		edge.To("recovery_codes", RecoveryCode.Type),
+
+		// 一个用户可以注册多个通行密钥
+		edge.To("passkeys", Passkey.Type),
+
+		// 一个用户可以关联多个外部身份
+		edge.To("identities", Identity.Type),
+
+		// 一个用户可以注册多个OAuth客户端
+		edge.To("oauth_clients", OAuthClient.Type),
+
+		// 一个用户可以签发多个个人访问令牌
+		edge.To("personal_access_tokens", PersonalAccessToken.Type),
+
+		// 一个用户有多条密码历史
+		edge.To("password_histories", PasswordHistory.Type),
 	}
 }
 
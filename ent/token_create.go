@@ -22,126 +22,246 @@ type TokenCreate struct {
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (tc *TokenCreate) SetCreatedAt(t time.Time) *TokenCreate {
-	tc.mutation.SetCreatedAt(t)
-	return tc
+func (_c *TokenCreate) SetCreatedAt(v time.Time) *TokenCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
 }
 
 // SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (tc *TokenCreate) SetNillableCreatedAt(t *time.Time) *TokenCreate {
-	if t != nil {
-		tc.SetCreatedAt(*t)
+func (_c *TokenCreate) SetNillableCreatedAt(v *time.Time) *TokenCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
 	}
-	return tc
+	return _c
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (tc *TokenCreate) SetUpdatedAt(t time.Time) *TokenCreate {
-	tc.mutation.SetUpdatedAt(t)
-	return tc
+func (_c *TokenCreate) SetUpdatedAt(v time.Time) *TokenCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
 }
 
 // SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
-func (tc *TokenCreate) SetNillableUpdatedAt(t *time.Time) *TokenCreate {
-	if t != nil {
-		tc.SetUpdatedAt(*t)
+func (_c *TokenCreate) SetNillableUpdatedAt(v *time.Time) *TokenCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
 	}
-	return tc
+	return _c
 }
 
 // SetDeletedAt sets the "deleted_at" field.
-func (tc *TokenCreate) SetDeletedAt(i int64) *TokenCreate {
-	tc.mutation.SetDeletedAt(i)
-	return tc
+func (_c *TokenCreate) SetDeletedAt(v int64) *TokenCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
 }
 
 // SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
-func (tc *TokenCreate) SetNillableDeletedAt(i *int64) *TokenCreate {
-	if i != nil {
-		tc.SetDeletedAt(*i)
+func (_c *TokenCreate) SetNillableDeletedAt(v *int64) *TokenCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
 	}
-	return tc
+	return _c
 }
 
 // SetUserID sets the "user_id" field.
-func (tc *TokenCreate) SetUserID(s string) *TokenCreate {
-	tc.mutation.SetUserID(s)
-	return tc
+func (_c *TokenCreate) SetUserID(v string) *TokenCreate {
+	_c.mutation.SetUserID(v)
+	return _c
 }
 
-// SetToken sets the "token" field.
-func (tc *TokenCreate) SetToken(s string) *TokenCreate {
-	tc.mutation.SetToken(s)
-	return tc
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *TokenCreate) SetNillableUserID(v *string) *TokenCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_c *TokenCreate) SetTokenHash(v string) *TokenCreate {
+	_c.mutation.SetTokenHash(v)
+	return _c
 }
 
 // SetType sets the "type" field.
-func (tc *TokenCreate) SetType(t token.Type) *TokenCreate {
-	tc.mutation.SetType(t)
-	return tc
+func (_c *TokenCreate) SetType(v token.Type) *TokenCreate {
+	_c.mutation.SetType(v)
+	return _c
 }
 
 // SetExpiresAt sets the "expires_at" field.
-func (tc *TokenCreate) SetExpiresAt(t time.Time) *TokenCreate {
-	tc.mutation.SetExpiresAt(t)
-	return tc
+func (_c *TokenCreate) SetExpiresAt(v time.Time) *TokenCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
 }
 
 // SetIsRevoked sets the "is_revoked" field.
-func (tc *TokenCreate) SetIsRevoked(b bool) *TokenCreate {
-	tc.mutation.SetIsRevoked(b)
-	return tc
+func (_c *TokenCreate) SetIsRevoked(v bool) *TokenCreate {
+	_c.mutation.SetIsRevoked(v)
+	return _c
 }
 
 // SetNillableIsRevoked sets the "is_revoked" field if the given value is not nil.
-func (tc *TokenCreate) SetNillableIsRevoked(b *bool) *TokenCreate {
-	if b != nil {
-		tc.SetIsRevoked(*b)
+func (_c *TokenCreate) SetNillableIsRevoked(v *bool) *TokenCreate {
+	if v != nil {
+		_c.SetIsRevoked(*v)
 	}
-	return tc
+	return _c
 }
 
 // SetLastUsedAt sets the "last_used_at" field.
-func (tc *TokenCreate) SetLastUsedAt(t time.Time) *TokenCreate {
-	tc.mutation.SetLastUsedAt(t)
-	return tc
+func (_c *TokenCreate) SetLastUsedAt(v time.Time) *TokenCreate {
+	_c.mutation.SetLastUsedAt(v)
+	return _c
 }
 
 // SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
-func (tc *TokenCreate) SetNillableLastUsedAt(t *time.Time) *TokenCreate {
-	if t != nil {
-		tc.SetLastUsedAt(*t)
+func (_c *TokenCreate) SetNillableLastUsedAt(v *time.Time) *TokenCreate {
+	if v != nil {
+		_c.SetLastUsedAt(*v)
+	}
+	return _c
+}
+
+// SetFamilyID sets the "family_id" field.
+func (_c *TokenCreate) SetFamilyID(v string) *TokenCreate {
+	_c.mutation.SetFamilyID(v)
+	return _c
+}
+
+// SetNillableFamilyID sets the "family_id" field if the given value is not nil.
+func (_c *TokenCreate) SetNillableFamilyID(v *string) *TokenCreate {
+	if v != nil {
+		_c.SetFamilyID(*v)
+	}
+	return _c
+}
+
+// SetRotatedAt sets the "rotated_at" field.
+func (_c *TokenCreate) SetRotatedAt(v time.Time) *TokenCreate {
+	_c.mutation.SetRotatedAt(v)
+	return _c
+}
+
+// SetNillableRotatedAt sets the "rotated_at" field if the given value is not nil.
+func (_c *TokenCreate) SetNillableRotatedAt(v *time.Time) *TokenCreate {
+	if v != nil {
+		_c.SetRotatedAt(*v)
+	}
+	return _c
+}
+
+// SetClientID sets the "client_id" field.
+func (_c *TokenCreate) SetClientID(v string) *TokenCreate {
+	_c.mutation.SetClientID(v)
+	return _c
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (_c *TokenCreate) SetNillableClientID(v *string) *TokenCreate {
+	if v != nil {
+		_c.SetClientID(*v)
+	}
+	return _c
+}
+
+// SetScope sets the "scope" field.
+func (_c *TokenCreate) SetScope(v string) *TokenCreate {
+	_c.mutation.SetScope(v)
+	return _c
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (_c *TokenCreate) SetNillableScope(v *string) *TokenCreate {
+	if v != nil {
+		_c.SetScope(*v)
+	}
+	return _c
+}
+
+// SetAuthTime sets the "auth_time" field.
+func (_c *TokenCreate) SetAuthTime(v time.Time) *TokenCreate {
+	_c.mutation.SetAuthTime(v)
+	return _c
+}
+
+// SetNillableAuthTime sets the "auth_time" field if the given value is not nil.
+func (_c *TokenCreate) SetNillableAuthTime(v *time.Time) *TokenCreate {
+	if v != nil {
+		_c.SetAuthTime(*v)
+	}
+	return _c
+}
+
+// SetImpersonatorID sets the "impersonator_id" field.
+func (_c *TokenCreate) SetImpersonatorID(v string) *TokenCreate {
+	_c.mutation.SetImpersonatorID(v)
+	return _c
+}
+
+// SetNillableImpersonatorID sets the "impersonator_id" field if the given value is not nil.
+func (_c *TokenCreate) SetNillableImpersonatorID(v *string) *TokenCreate {
+	if v != nil {
+		_c.SetImpersonatorID(*v)
 	}
-	return tc
+	return _c
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_c *TokenCreate) SetUserAgent(v string) *TokenCreate {
+	_c.mutation.SetUserAgent(v)
+	return _c
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_c *TokenCreate) SetNillableUserAgent(v *string) *TokenCreate {
+	if v != nil {
+		_c.SetUserAgent(*v)
+	}
+	return _c
+}
+
+// SetIP sets the "ip" field.
+func (_c *TokenCreate) SetIP(v string) *TokenCreate {
+	_c.mutation.SetIP(v)
+	return _c
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_c *TokenCreate) SetNillableIP(v *string) *TokenCreate {
+	if v != nil {
+		_c.SetIP(*v)
+	}
+	return _c
 }
 
 // SetID sets the "id" field.
-func (tc *TokenCreate) SetID(s string) *TokenCreate {
-	tc.mutation.SetID(s)
-	return tc
+func (_c *TokenCreate) SetID(v string) *TokenCreate {
+	_c.mutation.SetID(v)
+	return _c
 }
 
 // SetUser sets the "user" edge to the User entity.
-func (tc *TokenCreate) SetUser(u *User) *TokenCreate {
-	return tc.SetUserID(u.ID)
+func (_c *TokenCreate) SetUser(v *User) *TokenCreate {
+	return _c.SetUserID(v.ID)
 }
 
 // Mutation returns the TokenMutation object of the builder.
-func (tc *TokenCreate) Mutation() *TokenMutation {
-	return tc.mutation
+func (_c *TokenCreate) Mutation() *TokenMutation {
+	return _c.mutation
 }
 
 // Save creates the Token in the database.
-func (tc *TokenCreate) Save(ctx context.Context) (*Token, error) {
-	if err := tc.defaults(); err != nil {
+func (_c *TokenCreate) Save(ctx context.Context) (*Token, error) {
+	if err := _c.defaults(); err != nil {
 		return nil, err
 	}
-	return withHooks(ctx, tc.sqlSave, tc.mutation, tc.hooks)
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
 // SaveX calls Save and panics if Save returns an error.
-func (tc *TokenCreate) SaveX(ctx context.Context) *Token {
-	v, err := tc.Save(ctx)
+func (_c *TokenCreate) SaveX(ctx context.Context) *Token {
+	v, err := _c.Save(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -149,103 +269,127 @@ func (tc *TokenCreate) SaveX(ctx context.Context) *Token {
 }
 
 // Exec executes the query.
-func (tc *TokenCreate) Exec(ctx context.Context) error {
-	_, err := tc.Save(ctx)
+func (_c *TokenCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (tc *TokenCreate) ExecX(ctx context.Context) {
-	if err := tc.Exec(ctx); err != nil {
+func (_c *TokenCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
 
 // defaults sets the default values of the builder before save.
-func (tc *TokenCreate) defaults() error {
-	if _, ok := tc.mutation.CreatedAt(); !ok {
+func (_c *TokenCreate) defaults() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
 		if token.DefaultCreatedAt == nil {
 			return fmt.Errorf("ent: uninitialized token.DefaultCreatedAt (forgotten import ent/runtime?)")
 		}
 		v := token.DefaultCreatedAt()
-		tc.mutation.SetCreatedAt(v)
+		_c.mutation.SetCreatedAt(v)
 	}
-	if _, ok := tc.mutation.UpdatedAt(); !ok {
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
 		if token.DefaultUpdatedAt == nil {
 			return fmt.Errorf("ent: uninitialized token.DefaultUpdatedAt (forgotten import ent/runtime?)")
 		}
 		v := token.DefaultUpdatedAt()
-		tc.mutation.SetUpdatedAt(v)
+		_c.mutation.SetUpdatedAt(v)
 	}
-	if _, ok := tc.mutation.DeletedAt(); !ok {
+	if _, ok := _c.mutation.DeletedAt(); !ok {
 		v := token.DefaultDeletedAt
-		tc.mutation.SetDeletedAt(v)
+		_c.mutation.SetDeletedAt(v)
 	}
-	if _, ok := tc.mutation.IsRevoked(); !ok {
+	if _, ok := _c.mutation.IsRevoked(); !ok {
 		v := token.DefaultIsRevoked
-		tc.mutation.SetIsRevoked(v)
+		_c.mutation.SetIsRevoked(v)
 	}
 	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
-func (tc *TokenCreate) check() error {
-	if _, ok := tc.mutation.CreatedAt(); !ok {
+func (_c *TokenCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Token.created_at"`)}
 	}
-	if _, ok := tc.mutation.UpdatedAt(); !ok {
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Token.updated_at"`)}
 	}
-	if _, ok := tc.mutation.DeletedAt(); !ok {
+	if _, ok := _c.mutation.DeletedAt(); !ok {
 		return &ValidationError{Name: "deleted_at", err: errors.New(`ent: missing required field "Token.deleted_at"`)}
 	}
-	if _, ok := tc.mutation.UserID(); !ok {
-		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Token.user_id"`)}
-	}
-	if v, ok := tc.mutation.UserID(); ok {
+	if v, ok := _c.mutation.UserID(); ok {
 		if err := token.UserIDValidator(v); err != nil {
 			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Token.user_id": %w`, err)}
 		}
 	}
-	if _, ok := tc.mutation.Token(); !ok {
-		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "Token.token"`)}
+	if _, ok := _c.mutation.TokenHash(); !ok {
+		return &ValidationError{Name: "token_hash", err: errors.New(`ent: missing required field "Token.token_hash"`)}
 	}
-	if v, ok := tc.mutation.Token(); ok {
-		if err := token.TokenValidator(v); err != nil {
-			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "Token.token": %w`, err)}
+	if v, ok := _c.mutation.TokenHash(); ok {
+		if err := token.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "Token.token_hash": %w`, err)}
 		}
 	}
-	if _, ok := tc.mutation.GetType(); !ok {
+	if _, ok := _c.mutation.GetType(); !ok {
 		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "Token.type"`)}
 	}
-	if v, ok := tc.mutation.GetType(); ok {
+	if v, ok := _c.mutation.GetType(); ok {
 		if err := token.TypeValidator(v); err != nil {
 			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Token.type": %w`, err)}
 		}
 	}
-	if _, ok := tc.mutation.ExpiresAt(); !ok {
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
 		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "Token.expires_at"`)}
 	}
-	if _, ok := tc.mutation.IsRevoked(); !ok {
+	if _, ok := _c.mutation.IsRevoked(); !ok {
 		return &ValidationError{Name: "is_revoked", err: errors.New(`ent: missing required field "Token.is_revoked"`)}
 	}
-	if v, ok := tc.mutation.ID(); ok {
+	if v, ok := _c.mutation.FamilyID(); ok {
+		if err := token.FamilyIDValidator(v); err != nil {
+			return &ValidationError{Name: "family_id", err: fmt.Errorf(`ent: validator failed for field "Token.family_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ClientID(); ok {
+		if err := token.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "Token.client_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Scope(); ok {
+		if err := token.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "Token.scope": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ImpersonatorID(); ok {
+		if err := token.ImpersonatorIDValidator(v); err != nil {
+			return &ValidationError{Name: "impersonator_id", err: fmt.Errorf(`ent: validator failed for field "Token.impersonator_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.UserAgent(); ok {
+		if err := token.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "Token.user_agent": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.IP(); ok {
+		if err := token.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "Token.ip": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
 		if err := token.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Token.id": %w`, err)}
 		}
 	}
-	if len(tc.mutation.UserIDs()) == 0 {
-		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Token.user"`)}
-	}
 	return nil
 }
 
-func (tc *TokenCreate) sqlSave(ctx context.Context) (*Token, error) {
-	if err := tc.check(); err != nil {
+func (_c *TokenCreate) sqlSave(ctx context.Context) (*Token, error) {
+	if err := _c.check(); err != nil {
 		return nil, err
 	}
-	_node, _spec := tc.createSpec()
-	if err := sqlgraph.CreateNode(ctx, tc.driver, _spec); err != nil {
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -258,53 +402,85 @@ func (tc *TokenCreate) sqlSave(ctx context.Context) (*Token, error) {
 			return nil, fmt.Errorf("unexpected Token.ID type: %T", _spec.ID.Value)
 		}
 	}
-	tc.mutation.id = &_node.ID
-	tc.mutation.done = true
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
 	return _node, nil
 }
 
-func (tc *TokenCreate) createSpec() (*Token, *sqlgraph.CreateSpec) {
+func (_c *TokenCreate) createSpec() (*Token, *sqlgraph.CreateSpec) {
 	var (
-		_node = &Token{config: tc.config}
+		_node = &Token{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(token.Table, sqlgraph.NewFieldSpec(token.FieldID, field.TypeString))
 	)
-	if id, ok := tc.mutation.ID(); ok {
+	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
 	}
-	if value, ok := tc.mutation.CreatedAt(); ok {
+	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(token.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
 	}
-	if value, ok := tc.mutation.UpdatedAt(); ok {
+	if value, ok := _c.mutation.UpdatedAt(); ok {
 		_spec.SetField(token.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
-	if value, ok := tc.mutation.DeletedAt(); ok {
+	if value, ok := _c.mutation.DeletedAt(); ok {
 		_spec.SetField(token.FieldDeletedAt, field.TypeInt64, value)
 		_node.DeletedAt = value
 	}
-	if value, ok := tc.mutation.Token(); ok {
-		_spec.SetField(token.FieldToken, field.TypeString, value)
-		_node.Token = value
+	if value, ok := _c.mutation.TokenHash(); ok {
+		_spec.SetField(token.FieldTokenHash, field.TypeString, value)
+		_node.TokenHash = value
 	}
-	if value, ok := tc.mutation.GetType(); ok {
+	if value, ok := _c.mutation.GetType(); ok {
 		_spec.SetField(token.FieldType, field.TypeEnum, value)
 		_node.Type = value
 	}
-	if value, ok := tc.mutation.ExpiresAt(); ok {
+	if value, ok := _c.mutation.ExpiresAt(); ok {
 		_spec.SetField(token.FieldExpiresAt, field.TypeTime, value)
 		_node.ExpiresAt = value
 	}
-	if value, ok := tc.mutation.IsRevoked(); ok {
+	if value, ok := _c.mutation.IsRevoked(); ok {
 		_spec.SetField(token.FieldIsRevoked, field.TypeBool, value)
 		_node.IsRevoked = value
 	}
-	if value, ok := tc.mutation.LastUsedAt(); ok {
+	if value, ok := _c.mutation.LastUsedAt(); ok {
 		_spec.SetField(token.FieldLastUsedAt, field.TypeTime, value)
 		_node.LastUsedAt = &value
 	}
-	if nodes := tc.mutation.UserIDs(); len(nodes) > 0 {
+	if value, ok := _c.mutation.FamilyID(); ok {
+		_spec.SetField(token.FieldFamilyID, field.TypeString, value)
+		_node.FamilyID = value
+	}
+	if value, ok := _c.mutation.RotatedAt(); ok {
+		_spec.SetField(token.FieldRotatedAt, field.TypeTime, value)
+		_node.RotatedAt = &value
+	}
+	if value, ok := _c.mutation.ClientID(); ok {
+		_spec.SetField(token.FieldClientID, field.TypeString, value)
+		_node.ClientID = value
+	}
+	if value, ok := _c.mutation.Scope(); ok {
+		_spec.SetField(token.FieldScope, field.TypeString, value)
+		_node.Scope = value
+	}
+	if value, ok := _c.mutation.AuthTime(); ok {
+		_spec.SetField(token.FieldAuthTime, field.TypeTime, value)
+		_node.AuthTime = &value
+	}
+	if value, ok := _c.mutation.ImpersonatorID(); ok {
+		_spec.SetField(token.FieldImpersonatorID, field.TypeString, value)
+		_node.ImpersonatorID = value
+	}
+	if value, ok := _c.mutation.UserAgent(); ok {
+		_spec.SetField(token.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	if value, ok := _c.mutation.IP(); ok {
+		_spec.SetField(token.FieldIP, field.TypeString, value)
+		_node.IP = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
 			Inverse: true,
@@ -332,16 +508,16 @@ type TokenCreateBulk struct {
 }
 
 // Save creates the Token entities in the database.
-func (tcb *TokenCreateBulk) Save(ctx context.Context) ([]*Token, error) {
-	if tcb.err != nil {
-		return nil, tcb.err
-	}
-	specs := make([]*sqlgraph.CreateSpec, len(tcb.builders))
-	nodes := make([]*Token, len(tcb.builders))
-	mutators := make([]Mutator, len(tcb.builders))
-	for i := range tcb.builders {
+func (_c *TokenCreateBulk) Save(ctx context.Context) ([]*Token, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Token, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
 		func(i int, root context.Context) {
-			builder := tcb.builders[i]
+			builder := _c.builders[i]
 			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*TokenMutation)
@@ -355,11 +531,11 @@ func (tcb *TokenCreateBulk) Save(ctx context.Context) ([]*Token, error) {
 				var err error
 				nodes[i], specs[i] = builder.createSpec()
 				if i < len(mutators)-1 {
-					_, err = mutators[i+1].Mutate(root, tcb.builders[i+1].mutation)
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
 					// Invoke the actual operation on the latest mutation in the chain.
-					if err = sqlgraph.BatchCreate(ctx, tcb.driver, spec); err != nil {
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
 							err = &ConstraintError{msg: err.Error(), wrap: err}
 						}
@@ -379,7 +555,7 @@ func (tcb *TokenCreateBulk) Save(ctx context.Context) ([]*Token, error) {
 		}(i, ctx)
 	}
 	if len(mutators) > 0 {
-		if _, err := mutators[0].Mutate(ctx, tcb.builders[0].mutation); err != nil {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
 			return nil, err
 		}
 	}
@@ -387,8 +563,8 @@ func (tcb *TokenCreateBulk) Save(ctx context.Context) ([]*Token, error) {
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (tcb *TokenCreateBulk) SaveX(ctx context.Context) []*Token {
-	v, err := tcb.Save(ctx)
+func (_c *TokenCreateBulk) SaveX(ctx context.Context) []*Token {
+	v, err := _c.Save(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -396,14 +572,14 @@ func (tcb *TokenCreateBulk) SaveX(ctx context.Context) []*Token {
 }
 
 // Exec executes the query.
-func (tcb *TokenCreateBulk) Exec(ctx context.Context) error {
-	_, err := tcb.Save(ctx)
+func (_c *TokenCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (tcb *TokenCreateBulk) ExecX(ctx context.Context) {
-	if err := tcb.Exec(ctx); err != nil {
+func (_c *TokenCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -24,155 +24,321 @@ type TokenUpdate struct {
 }
 
 // Where appends a list predicates to the TokenUpdate builder.
-func (tu *TokenUpdate) Where(ps ...predicate.Token) *TokenUpdate {
-	tu.mutation.Where(ps...)
-	return tu
+func (_u *TokenUpdate) Where(ps ...predicate.Token) *TokenUpdate {
+	_u.mutation.Where(ps...)
+	return _u
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (tu *TokenUpdate) SetUpdatedAt(t time.Time) *TokenUpdate {
-	tu.mutation.SetUpdatedAt(t)
-	return tu
+func (_u *TokenUpdate) SetUpdatedAt(v time.Time) *TokenUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
 }
 
 // SetDeletedAt sets the "deleted_at" field.
-func (tu *TokenUpdate) SetDeletedAt(i int64) *TokenUpdate {
-	tu.mutation.ResetDeletedAt()
-	tu.mutation.SetDeletedAt(i)
-	return tu
+func (_u *TokenUpdate) SetDeletedAt(v int64) *TokenUpdate {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
 }
 
 // SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
-func (tu *TokenUpdate) SetNillableDeletedAt(i *int64) *TokenUpdate {
-	if i != nil {
-		tu.SetDeletedAt(*i)
+func (_u *TokenUpdate) SetNillableDeletedAt(v *int64) *TokenUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
 	}
-	return tu
+	return _u
 }
 
-// AddDeletedAt adds i to the "deleted_at" field.
-func (tu *TokenUpdate) AddDeletedAt(i int64) *TokenUpdate {
-	tu.mutation.AddDeletedAt(i)
-	return tu
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *TokenUpdate) AddDeletedAt(v int64) *TokenUpdate {
+	_u.mutation.AddDeletedAt(v)
+	return _u
 }
 
 // SetUserID sets the "user_id" field.
-func (tu *TokenUpdate) SetUserID(s string) *TokenUpdate {
-	tu.mutation.SetUserID(s)
-	return tu
+func (_u *TokenUpdate) SetUserID(v string) *TokenUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
 }
 
 // SetNillableUserID sets the "user_id" field if the given value is not nil.
-func (tu *TokenUpdate) SetNillableUserID(s *string) *TokenUpdate {
-	if s != nil {
-		tu.SetUserID(*s)
+func (_u *TokenUpdate) SetNillableUserID(v *string) *TokenUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
 	}
-	return tu
+	return _u
 }
 
-// SetToken sets the "token" field.
-func (tu *TokenUpdate) SetToken(s string) *TokenUpdate {
-	tu.mutation.SetToken(s)
-	return tu
+// ClearUserID clears the value of the "user_id" field.
+func (_u *TokenUpdate) ClearUserID() *TokenUpdate {
+	_u.mutation.ClearUserID()
+	return _u
 }
 
-// SetNillableToken sets the "token" field if the given value is not nil.
-func (tu *TokenUpdate) SetNillableToken(s *string) *TokenUpdate {
-	if s != nil {
-		tu.SetToken(*s)
+// SetTokenHash sets the "token_hash" field.
+func (_u *TokenUpdate) SetTokenHash(v string) *TokenUpdate {
+	_u.mutation.SetTokenHash(v)
+	return _u
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (_u *TokenUpdate) SetNillableTokenHash(v *string) *TokenUpdate {
+	if v != nil {
+		_u.SetTokenHash(*v)
 	}
-	return tu
+	return _u
 }
 
 // SetType sets the "type" field.
-func (tu *TokenUpdate) SetType(t token.Type) *TokenUpdate {
-	tu.mutation.SetType(t)
-	return tu
+func (_u *TokenUpdate) SetType(v token.Type) *TokenUpdate {
+	_u.mutation.SetType(v)
+	return _u
 }
 
 // SetNillableType sets the "type" field if the given value is not nil.
-func (tu *TokenUpdate) SetNillableType(t *token.Type) *TokenUpdate {
-	if t != nil {
-		tu.SetType(*t)
+func (_u *TokenUpdate) SetNillableType(v *token.Type) *TokenUpdate {
+	if v != nil {
+		_u.SetType(*v)
 	}
-	return tu
+	return _u
 }
 
 // SetExpiresAt sets the "expires_at" field.
-func (tu *TokenUpdate) SetExpiresAt(t time.Time) *TokenUpdate {
-	tu.mutation.SetExpiresAt(t)
-	return tu
+func (_u *TokenUpdate) SetExpiresAt(v time.Time) *TokenUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
 }
 
 // SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
-func (tu *TokenUpdate) SetNillableExpiresAt(t *time.Time) *TokenUpdate {
-	if t != nil {
-		tu.SetExpiresAt(*t)
+func (_u *TokenUpdate) SetNillableExpiresAt(v *time.Time) *TokenUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
 	}
-	return tu
+	return _u
 }
 
 // SetIsRevoked sets the "is_revoked" field.
-func (tu *TokenUpdate) SetIsRevoked(b bool) *TokenUpdate {
-	tu.mutation.SetIsRevoked(b)
-	return tu
+func (_u *TokenUpdate) SetIsRevoked(v bool) *TokenUpdate {
+	_u.mutation.SetIsRevoked(v)
+	return _u
 }
 
 // SetNillableIsRevoked sets the "is_revoked" field if the given value is not nil.
-func (tu *TokenUpdate) SetNillableIsRevoked(b *bool) *TokenUpdate {
-	if b != nil {
-		tu.SetIsRevoked(*b)
+func (_u *TokenUpdate) SetNillableIsRevoked(v *bool) *TokenUpdate {
+	if v != nil {
+		_u.SetIsRevoked(*v)
 	}
-	return tu
+	return _u
 }
 
 // SetLastUsedAt sets the "last_used_at" field.
-func (tu *TokenUpdate) SetLastUsedAt(t time.Time) *TokenUpdate {
-	tu.mutation.SetLastUsedAt(t)
-	return tu
+func (_u *TokenUpdate) SetLastUsedAt(v time.Time) *TokenUpdate {
+	_u.mutation.SetLastUsedAt(v)
+	return _u
 }
 
 // SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
-func (tu *TokenUpdate) SetNillableLastUsedAt(t *time.Time) *TokenUpdate {
-	if t != nil {
-		tu.SetLastUsedAt(*t)
+func (_u *TokenUpdate) SetNillableLastUsedAt(v *time.Time) *TokenUpdate {
+	if v != nil {
+		_u.SetLastUsedAt(*v)
 	}
-	return tu
+	return _u
 }
 
 // ClearLastUsedAt clears the value of the "last_used_at" field.
-func (tu *TokenUpdate) ClearLastUsedAt() *TokenUpdate {
-	tu.mutation.ClearLastUsedAt()
-	return tu
+func (_u *TokenUpdate) ClearLastUsedAt() *TokenUpdate {
+	_u.mutation.ClearLastUsedAt()
+	return _u
+}
+
+// SetFamilyID sets the "family_id" field.
+func (_u *TokenUpdate) SetFamilyID(v string) *TokenUpdate {
+	_u.mutation.SetFamilyID(v)
+	return _u
+}
+
+// SetNillableFamilyID sets the "family_id" field if the given value is not nil.
+func (_u *TokenUpdate) SetNillableFamilyID(v *string) *TokenUpdate {
+	if v != nil {
+		_u.SetFamilyID(*v)
+	}
+	return _u
+}
+
+// ClearFamilyID clears the value of the "family_id" field.
+func (_u *TokenUpdate) ClearFamilyID() *TokenUpdate {
+	_u.mutation.ClearFamilyID()
+	return _u
+}
+
+// SetRotatedAt sets the "rotated_at" field.
+func (_u *TokenUpdate) SetRotatedAt(v time.Time) *TokenUpdate {
+	_u.mutation.SetRotatedAt(v)
+	return _u
+}
+
+// SetNillableRotatedAt sets the "rotated_at" field if the given value is not nil.
+func (_u *TokenUpdate) SetNillableRotatedAt(v *time.Time) *TokenUpdate {
+	if v != nil {
+		_u.SetRotatedAt(*v)
+	}
+	return _u
+}
+
+// ClearRotatedAt clears the value of the "rotated_at" field.
+func (_u *TokenUpdate) ClearRotatedAt() *TokenUpdate {
+	_u.mutation.ClearRotatedAt()
+	return _u
+}
+
+// SetClientID sets the "client_id" field.
+func (_u *TokenUpdate) SetClientID(v string) *TokenUpdate {
+	_u.mutation.SetClientID(v)
+	return _u
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (_u *TokenUpdate) SetNillableClientID(v *string) *TokenUpdate {
+	if v != nil {
+		_u.SetClientID(*v)
+	}
+	return _u
+}
+
+// ClearClientID clears the value of the "client_id" field.
+func (_u *TokenUpdate) ClearClientID() *TokenUpdate {
+	_u.mutation.ClearClientID()
+	return _u
+}
+
+// SetScope sets the "scope" field.
+func (_u *TokenUpdate) SetScope(v string) *TokenUpdate {
+	_u.mutation.SetScope(v)
+	return _u
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (_u *TokenUpdate) SetNillableScope(v *string) *TokenUpdate {
+	if v != nil {
+		_u.SetScope(*v)
+	}
+	return _u
+}
+
+// ClearScope clears the value of the "scope" field.
+func (_u *TokenUpdate) ClearScope() *TokenUpdate {
+	_u.mutation.ClearScope()
+	return _u
+}
+
+// SetAuthTime sets the "auth_time" field.
+func (_u *TokenUpdate) SetAuthTime(v time.Time) *TokenUpdate {
+	_u.mutation.SetAuthTime(v)
+	return _u
+}
+
+// SetNillableAuthTime sets the "auth_time" field if the given value is not nil.
+func (_u *TokenUpdate) SetNillableAuthTime(v *time.Time) *TokenUpdate {
+	if v != nil {
+		_u.SetAuthTime(*v)
+	}
+	return _u
+}
+
+// ClearAuthTime clears the value of the "auth_time" field.
+func (_u *TokenUpdate) ClearAuthTime() *TokenUpdate {
+	_u.mutation.ClearAuthTime()
+	return _u
+}
+
+// SetImpersonatorID sets the "impersonator_id" field.
+func (_u *TokenUpdate) SetImpersonatorID(v string) *TokenUpdate {
+	_u.mutation.SetImpersonatorID(v)
+	return _u
+}
+
+// SetNillableImpersonatorID sets the "impersonator_id" field if the given value is not nil.
+func (_u *TokenUpdate) SetNillableImpersonatorID(v *string) *TokenUpdate {
+	if v != nil {
+		_u.SetImpersonatorID(*v)
+	}
+	return _u
+}
+
+// ClearImpersonatorID clears the value of the "impersonator_id" field.
+func (_u *TokenUpdate) ClearImpersonatorID() *TokenUpdate {
+	_u.mutation.ClearImpersonatorID()
+	return _u
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_u *TokenUpdate) SetUserAgent(v string) *TokenUpdate {
+	_u.mutation.SetUserAgent(v)
+	return _u
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_u *TokenUpdate) SetNillableUserAgent(v *string) *TokenUpdate {
+	if v != nil {
+		_u.SetUserAgent(*v)
+	}
+	return _u
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (_u *TokenUpdate) ClearUserAgent() *TokenUpdate {
+	_u.mutation.ClearUserAgent()
+	return _u
+}
+
+// SetIP sets the "ip" field.
+func (_u *TokenUpdate) SetIP(v string) *TokenUpdate {
+	_u.mutation.SetIP(v)
+	return _u
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_u *TokenUpdate) SetNillableIP(v *string) *TokenUpdate {
+	if v != nil {
+		_u.SetIP(*v)
+	}
+	return _u
+}
+
+// ClearIP clears the value of the "ip" field.
+func (_u *TokenUpdate) ClearIP() *TokenUpdate {
+	_u.mutation.ClearIP()
+	return _u
 }
 
 // SetUser sets the "user" edge to the User entity.
-func (tu *TokenUpdate) SetUser(u *User) *TokenUpdate {
-	return tu.SetUserID(u.ID)
+func (_u *TokenUpdate) SetUser(v *User) *TokenUpdate {
+	return _u.SetUserID(v.ID)
 }
 
 // Mutation returns the TokenMutation object of the builder.
-func (tu *TokenUpdate) Mutation() *TokenMutation {
-	return tu.mutation
+func (_u *TokenUpdate) Mutation() *TokenMutation {
+	return _u.mutation
 }
 
 // ClearUser clears the "user" edge to the User entity.
-func (tu *TokenUpdate) ClearUser() *TokenUpdate {
-	tu.mutation.ClearUser()
-	return tu
+func (_u *TokenUpdate) ClearUser() *TokenUpdate {
+	_u.mutation.ClearUser()
+	return _u
 }
 
 // Save executes the query and returns the number of nodes affected by the update operation.
-func (tu *TokenUpdate) Save(ctx context.Context) (int, error) {
-	if err := tu.defaults(); err != nil {
+func (_u *TokenUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
 		return 0, err
 	}
-	return withHooks(ctx, tu.sqlSave, tu.mutation, tu.hooks)
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (tu *TokenUpdate) SaveX(ctx context.Context) int {
-	affected, err := tu.Save(ctx)
+func (_u *TokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -180,93 +346,168 @@ func (tu *TokenUpdate) SaveX(ctx context.Context) int {
 }
 
 // Exec executes the query.
-func (tu *TokenUpdate) Exec(ctx context.Context) error {
-	_, err := tu.Save(ctx)
+func (_u *TokenUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (tu *TokenUpdate) ExecX(ctx context.Context) {
-	if err := tu.Exec(ctx); err != nil {
+func (_u *TokenUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
 
 // defaults sets the default values of the builder before save.
-func (tu *TokenUpdate) defaults() error {
-	if _, ok := tu.mutation.UpdatedAt(); !ok {
+func (_u *TokenUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
 		if token.UpdateDefaultUpdatedAt == nil {
 			return fmt.Errorf("ent: uninitialized token.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
 		}
 		v := token.UpdateDefaultUpdatedAt()
-		tu.mutation.SetUpdatedAt(v)
+		_u.mutation.SetUpdatedAt(v)
 	}
 	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
-func (tu *TokenUpdate) check() error {
-	if v, ok := tu.mutation.UserID(); ok {
+func (_u *TokenUpdate) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
 		if err := token.UserIDValidator(v); err != nil {
 			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Token.user_id": %w`, err)}
 		}
 	}
-	if v, ok := tu.mutation.Token(); ok {
-		if err := token.TokenValidator(v); err != nil {
-			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "Token.token": %w`, err)}
+	if v, ok := _u.mutation.TokenHash(); ok {
+		if err := token.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "Token.token_hash": %w`, err)}
 		}
 	}
-	if v, ok := tu.mutation.GetType(); ok {
+	if v, ok := _u.mutation.GetType(); ok {
 		if err := token.TypeValidator(v); err != nil {
 			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Token.type": %w`, err)}
 		}
 	}
-	if tu.mutation.UserCleared() && len(tu.mutation.UserIDs()) > 0 {
-		return errors.New(`ent: clearing a required unique edge "Token.user"`)
+	if v, ok := _u.mutation.FamilyID(); ok {
+		if err := token.FamilyIDValidator(v); err != nil {
+			return &ValidationError{Name: "family_id", err: fmt.Errorf(`ent: validator failed for field "Token.family_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClientID(); ok {
+		if err := token.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "Token.client_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Scope(); ok {
+		if err := token.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "Token.scope": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ImpersonatorID(); ok {
+		if err := token.ImpersonatorIDValidator(v); err != nil {
+			return &ValidationError{Name: "impersonator_id", err: fmt.Errorf(`ent: validator failed for field "Token.impersonator_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserAgent(); ok {
+		if err := token.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "Token.user_agent": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IP(); ok {
+		if err := token.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "Token.ip": %w`, err)}
+		}
 	}
 	return nil
 }
 
-func (tu *TokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	if err := tu.check(); err != nil {
-		return n, err
+func (_u *TokenUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
 	}
 	_spec := sqlgraph.NewUpdateSpec(token.Table, token.Columns, sqlgraph.NewFieldSpec(token.FieldID, field.TypeString))
-	if ps := tu.mutation.predicates; len(ps) > 0 {
+	if ps := _u.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
 				ps[i](selector)
 			}
 		}
 	}
-	if value, ok := tu.mutation.UpdatedAt(); ok {
+	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(token.FieldUpdatedAt, field.TypeTime, value)
 	}
-	if value, ok := tu.mutation.DeletedAt(); ok {
+	if value, ok := _u.mutation.DeletedAt(); ok {
 		_spec.SetField(token.FieldDeletedAt, field.TypeInt64, value)
 	}
-	if value, ok := tu.mutation.AddedDeletedAt(); ok {
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
 		_spec.AddField(token.FieldDeletedAt, field.TypeInt64, value)
 	}
-	if value, ok := tu.mutation.Token(); ok {
-		_spec.SetField(token.FieldToken, field.TypeString, value)
+	if value, ok := _u.mutation.TokenHash(); ok {
+		_spec.SetField(token.FieldTokenHash, field.TypeString, value)
 	}
-	if value, ok := tu.mutation.GetType(); ok {
+	if value, ok := _u.mutation.GetType(); ok {
 		_spec.SetField(token.FieldType, field.TypeEnum, value)
 	}
-	if value, ok := tu.mutation.ExpiresAt(); ok {
+	if value, ok := _u.mutation.ExpiresAt(); ok {
 		_spec.SetField(token.FieldExpiresAt, field.TypeTime, value)
 	}
-	if value, ok := tu.mutation.IsRevoked(); ok {
+	if value, ok := _u.mutation.IsRevoked(); ok {
 		_spec.SetField(token.FieldIsRevoked, field.TypeBool, value)
 	}
-	if value, ok := tu.mutation.LastUsedAt(); ok {
+	if value, ok := _u.mutation.LastUsedAt(); ok {
 		_spec.SetField(token.FieldLastUsedAt, field.TypeTime, value)
 	}
-	if tu.mutation.LastUsedAtCleared() {
+	if _u.mutation.LastUsedAtCleared() {
 		_spec.ClearField(token.FieldLastUsedAt, field.TypeTime)
 	}
-	if tu.mutation.UserCleared() {
+	if value, ok := _u.mutation.FamilyID(); ok {
+		_spec.SetField(token.FieldFamilyID, field.TypeString, value)
+	}
+	if _u.mutation.FamilyIDCleared() {
+		_spec.ClearField(token.FieldFamilyID, field.TypeString)
+	}
+	if value, ok := _u.mutation.RotatedAt(); ok {
+		_spec.SetField(token.FieldRotatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RotatedAtCleared() {
+		_spec.ClearField(token.FieldRotatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ClientID(); ok {
+		_spec.SetField(token.FieldClientID, field.TypeString, value)
+	}
+	if _u.mutation.ClientIDCleared() {
+		_spec.ClearField(token.FieldClientID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Scope(); ok {
+		_spec.SetField(token.FieldScope, field.TypeString, value)
+	}
+	if _u.mutation.ScopeCleared() {
+		_spec.ClearField(token.FieldScope, field.TypeString)
+	}
+	if value, ok := _u.mutation.AuthTime(); ok {
+		_spec.SetField(token.FieldAuthTime, field.TypeTime, value)
+	}
+	if _u.mutation.AuthTimeCleared() {
+		_spec.ClearField(token.FieldAuthTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ImpersonatorID(); ok {
+		_spec.SetField(token.FieldImpersonatorID, field.TypeString, value)
+	}
+	if _u.mutation.ImpersonatorIDCleared() {
+		_spec.ClearField(token.FieldImpersonatorID, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserAgent(); ok {
+		_spec.SetField(token.FieldUserAgent, field.TypeString, value)
+	}
+	if _u.mutation.UserAgentCleared() {
+		_spec.ClearField(token.FieldUserAgent, field.TypeString)
+	}
+	if value, ok := _u.mutation.IP(); ok {
+		_spec.SetField(token.FieldIP, field.TypeString, value)
+	}
+	if _u.mutation.IPCleared() {
+		_spec.ClearField(token.FieldIP, field.TypeString)
+	}
+	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
 			Inverse: true,
@@ -279,7 +520,7 @@ func (tu *TokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := tu.mutation.UserIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
 			Inverse: true,
@@ -295,7 +536,7 @@ func (tu *TokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{token.Label}
 		} else if sqlgraph.IsConstraintError(err) {
@@ -303,8 +544,8 @@ func (tu *TokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		return 0, err
 	}
-	tu.mutation.done = true
-	return n, nil
+	_u.mutation.done = true
+	return _node, nil
 }
 
 // TokenUpdateOne is the builder for updating a single Token entity.
@@ -316,162 +557,328 @@ type TokenUpdateOne struct {
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (tuo *TokenUpdateOne) SetUpdatedAt(t time.Time) *TokenUpdateOne {
-	tuo.mutation.SetUpdatedAt(t)
-	return tuo
+func (_u *TokenUpdateOne) SetUpdatedAt(v time.Time) *TokenUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
 }
 
 // SetDeletedAt sets the "deleted_at" field.
-func (tuo *TokenUpdateOne) SetDeletedAt(i int64) *TokenUpdateOne {
-	tuo.mutation.ResetDeletedAt()
-	tuo.mutation.SetDeletedAt(i)
-	return tuo
+func (_u *TokenUpdateOne) SetDeletedAt(v int64) *TokenUpdateOne {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
 }
 
 // SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
-func (tuo *TokenUpdateOne) SetNillableDeletedAt(i *int64) *TokenUpdateOne {
-	if i != nil {
-		tuo.SetDeletedAt(*i)
+func (_u *TokenUpdateOne) SetNillableDeletedAt(v *int64) *TokenUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
 	}
-	return tuo
+	return _u
 }
 
-// AddDeletedAt adds i to the "deleted_at" field.
-func (tuo *TokenUpdateOne) AddDeletedAt(i int64) *TokenUpdateOne {
-	tuo.mutation.AddDeletedAt(i)
-	return tuo
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *TokenUpdateOne) AddDeletedAt(v int64) *TokenUpdateOne {
+	_u.mutation.AddDeletedAt(v)
+	return _u
 }
 
 // SetUserID sets the "user_id" field.
-func (tuo *TokenUpdateOne) SetUserID(s string) *TokenUpdateOne {
-	tuo.mutation.SetUserID(s)
-	return tuo
+func (_u *TokenUpdateOne) SetUserID(v string) *TokenUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
 }
 
 // SetNillableUserID sets the "user_id" field if the given value is not nil.
-func (tuo *TokenUpdateOne) SetNillableUserID(s *string) *TokenUpdateOne {
-	if s != nil {
-		tuo.SetUserID(*s)
+func (_u *TokenUpdateOne) SetNillableUserID(v *string) *TokenUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
 	}
-	return tuo
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *TokenUpdateOne) ClearUserID() *TokenUpdateOne {
+	_u.mutation.ClearUserID()
+	return _u
 }
 
-// SetToken sets the "token" field.
-func (tuo *TokenUpdateOne) SetToken(s string) *TokenUpdateOne {
-	tuo.mutation.SetToken(s)
-	return tuo
+// SetTokenHash sets the "token_hash" field.
+func (_u *TokenUpdateOne) SetTokenHash(v string) *TokenUpdateOne {
+	_u.mutation.SetTokenHash(v)
+	return _u
 }
 
-// SetNillableToken sets the "token" field if the given value is not nil.
-func (tuo *TokenUpdateOne) SetNillableToken(s *string) *TokenUpdateOne {
-	if s != nil {
-		tuo.SetToken(*s)
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (_u *TokenUpdateOne) SetNillableTokenHash(v *string) *TokenUpdateOne {
+	if v != nil {
+		_u.SetTokenHash(*v)
 	}
-	return tuo
+	return _u
 }
 
 // SetType sets the "type" field.
-func (tuo *TokenUpdateOne) SetType(t token.Type) *TokenUpdateOne {
-	tuo.mutation.SetType(t)
-	return tuo
+func (_u *TokenUpdateOne) SetType(v token.Type) *TokenUpdateOne {
+	_u.mutation.SetType(v)
+	return _u
 }
 
 // SetNillableType sets the "type" field if the given value is not nil.
-func (tuo *TokenUpdateOne) SetNillableType(t *token.Type) *TokenUpdateOne {
-	if t != nil {
-		tuo.SetType(*t)
+func (_u *TokenUpdateOne) SetNillableType(v *token.Type) *TokenUpdateOne {
+	if v != nil {
+		_u.SetType(*v)
 	}
-	return tuo
+	return _u
 }
 
 // SetExpiresAt sets the "expires_at" field.
-func (tuo *TokenUpdateOne) SetExpiresAt(t time.Time) *TokenUpdateOne {
-	tuo.mutation.SetExpiresAt(t)
-	return tuo
+func (_u *TokenUpdateOne) SetExpiresAt(v time.Time) *TokenUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
 }
 
 // SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
-func (tuo *TokenUpdateOne) SetNillableExpiresAt(t *time.Time) *TokenUpdateOne {
-	if t != nil {
-		tuo.SetExpiresAt(*t)
+func (_u *TokenUpdateOne) SetNillableExpiresAt(v *time.Time) *TokenUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
 	}
-	return tuo
+	return _u
 }
 
 // SetIsRevoked sets the "is_revoked" field.
-func (tuo *TokenUpdateOne) SetIsRevoked(b bool) *TokenUpdateOne {
-	tuo.mutation.SetIsRevoked(b)
-	return tuo
+func (_u *TokenUpdateOne) SetIsRevoked(v bool) *TokenUpdateOne {
+	_u.mutation.SetIsRevoked(v)
+	return _u
 }
 
 // SetNillableIsRevoked sets the "is_revoked" field if the given value is not nil.
-func (tuo *TokenUpdateOne) SetNillableIsRevoked(b *bool) *TokenUpdateOne {
-	if b != nil {
-		tuo.SetIsRevoked(*b)
+func (_u *TokenUpdateOne) SetNillableIsRevoked(v *bool) *TokenUpdateOne {
+	if v != nil {
+		_u.SetIsRevoked(*v)
 	}
-	return tuo
+	return _u
 }
 
 // SetLastUsedAt sets the "last_used_at" field.
-func (tuo *TokenUpdateOne) SetLastUsedAt(t time.Time) *TokenUpdateOne {
-	tuo.mutation.SetLastUsedAt(t)
-	return tuo
+func (_u *TokenUpdateOne) SetLastUsedAt(v time.Time) *TokenUpdateOne {
+	_u.mutation.SetLastUsedAt(v)
+	return _u
 }
 
 // SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
-func (tuo *TokenUpdateOne) SetNillableLastUsedAt(t *time.Time) *TokenUpdateOne {
-	if t != nil {
-		tuo.SetLastUsedAt(*t)
+func (_u *TokenUpdateOne) SetNillableLastUsedAt(v *time.Time) *TokenUpdateOne {
+	if v != nil {
+		_u.SetLastUsedAt(*v)
 	}
-	return tuo
+	return _u
 }
 
 // ClearLastUsedAt clears the value of the "last_used_at" field.
-func (tuo *TokenUpdateOne) ClearLastUsedAt() *TokenUpdateOne {
-	tuo.mutation.ClearLastUsedAt()
-	return tuo
+func (_u *TokenUpdateOne) ClearLastUsedAt() *TokenUpdateOne {
+	_u.mutation.ClearLastUsedAt()
+	return _u
+}
+
+// SetFamilyID sets the "family_id" field.
+func (_u *TokenUpdateOne) SetFamilyID(v string) *TokenUpdateOne {
+	_u.mutation.SetFamilyID(v)
+	return _u
+}
+
+// SetNillableFamilyID sets the "family_id" field if the given value is not nil.
+func (_u *TokenUpdateOne) SetNillableFamilyID(v *string) *TokenUpdateOne {
+	if v != nil {
+		_u.SetFamilyID(*v)
+	}
+	return _u
+}
+
+// ClearFamilyID clears the value of the "family_id" field.
+func (_u *TokenUpdateOne) ClearFamilyID() *TokenUpdateOne {
+	_u.mutation.ClearFamilyID()
+	return _u
+}
+
+// SetRotatedAt sets the "rotated_at" field.
+func (_u *TokenUpdateOne) SetRotatedAt(v time.Time) *TokenUpdateOne {
+	_u.mutation.SetRotatedAt(v)
+	return _u
+}
+
+// SetNillableRotatedAt sets the "rotated_at" field if the given value is not nil.
+func (_u *TokenUpdateOne) SetNillableRotatedAt(v *time.Time) *TokenUpdateOne {
+	if v != nil {
+		_u.SetRotatedAt(*v)
+	}
+	return _u
+}
+
+// ClearRotatedAt clears the value of the "rotated_at" field.
+func (_u *TokenUpdateOne) ClearRotatedAt() *TokenUpdateOne {
+	_u.mutation.ClearRotatedAt()
+	return _u
+}
+
+// SetClientID sets the "client_id" field.
+func (_u *TokenUpdateOne) SetClientID(v string) *TokenUpdateOne {
+	_u.mutation.SetClientID(v)
+	return _u
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (_u *TokenUpdateOne) SetNillableClientID(v *string) *TokenUpdateOne {
+	if v != nil {
+		_u.SetClientID(*v)
+	}
+	return _u
+}
+
+// ClearClientID clears the value of the "client_id" field.
+func (_u *TokenUpdateOne) ClearClientID() *TokenUpdateOne {
+	_u.mutation.ClearClientID()
+	return _u
+}
+
+// SetScope sets the "scope" field.
+func (_u *TokenUpdateOne) SetScope(v string) *TokenUpdateOne {
+	_u.mutation.SetScope(v)
+	return _u
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (_u *TokenUpdateOne) SetNillableScope(v *string) *TokenUpdateOne {
+	if v != nil {
+		_u.SetScope(*v)
+	}
+	return _u
+}
+
+// ClearScope clears the value of the "scope" field.
+func (_u *TokenUpdateOne) ClearScope() *TokenUpdateOne {
+	_u.mutation.ClearScope()
+	return _u
+}
+
+// SetAuthTime sets the "auth_time" field.
+func (_u *TokenUpdateOne) SetAuthTime(v time.Time) *TokenUpdateOne {
+	_u.mutation.SetAuthTime(v)
+	return _u
+}
+
+// SetNillableAuthTime sets the "auth_time" field if the given value is not nil.
+func (_u *TokenUpdateOne) SetNillableAuthTime(v *time.Time) *TokenUpdateOne {
+	if v != nil {
+		_u.SetAuthTime(*v)
+	}
+	return _u
+}
+
+// ClearAuthTime clears the value of the "auth_time" field.
+func (_u *TokenUpdateOne) ClearAuthTime() *TokenUpdateOne {
+	_u.mutation.ClearAuthTime()
+	return _u
+}
+
+// SetImpersonatorID sets the "impersonator_id" field.
+func (_u *TokenUpdateOne) SetImpersonatorID(v string) *TokenUpdateOne {
+	_u.mutation.SetImpersonatorID(v)
+	return _u
+}
+
+// SetNillableImpersonatorID sets the "impersonator_id" field if the given value is not nil.
+func (_u *TokenUpdateOne) SetNillableImpersonatorID(v *string) *TokenUpdateOne {
+	if v != nil {
+		_u.SetImpersonatorID(*v)
+	}
+	return _u
+}
+
+// ClearImpersonatorID clears the value of the "impersonator_id" field.
+func (_u *TokenUpdateOne) ClearImpersonatorID() *TokenUpdateOne {
+	_u.mutation.ClearImpersonatorID()
+	return _u
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_u *TokenUpdateOne) SetUserAgent(v string) *TokenUpdateOne {
+	_u.mutation.SetUserAgent(v)
+	return _u
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_u *TokenUpdateOne) SetNillableUserAgent(v *string) *TokenUpdateOne {
+	if v != nil {
+		_u.SetUserAgent(*v)
+	}
+	return _u
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (_u *TokenUpdateOne) ClearUserAgent() *TokenUpdateOne {
+	_u.mutation.ClearUserAgent()
+	return _u
+}
+
+// SetIP sets the "ip" field.
+func (_u *TokenUpdateOne) SetIP(v string) *TokenUpdateOne {
+	_u.mutation.SetIP(v)
+	return _u
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_u *TokenUpdateOne) SetNillableIP(v *string) *TokenUpdateOne {
+	if v != nil {
+		_u.SetIP(*v)
+	}
+	return _u
+}
+
+// ClearIP clears the value of the "ip" field.
+func (_u *TokenUpdateOne) ClearIP() *TokenUpdateOne {
+	_u.mutation.ClearIP()
+	return _u
 }
 
 // SetUser sets the "user" edge to the User entity.
-func (tuo *TokenUpdateOne) SetUser(u *User) *TokenUpdateOne {
-	return tuo.SetUserID(u.ID)
+func (_u *TokenUpdateOne) SetUser(v *User) *TokenUpdateOne {
+	return _u.SetUserID(v.ID)
 }
 
 // Mutation returns the TokenMutation object of the builder.
-func (tuo *TokenUpdateOne) Mutation() *TokenMutation {
-	return tuo.mutation
+func (_u *TokenUpdateOne) Mutation() *TokenMutation {
+	return _u.mutation
 }
 
 // ClearUser clears the "user" edge to the User entity.
-func (tuo *TokenUpdateOne) ClearUser() *TokenUpdateOne {
-	tuo.mutation.ClearUser()
-	return tuo
+func (_u *TokenUpdateOne) ClearUser() *TokenUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
 }
 
 // Where appends a list predicates to the TokenUpdate builder.
-func (tuo *TokenUpdateOne) Where(ps ...predicate.Token) *TokenUpdateOne {
-	tuo.mutation.Where(ps...)
-	return tuo
+func (_u *TokenUpdateOne) Where(ps ...predicate.Token) *TokenUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
 }
 
 // Select allows selecting one or more fields (columns) of the returned entity.
 // The default is selecting all fields defined in the entity schema.
-func (tuo *TokenUpdateOne) Select(field string, fields ...string) *TokenUpdateOne {
-	tuo.fields = append([]string{field}, fields...)
-	return tuo
+func (_u *TokenUpdateOne) Select(field string, fields ...string) *TokenUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
 }
 
 // Save executes the query and returns the updated Token entity.
-func (tuo *TokenUpdateOne) Save(ctx context.Context) (*Token, error) {
-	if err := tuo.defaults(); err != nil {
+func (_u *TokenUpdateOne) Save(ctx context.Context) (*Token, error) {
+	if err := _u.defaults(); err != nil {
 		return nil, err
 	}
-	return withHooks(ctx, tuo.sqlSave, tuo.mutation, tuo.hooks)
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (tuo *TokenUpdateOne) SaveX(ctx context.Context) *Token {
-	node, err := tuo.Save(ctx)
+func (_u *TokenUpdateOne) SaveX(ctx context.Context) *Token {
+	node, err := _u.Save(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -479,64 +886,91 @@ func (tuo *TokenUpdateOne) SaveX(ctx context.Context) *Token {
 }
 
 // Exec executes the query on the entity.
-func (tuo *TokenUpdateOne) Exec(ctx context.Context) error {
-	_, err := tuo.Save(ctx)
+func (_u *TokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (tuo *TokenUpdateOne) ExecX(ctx context.Context) {
-	if err := tuo.Exec(ctx); err != nil {
+func (_u *TokenUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
 
 // defaults sets the default values of the builder before save.
-func (tuo *TokenUpdateOne) defaults() error {
-	if _, ok := tuo.mutation.UpdatedAt(); !ok {
+func (_u *TokenUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
 		if token.UpdateDefaultUpdatedAt == nil {
 			return fmt.Errorf("ent: uninitialized token.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
 		}
 		v := token.UpdateDefaultUpdatedAt()
-		tuo.mutation.SetUpdatedAt(v)
+		_u.mutation.SetUpdatedAt(v)
 	}
 	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
-func (tuo *TokenUpdateOne) check() error {
-	if v, ok := tuo.mutation.UserID(); ok {
+func (_u *TokenUpdateOne) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
 		if err := token.UserIDValidator(v); err != nil {
 			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Token.user_id": %w`, err)}
 		}
 	}
-	if v, ok := tuo.mutation.Token(); ok {
-		if err := token.TokenValidator(v); err != nil {
-			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "Token.token": %w`, err)}
+	if v, ok := _u.mutation.TokenHash(); ok {
+		if err := token.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "Token.token_hash": %w`, err)}
 		}
 	}
-	if v, ok := tuo.mutation.GetType(); ok {
+	if v, ok := _u.mutation.GetType(); ok {
 		if err := token.TypeValidator(v); err != nil {
 			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Token.type": %w`, err)}
 		}
 	}
-	if tuo.mutation.UserCleared() && len(tuo.mutation.UserIDs()) > 0 {
-		return errors.New(`ent: clearing a required unique edge "Token.user"`)
+	if v, ok := _u.mutation.FamilyID(); ok {
+		if err := token.FamilyIDValidator(v); err != nil {
+			return &ValidationError{Name: "family_id", err: fmt.Errorf(`ent: validator failed for field "Token.family_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClientID(); ok {
+		if err := token.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "Token.client_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Scope(); ok {
+		if err := token.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "Token.scope": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ImpersonatorID(); ok {
+		if err := token.ImpersonatorIDValidator(v); err != nil {
+			return &ValidationError{Name: "impersonator_id", err: fmt.Errorf(`ent: validator failed for field "Token.impersonator_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserAgent(); ok {
+		if err := token.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "Token.user_agent": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IP(); ok {
+		if err := token.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "Token.ip": %w`, err)}
+		}
 	}
 	return nil
 }
 
-func (tuo *TokenUpdateOne) sqlSave(ctx context.Context) (_node *Token, err error) {
-	if err := tuo.check(); err != nil {
+func (_u *TokenUpdateOne) sqlSave(ctx context.Context) (_node *Token, err error) {
+	if err := _u.check(); err != nil {
 		return _node, err
 	}
 	_spec := sqlgraph.NewUpdateSpec(token.Table, token.Columns, sqlgraph.NewFieldSpec(token.FieldID, field.TypeString))
-	id, ok := tuo.mutation.ID()
+	id, ok := _u.mutation.ID()
 	if !ok {
 		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Token.id" for update`)}
 	}
 	_spec.Node.ID.Value = id
-	if fields := tuo.fields; len(fields) > 0 {
+	if fields := _u.fields; len(fields) > 0 {
 		_spec.Node.Columns = make([]string, 0, len(fields))
 		_spec.Node.Columns = append(_spec.Node.Columns, token.FieldID)
 		for _, f := range fields {
@@ -548,41 +982,89 @@ func (tuo *TokenUpdateOne) sqlSave(ctx context.Context) (_node *Token, err error
 			}
 		}
 	}
-	if ps := tuo.mutation.predicates; len(ps) > 0 {
+	if ps := _u.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
 				ps[i](selector)
 			}
 		}
 	}
-	if value, ok := tuo.mutation.UpdatedAt(); ok {
+	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(token.FieldUpdatedAt, field.TypeTime, value)
 	}
-	if value, ok := tuo.mutation.DeletedAt(); ok {
+	if value, ok := _u.mutation.DeletedAt(); ok {
 		_spec.SetField(token.FieldDeletedAt, field.TypeInt64, value)
 	}
-	if value, ok := tuo.mutation.AddedDeletedAt(); ok {
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
 		_spec.AddField(token.FieldDeletedAt, field.TypeInt64, value)
 	}
-	if value, ok := tuo.mutation.Token(); ok {
-		_spec.SetField(token.FieldToken, field.TypeString, value)
+	if value, ok := _u.mutation.TokenHash(); ok {
+		_spec.SetField(token.FieldTokenHash, field.TypeString, value)
 	}
-	if value, ok := tuo.mutation.GetType(); ok {
+	if value, ok := _u.mutation.GetType(); ok {
 		_spec.SetField(token.FieldType, field.TypeEnum, value)
 	}
-	if value, ok := tuo.mutation.ExpiresAt(); ok {
+	if value, ok := _u.mutation.ExpiresAt(); ok {
 		_spec.SetField(token.FieldExpiresAt, field.TypeTime, value)
 	}
-	if value, ok := tuo.mutation.IsRevoked(); ok {
+	if value, ok := _u.mutation.IsRevoked(); ok {
 		_spec.SetField(token.FieldIsRevoked, field.TypeBool, value)
 	}
-	if value, ok := tuo.mutation.LastUsedAt(); ok {
+	if value, ok := _u.mutation.LastUsedAt(); ok {
 		_spec.SetField(token.FieldLastUsedAt, field.TypeTime, value)
 	}
-	if tuo.mutation.LastUsedAtCleared() {
+	if _u.mutation.LastUsedAtCleared() {
 		_spec.ClearField(token.FieldLastUsedAt, field.TypeTime)
 	}
-	if tuo.mutation.UserCleared() {
+	if value, ok := _u.mutation.FamilyID(); ok {
+		_spec.SetField(token.FieldFamilyID, field.TypeString, value)
+	}
+	if _u.mutation.FamilyIDCleared() {
+		_spec.ClearField(token.FieldFamilyID, field.TypeString)
+	}
+	if value, ok := _u.mutation.RotatedAt(); ok {
+		_spec.SetField(token.FieldRotatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RotatedAtCleared() {
+		_spec.ClearField(token.FieldRotatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ClientID(); ok {
+		_spec.SetField(token.FieldClientID, field.TypeString, value)
+	}
+	if _u.mutation.ClientIDCleared() {
+		_spec.ClearField(token.FieldClientID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Scope(); ok {
+		_spec.SetField(token.FieldScope, field.TypeString, value)
+	}
+	if _u.mutation.ScopeCleared() {
+		_spec.ClearField(token.FieldScope, field.TypeString)
+	}
+	if value, ok := _u.mutation.AuthTime(); ok {
+		_spec.SetField(token.FieldAuthTime, field.TypeTime, value)
+	}
+	if _u.mutation.AuthTimeCleared() {
+		_spec.ClearField(token.FieldAuthTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ImpersonatorID(); ok {
+		_spec.SetField(token.FieldImpersonatorID, field.TypeString, value)
+	}
+	if _u.mutation.ImpersonatorIDCleared() {
+		_spec.ClearField(token.FieldImpersonatorID, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserAgent(); ok {
+		_spec.SetField(token.FieldUserAgent, field.TypeString, value)
+	}
+	if _u.mutation.UserAgentCleared() {
+		_spec.ClearField(token.FieldUserAgent, field.TypeString)
+	}
+	if value, ok := _u.mutation.IP(); ok {
+		_spec.SetField(token.FieldIP, field.TypeString, value)
+	}
+	if _u.mutation.IPCleared() {
+		_spec.ClearField(token.FieldIP, field.TypeString)
+	}
+	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
 			Inverse: true,
@@ -595,7 +1077,7 @@ func (tuo *TokenUpdateOne) sqlSave(ctx context.Context) (_node *Token, err error
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := tuo.mutation.UserIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
 			Inverse: true,
@@ -611,10 +1093,10 @@ func (tuo *TokenUpdateOne) sqlSave(ctx context.Context) (_node *Token, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	_node = &Token{config: tuo.config}
+	_node = &Token{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
-	if err = sqlgraph.UpdateNode(ctx, tuo.driver, _spec); err != nil {
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{token.Label}
 		} else if sqlgraph.IsConstraintError(err) {
@@ -622,6 +1104,6 @@ func (tuo *TokenUpdateOne) sqlSave(ctx context.Context) (_node *Token, err error
 		}
 		return nil, err
 	}
-	tuo.mutation.done = true
+	_u.mutation.done = true
 	return _node, nil
 }
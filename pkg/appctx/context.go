@@ -13,6 +13,11 @@ type contextKey string
 const (
 	requestIDKey contextKey = "request_id"
 	userKey      contextKey = "user"
+	tokenKey     contextKey = "token"
+	scopesKey    contextKey = "scopes"
+	actorKey     contextKey = "actor"
+	clientIPKey  contextKey = "client_ip"
+	userAgentKey contextKey = "user_agent"
 )
 
 // WithRequestID 在 context 中设置 request ID
@@ -32,6 +37,28 @@ func MustGetRequestIDFromContext(ctx context.Context) string {
 	return requestID
 }
 
+// WithClientIP 在 context 中设置客户端IP
+func WithClientIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, clientIPKey, ip)
+}
+
+// MustGetClientIPFromContext 从 context 中获取客户端IP，如果不存在则返回空字符串
+func MustGetClientIPFromContext(ctx context.Context) string {
+	ip, _ := ctx.Value(clientIPKey).(string)
+	return ip
+}
+
+// WithUserAgent 在 context 中设置客户端 User-Agent
+func WithUserAgent(ctx context.Context, userAgent string) context.Context {
+	return context.WithValue(ctx, userAgentKey, userAgent)
+}
+
+// MustGetUserAgentFromContext 从 context 中获取客户端 User-Agent，如果不存在则返回空字符串
+func MustGetUserAgentFromContext(ctx context.Context) string {
+	userAgent, _ := ctx.Value(userAgentKey).(string)
+	return userAgent
+}
+
 // WithUser 在 context 中设置用户信息
 func WithUser(ctx context.Context, user *ent.User) context.Context {
 	return context.WithValue(ctx, userKey, user)
@@ -51,3 +78,36 @@ func MustGetUserFromContext(ctx context.Context) *ent.User {
 	}
 	return user
 }
+
+// WithToken 在 context 中设置当前请求使用的令牌记录
+func WithToken(ctx context.Context, token *ent.Token) context.Context {
+	return context.WithValue(ctx, tokenKey, token)
+}
+
+// GetTokenFromContext 从 context 中获取当前请求使用的令牌记录
+func GetTokenFromContext(ctx context.Context) (*ent.Token, bool) {
+	token, ok := ctx.Value(tokenKey).(*ent.Token)
+	return token, ok
+}
+
+// WithScopes 在 context 中设置当前令牌的授权范围，第一方登录会话不受授权范围限制，不需要设置
+func WithScopes(ctx context.Context, scopes []string) context.Context {
+	return context.WithValue(ctx, scopesKey, scopes)
+}
+
+// GetScopesFromContext 从 context 中获取当前令牌的授权范围，ok 为 false 表示不受授权范围限制
+func GetScopesFromContext(ctx context.Context) ([]string, bool) {
+	scopes, ok := ctx.Value(scopesKey).([]string)
+	return scopes, ok
+}
+
+// WithImpersonator 在 context 中设置代为登录的管理员ID，即当前请求的实际操作者
+func WithImpersonator(ctx context.Context, adminID string) context.Context {
+	return context.WithValue(ctx, actorKey, adminID)
+}
+
+// GetImpersonatorFromContext 从 context 中获取代为登录的管理员ID，ok 为 false 表示用户本人登录
+func GetImpersonatorFromContext(ctx context.Context) (string, bool) {
+	adminID, ok := ctx.Value(actorKey).(string)
+	return adminID, ok && adminID != ""
+}
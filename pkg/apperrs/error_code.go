@@ -67,6 +67,7 @@ const (
 	CodeConflict            Code = 10006 // 资源冲突
 	CodeUnprocessableEntity Code = 10007 // 数据验证失败
 	CodeTooManyRequests     Code = 10008 // 请求过于频繁
+	CodeReauthRequired      Code = 10009 // 需要重新验证身份
 )
 
 // 服务器错误 2xxxx
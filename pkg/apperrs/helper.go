@@ -49,6 +49,20 @@ var (
 			Tags(TagResource).
 			Public("资源不存在")
 
+	// ErrTooManyRequests 请求过于频繁错误构建器
+	ErrTooManyRequests = oops.
+				Code(CodeTooManyRequests.ToString()).
+				In("security").
+				Tags(TagSecurity, TagClient).
+				Public("请求过于频繁")
+
+	// ErrReauthRequired 需要重新验证身份错误构建器，客户端应引导用户重新验证后重试
+	ErrReauthRequired = oops.
+				Code(CodeReauthRequired.ToString()).
+				In("auth").
+				Tags(TagAuth, TagSecurity).
+				Public("请重新验证身份后再执行此操作")
+
 	// ErrInternal 内部服务器错误构建器
 	ErrInternal = oops.
 			Code(CodeInternalServerError.ToString()).
@@ -0,0 +1,138 @@
+package handlers
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/liukeshao/echo-template/pkg/appctx"
+	"github.com/liukeshao/echo-template/pkg/apperrs"
+	"github.com/liukeshao/echo-template/pkg/middleware"
+	"github.com/liukeshao/echo-template/pkg/services"
+	"github.com/liukeshao/echo-template/pkg/types"
+)
+
+// AdminHandler 管理员处理器
+type AdminHandler struct {
+	auth          *services.AuthService
+	admin         *services.AdminService
+	impersonation *services.ImpersonationService
+}
+
+// 自动注册
+func init() {
+	Register(new(AdminHandler))
+}
+
+// Init 依赖注入
+func (h *AdminHandler) Init(c *services.Container) error {
+	h.auth = c.Auth
+	h.admin = c.Admin
+	h.impersonation = c.Impersonation
+	return nil
+}
+
+// Routes 路由定义
+func (h *AdminHandler) Routes(g *echo.Group) {
+	// 只有管理员本人登录的会话可以访问
+	admin := g.Group("/api/v1/admin")
+	admin.Use(middleware.RequireAuth(h.auth), middleware.RequireAdmin())
+
+	// 用户管理
+	admin.POST("/users/:id/reset-password", h.ResetPassword)
+
+	// 代为登录
+	admin.POST("/users/:id/impersonate", h.Impersonate)
+	admin.GET("/impersonations", h.ListImpersonations)
+	admin.POST("/impersonations/:id/end", h.EndImpersonation)
+}
+
+// ResetPassword 强制重置用户密码
+func (h *AdminHandler) ResetPassword(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	admin, ok := appctx.GetUserFromContext(ctx)
+	if !ok {
+		return apperrs.ErrUnauthorized.Errorf("用户未登录")
+	}
+
+	var in types.AdminResetPasswordInput
+	if err := c.Bind(&in); err != nil {
+		return apperrs.ErrBadRequest.Wrap(err)
+	}
+
+	if err := in.Validate(); err != nil {
+		return err
+	}
+
+	if err := h.admin.ResetPassword(ctx, admin.ID, &in); err != nil {
+		return err
+	}
+
+	return Success(c, nil)
+}
+
+// Impersonate 代为登录用户账户
+func (h *AdminHandler) Impersonate(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	admin, ok := appctx.GetUserFromContext(ctx)
+	if !ok {
+		return apperrs.ErrUnauthorized.Errorf("用户未登录")
+	}
+
+	var in types.ImpersonateInput
+	if err := c.Bind(&in); err != nil {
+		return apperrs.ErrBadRequest.Wrap(err)
+	}
+
+	if err := in.Validate(); err != nil {
+		return err
+	}
+
+	out, err := h.impersonation.Start(ctx, admin.ID, &in)
+	if err != nil {
+		return err
+	}
+
+	return Success(c, out)
+}
+
+// ListImpersonations 获取代为登录审计记录
+func (h *AdminHandler) ListImpersonations(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	var in types.ListImpersonationsInput
+	if err := c.Bind(&in); err != nil {
+		return apperrs.ErrBadRequest.Wrap(err)
+	}
+
+	if err := in.Validate(); err != nil {
+		return err
+	}
+
+	out, err := h.impersonation.List(ctx, &in)
+	if err != nil {
+		return err
+	}
+
+	return Success(c, out)
+}
+
+// EndImpersonation 结束代为登录
+func (h *AdminHandler) EndImpersonation(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	var in types.EndImpersonationInput
+	if err := c.Bind(&in); err != nil {
+		return apperrs.ErrBadRequest.Wrap(err)
+	}
+
+	if err := in.Validate(); err != nil {
+		return err
+	}
+
+	if err := h.impersonation.End(ctx, &in); err != nil {
+		return err
+	}
+
+	return Success(c, nil)
+}
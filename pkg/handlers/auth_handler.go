@@ -1,7 +1,9 @@
 package handlers
 
 import (
-	"strings"
+	"net/http"
+	"net/url"
+	"strconv"
 
 	"github.com/labstack/echo/v4"
 	"github.com/liukeshao/echo-template/pkg/appctx"
@@ -13,7 +15,14 @@ import (
 
 // AuthHandler 认证处理器
 type AuthHandler struct {
-	auth *services.AuthService
+	auth          *services.AuthService
+	verification  *services.VerificationService
+	passwordReset *services.PasswordResetService
+	emailChange   *services.EmailChangeService
+	magicLink     *services.MagicLinkService
+	passkey       *services.PasskeyService
+	oidc          *services.OIDCService
+	cookies       *services.SessionCookies
 }
 
 // 自动注册
@@ -24,6 +33,13 @@ func init() {
 // Init 依赖注入
 func (h *AuthHandler) Init(c *services.Container) error {
 	h.auth = c.Auth
+	h.verification = c.Verification
+	h.passwordReset = c.PasswordReset
+	h.emailChange = c.EmailChange
+	h.magicLink = c.MagicLink
+	h.passkey = c.Passkey
+	h.oidc = c.OIDC
+	h.cookies = c.Auth.SessionCookies()
 	return nil
 }
 
@@ -34,12 +50,31 @@ func (h *AuthHandler) Routes(g *echo.Group) {
 	// 公开路由（无需认证）
 	auth.POST("/register", h.Register)
 	auth.POST("/login", h.Login)
+	auth.POST("/login/2fa", h.LoginTwoFactor)
 	auth.POST("/refresh", h.RefreshToken)
+	auth.POST("/cookie/refresh", h.RefreshCookieSession)
+	auth.GET("/verify-email", h.VerifyEmail)
+	auth.POST("/verify-email", h.VerifyEmail)
+	auth.POST("/verify-email/resend", h.ResendVerification)
+	auth.POST("/password/forgot", h.ForgotPassword)
+	auth.POST("/password/reset", h.ResetPassword)
+	auth.POST("/email/confirm", h.ConfirmEmailChange)
+	auth.POST("/email/revert", h.RevertEmailChange)
+	auth.POST("/magic-link", h.RequestMagicLink)
+	auth.POST("/magic-link/verify", h.VerifyMagicLink)
+	auth.POST("/webauthn/login/begin", h.BeginPasskeyLogin)
+	auth.POST("/webauthn/login/finish", h.FinishPasskeyLogin)
+	auth.GET("/oidc/providers", h.OIDCProviders)
+	auth.POST("/oidc/exchange", h.OIDCExchange)
+	auth.GET("/oidc/:provider", h.OIDCLogin)
+	auth.GET("/oidc/:provider/callback", h.OIDCCallback)
 
 	// 需要认证的路由
 	protected := g.Group("/api/v1/auth")
 	protected.Use(middleware.RequireAuth(h.auth))
 	protected.POST("/logout", h.Logout)
+	protected.POST("/webauthn/register/begin", h.BeginPasskeyRegistration, middleware.DenyImpersonation())
+	protected.POST("/webauthn/register/finish", h.FinishPasskeyRegistration, middleware.DenyImpersonation())
 }
 
 // Register 用户注册
@@ -61,7 +96,7 @@ func (h *AuthHandler) Register(c echo.Context) error {
 		return err
 	}
 
-	return Success(c, out)
+	return authResponse(c, h.cookies, out)
 }
 
 // Login 用户登录
@@ -83,7 +118,28 @@ func (h *AuthHandler) Login(c echo.Context) error {
 		return err
 	}
 
-	return Success(c, out)
+	return authResponse(c, h.cookies, out)
+}
+
+// LoginTwoFactor 两步验证登录
+func (h *AuthHandler) LoginTwoFactor(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	var in types.LoginTwoFactorInput
+	if err := c.Bind(&in); err != nil {
+		return apperrs.ErrBadRequest.Wrap(err)
+	}
+
+	if err := in.Validate(); err != nil {
+		return err
+	}
+
+	out, err := h.auth.LoginTwoFactor(ctx, &in)
+	if err != nil {
+		return err
+	}
+
+	return authResponse(c, h.cookies, out)
 }
 
 // RefreshToken 刷新访问令牌
@@ -119,27 +175,393 @@ func (h *AuthHandler) Logout(c echo.Context) error {
 		return apperrs.ErrUnauthorized.Errorf("用户未登录")
 	}
 
-	// 从Authorization header获取token
-	authHeader := c.Request().Header.Get("Authorization")
-	if authHeader == "" {
+	token, fromCookie, err := middleware.TokenFromRequest(c, h.cookies)
+	if err != nil {
 		return apperrs.ErrUnauthorized.Errorf("用户未登录")
 	}
 
-	// 检查Bearer格式
-	if !strings.HasPrefix(authHeader, "Bearer ") {
+	// 撤销token
+	if err := h.auth.Logout(ctx, token); err != nil {
+		return err
+	}
+
+	if fromCookie {
+		h.cookies.Clear(c.Response())
+	}
+
+	return Success(c, nil)
+}
+
+// RefreshCookieSession 使用刷新令牌Cookie刷新Cookie会话
+func (h *AuthHandler) RefreshCookieSession(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	if !h.cookies.Enabled() {
+		return apperrs.ErrForbidden.Public("未开启Cookie会话模式").Errorf("未开启Cookie会话模式")
+	}
+
+	// 刷新令牌Cookie由浏览器自动携带，同样需要校验CSRF令牌
+	if err := h.cookies.CheckCSRF(c.Request()); err != nil {
+		return err
+	}
+
+	refreshToken := h.cookies.RefreshToken(c.Request())
+	if refreshToken == "" {
+		return apperrs.ErrUnauthorized.Errorf("缺少刷新令牌Cookie")
+	}
+
+	// 调用服务层
+	out, err := h.auth.RefreshToken(ctx, &types.RefreshTokenInput{RefreshToken: refreshToken})
+	if err != nil {
+		return err
+	}
+
+	h.cookies.Issue(c.Response(), out)
+	out.AccessToken = ""
+	out.RefreshToken = ""
+	return Success(c, out)
+}
+
+// authResponse 返回登录结果，请求选择Cookie会话模式时将令牌写入HttpOnly Cookie，不在响应体中返回
+func authResponse(c echo.Context, cookies *services.SessionCookies, out *types.AuthOutput) error {
+	if out.AccessToken != "" && cookies.Requested(c.Request()) {
+		cookies.Issue(c.Response(), out)
+		out.AccessToken = ""
+		out.RefreshToken = ""
+	}
+
+	return Success(c, out)
+}
+
+// VerifyEmail 验证邮箱
+func (h *AuthHandler) VerifyEmail(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	var in types.VerifyEmailInput
+	if err := c.Bind(&in); err != nil {
+		return apperrs.ErrBadRequest.Wrap(err)
+	}
+
+	if err := in.Validate(); err != nil {
+		return err
+	}
+
+	// 调用服务层
+	if err := h.verification.VerifyEmail(ctx, &in); err != nil {
+		return err
+	}
+
+	return Success(c, nil)
+}
+
+// ResendVerification 重新发送验证邮件
+func (h *AuthHandler) ResendVerification(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	var in types.ResendVerificationInput
+	if err := c.Bind(&in); err != nil {
+		return apperrs.ErrBadRequest.Wrap(err)
+	}
+
+	if err := in.Validate(); err != nil {
+		return err
+	}
+
+	// 调用服务层
+	if err := h.verification.ResendVerification(ctx, &in); err != nil {
+		return err
+	}
+
+	return Success(c, nil)
+}
+
+// ForgotPassword 忘记密码，发送重置密码邮件
+func (h *AuthHandler) ForgotPassword(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	var in types.ForgotPasswordInput
+	if err := c.Bind(&in); err != nil {
+		return apperrs.ErrBadRequest.Wrap(err)
+	}
+
+	if err := in.Validate(); err != nil {
+		return err
+	}
+
+	// 调用服务层
+	if err := h.passwordReset.ForgotPassword(ctx, &in); err != nil {
+		return err
+	}
+
+	return Success(c, nil)
+}
+
+// ResetPassword 使用重置令牌设置新密码
+func (h *AuthHandler) ResetPassword(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	var in types.ResetPasswordInput
+	if err := c.Bind(&in); err != nil {
+		return apperrs.ErrBadRequest.Wrap(err)
+	}
+
+	if err := in.Validate(); err != nil {
+		return err
+	}
+
+	// 调用服务层
+	if err := h.passwordReset.ResetPassword(ctx, &in); err != nil {
+		return err
+	}
+
+	return Success(c, nil)
+}
+
+// ConfirmEmailChange 使用发送到新邮箱的令牌确认修改邮箱
+func (h *AuthHandler) ConfirmEmailChange(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	var in types.EmailChangeTokenInput
+	if err := c.Bind(&in); err != nil {
+		return apperrs.ErrBadRequest.Wrap(err)
+	}
+
+	if err := in.Validate(); err != nil {
+		return err
+	}
+
+	if err := h.emailChange.Confirm(ctx, &in); err != nil {
+		return err
+	}
+
+	return Success(c, nil)
+}
+
+// RevertEmailChange 使用发送到原邮箱的令牌撤销修改邮箱
+func (h *AuthHandler) RevertEmailChange(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	var in types.EmailChangeTokenInput
+	if err := c.Bind(&in); err != nil {
+		return apperrs.ErrBadRequest.Wrap(err)
+	}
+
+	if err := in.Validate(); err != nil {
+		return err
+	}
+
+	if err := h.emailChange.Revert(ctx, &in); err != nil {
+		return err
+	}
+
+	return Success(c, nil)
+}
+
+// RequestMagicLink 申请邮件登录链接
+func (h *AuthHandler) RequestMagicLink(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	var in types.MagicLinkInput
+	if err := c.Bind(&in); err != nil {
+		return apperrs.ErrBadRequest.Wrap(err)
+	}
+
+	if err := in.Validate(); err != nil {
+		return err
+	}
+
+	// 调用服务层
+	out, err := h.magicLink.RequestLink(ctx, &in)
+	if err != nil {
+		return err
+	}
+
+	return Success(c, out)
+}
+
+// VerifyMagicLink 使用邮件登录链接登录
+func (h *AuthHandler) VerifyMagicLink(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	var in types.MagicLinkVerifyInput
+	if err := c.Bind(&in); err != nil {
+		return apperrs.ErrBadRequest.Wrap(err)
+	}
+
+	if err := in.Validate(); err != nil {
+		return err
+	}
+
+	// 调用服务层
+	out, err := h.magicLink.Verify(ctx, &in)
+	if err != nil {
+		return err
+	}
+
+	return authResponse(c, h.cookies, out)
+}
+
+// BeginPasskeyRegistration 开始为当前用户注册通行密钥
+func (h *AuthHandler) BeginPasskeyRegistration(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	user, ok := appctx.GetUserFromContext(ctx)
+	if !ok {
 		return apperrs.ErrUnauthorized.Errorf("用户未登录")
 	}
 
-	token := strings.TrimPrefix(authHeader, "Bearer ")
-	if token == "" {
+	out, err := h.passkey.BeginRegistration(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+
+	return Success(c, out)
+}
+
+// FinishPasskeyRegistration 完成通行密钥注册
+func (h *AuthHandler) FinishPasskeyRegistration(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	user, ok := appctx.GetUserFromContext(ctx)
+	if !ok {
 		return apperrs.ErrUnauthorized.Errorf("用户未登录")
 	}
 
-	// 撤销token
-	err := h.auth.Logout(ctx, token)
+	var in types.PasskeyRegisterFinishInput
+	if err := c.Bind(&in); err != nil {
+		return apperrs.ErrBadRequest.Wrap(err)
+	}
+
+	if err := in.Validate(); err != nil {
+		return err
+	}
+
+	out, err := h.passkey.FinishRegistration(ctx, user.ID, &in)
 	if err != nil {
 		return err
 	}
 
-	return Success(c, nil)
+	return Success(c, out)
+}
+
+// BeginPasskeyLogin 开始通行密钥登录
+func (h *AuthHandler) BeginPasskeyLogin(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	out, err := h.passkey.BeginLogin(ctx)
+	if err != nil {
+		return err
+	}
+
+	return Success(c, out)
+}
+
+// FinishPasskeyLogin 完成通行密钥登录
+func (h *AuthHandler) FinishPasskeyLogin(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	var in types.PasskeyLoginFinishInput
+	if err := c.Bind(&in); err != nil {
+		return apperrs.ErrBadRequest.Wrap(err)
+	}
+
+	if err := in.Validate(); err != nil {
+		return err
+	}
+
+	out, err := h.passkey.FinishLogin(ctx, &in)
+	if err != nil {
+		return err
+	}
+
+	return authResponse(c, h.cookies, out)
+}
+
+// OIDCProviders 获取可用的外部身份提供方
+func (h *AuthHandler) OIDCProviders(c echo.Context) error {
+	return Success(c, h.oidc.Providers())
+}
+
+// OIDCLogin 跳转到外部身份提供方登录
+func (h *AuthHandler) OIDCLogin(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	var in types.OIDCLoginInput
+	if err := c.Bind(&in); err != nil {
+		return apperrs.ErrBadRequest.Wrap(err)
+	}
+
+	if err := in.Validate(); err != nil {
+		return err
+	}
+
+	authURL, err := h.oidc.AuthURL(ctx, in.Provider)
+	if err != nil {
+		return err
+	}
+
+	return c.Redirect(http.StatusFound, authURL)
+}
+
+// OIDCCallback 外部身份提供方登录回调，完成后跳转到前端页面，令牌不会出现在响应或跳转地址中。
+// 开启 Cookie 会话模式时直接写入会话 Cookie，否则在地址片段中附带一次性登录码，由前端换取令牌
+func (h *AuthHandler) OIDCCallback(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	var in types.OIDCCallbackInput
+	if err := c.Bind(&in); err != nil {
+		return apperrs.ErrBadRequest.Wrap(err)
+	}
+
+	if err := in.Validate(); err != nil {
+		return err
+	}
+
+	// 未开启 Cookie 会话模式时通过地址片段把登录码交给前端，片段不会发送到服务器
+	if !h.cookies.Enabled() {
+		code, err := h.oidc.CallbackCode(ctx, &in)
+		if err != nil {
+			return err
+		}
+		fragment := url.Values{"code": {code}}
+		return c.Redirect(http.StatusFound, h.oidc.LoginURL()+"#"+fragment.Encode())
+	}
+
+	out, err := h.oidc.Callback(ctx, &in)
+	if err != nil {
+		return err
+	}
+
+	// 需要两步验证时通过地址片段把挑战令牌交给前端
+	if out.MFARequired {
+		fragment := url.Values{
+			"mfa_token":      {out.MFAToken},
+			"mfa_expires_at": {strconv.FormatInt(out.MFAExpiresAt, 10)},
+		}
+		return c.Redirect(http.StatusFound, h.oidc.LoginURL()+"#"+fragment.Encode())
+	}
+
+	h.cookies.Issue(c.Response(), out)
+	return c.Redirect(http.StatusFound, h.oidc.LoginURL())
+}
+
+// OIDCExchange 使用外部账户登录回调附带的一次性登录码换取令牌
+func (h *AuthHandler) OIDCExchange(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	var in types.OIDCExchangeInput
+	if err := c.Bind(&in); err != nil {
+		return apperrs.ErrBadRequest.Wrap(err)
+	}
+
+	if err := in.Validate(); err != nil {
+		return err
+	}
+
+	out, err := h.oidc.Exchange(ctx, &in)
+	if err != nil {
+		return err
+	}
+
+	return authResponse(c, h.cookies, out)
 }
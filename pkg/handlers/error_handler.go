@@ -19,6 +19,8 @@ func AppErrorHandler(err error, c echo.Context) {
 	switch e := err.(type) {
 	case oops.OopsError:
 		handleOopsError(e, c)
+	case *apperrs.Response:
+		handleResponseError(e, c)
 	case *echo.HTTPError:
 		handleEchoHTTPError(e, c)
 	default:
@@ -40,6 +42,28 @@ func handleOopsError(err oops.OopsError, c echo.Context) {
 	sendResponse(c, response)
 }
 
+// handleResponseError 处理带有字段级错误详情的响应，例如输入校验和密码策略错误
+func handleResponseError(err *apperrs.Response, c echo.Context) {
+	// 输入校验沿用HTTP状态码400，统一转换为业务状态码
+	code := err.Code
+	if code == http.StatusBadRequest || code == apperrs.CodeOK.ToInt() {
+		code = apperrs.CodeBadRequest.ToInt()
+	}
+	message := err.Message
+	if message == "" {
+		message = "请求参数错误"
+	}
+
+	response := apperrs.NewResponse(c, apperrs.WithCode(code), apperrs.WithMessage(message), apperrs.WithErrors(err.Errors))
+	logger := slog.With(
+		"path", c.Request().URL.Path,
+		"method", c.Request().Method,
+		"errors", err.Errors,
+	)
+	logger.InfoContext(c.Request().Context(), "Request rejected")
+	sendResponse(c, response)
+}
+
 // handleEchoHTTPError 处理Echo HTTP错误
 func handleEchoHTTPError(err *echo.HTTPError, c echo.Context) {
 	logError(err, c, "Echo HTTP error occurred")
@@ -12,8 +12,14 @@ import (
 
 // MeHandler 用户处理器
 type MeHandler struct {
-	me   *services.MeService
-	auth *services.AuthService
+	me        *services.MeService
+	auth      *services.AuthService
+	session   *services.SessionService
+	twoFactor *services.TwoFactorService
+	passkey   *services.PasskeyService
+	tokens    *services.PersonalTokenService
+	email     *services.EmailChangeService
+	events    *services.SecurityEventService
 }
 
 // init 注册handler
@@ -25,20 +31,58 @@ func init() {
 func (h *MeHandler) Init(c *services.Container) error {
 	h.me = c.Me
 	h.auth = c.Auth
+	h.session = c.Session
+	h.twoFactor = c.TwoFactor
+	h.passkey = c.Passkey
+	h.tokens = c.PersonalTokens
+	h.email = c.EmailChange
+	h.events = c.SecurityEvents
 	return nil
 }
 
 // Routes 注册路由
 func (h *MeHandler) Routes(g *echo.Group) {
-	// 需要认证的路由组
+	// 可以通过个人访问令牌或第三方应用令牌访问的路由，按路由声明所需授权范围
+	scoped := g.Group("/api/v1/me")
+	scoped.GET("", h.Get, middleware.RequireAuth(h.auth, types.ScopeProfileRead))
+	scoped.PUT("/username", h.UpdateUsername, middleware.RequireAuth(h.auth, types.ScopeProfileWrite))
+
+	// 需要认证的路由组，只接受第一方登录会话
 	protected := g.Group("/api/v1/me")
 	protected.Use(middleware.RequireAuth(h.auth)) // 先验证用户身份
 
-	// 当前用户相关路由（不需要额外权限，只要登录即可）
-	protected.GET("", h.Get)
-	protected.PUT("/username", h.UpdateUsername)
-	protected.PUT("/email", h.UpdateEmail)
-	protected.POST("/change-password", h.ChangePassword)
+	// 当前用户相关路由（不需要额外权限，只要登录即可），
+	// 修改登录凭据等敏感操作不允许管理员代为登录时执行
+	protected.POST("/change-password", h.ChangePassword, middleware.DenyImpersonation())
+
+	// 重新验证身份，修改登录标识、关闭两步验证和注销账户需要最近完成过认证
+	protected.POST("/reauthenticate", h.Reauthenticate, middleware.DenyImpersonation())
+	protected.PUT("/email", h.UpdateEmail, middleware.DenyImpersonation(), middleware.RequireRecentAuth(h.auth))
+	protected.DELETE("", h.DeleteAccount, middleware.DenyImpersonation(), middleware.RequireRecentAuth(h.auth))
+
+	// 会话管理
+	protected.GET("/sessions", h.ListSessions)
+	protected.DELETE("/sessions/:id", h.RevokeSession)
+	protected.POST("/sessions/revoke-others", h.RevokeOtherSessions, middleware.DenyImpersonation())
+
+	// 安全事件日志
+	protected.GET("/security-events", h.ListSecurityEvents)
+
+	// 两步验证
+	protected.GET("/2fa", h.TwoFactorStatus)
+	protected.POST("/2fa/setup", h.SetupTwoFactor, middleware.DenyImpersonation())
+	protected.POST("/2fa/confirm", h.ConfirmTwoFactor, middleware.DenyImpersonation())
+	protected.POST("/2fa/disable", h.DisableTwoFactor, middleware.DenyImpersonation(), middleware.RequireRecentAuth(h.auth))
+
+	// 通行密钥管理
+	protected.GET("/passkeys", h.ListPasskeys)
+	protected.PUT("/passkeys/:id", h.RenamePasskey)
+	protected.DELETE("/passkeys/:id", h.DeletePasskey, middleware.DenyImpersonation())
+
+	// 个人访问令牌管理
+	protected.GET("/tokens", h.ListPersonalTokens)
+	protected.POST("/tokens", h.CreatePersonalToken, middleware.DenyImpersonation())
+	protected.DELETE("/tokens/:id", h.RevokePersonalToken)
 }
 
 // Get 获取当前用户信息
@@ -89,7 +133,7 @@ func (h *MeHandler) UpdateUsername(c echo.Context) error {
 	return Success(c, out)
 }
 
-// UpdateEmail 更新当前用户邮箱
+// UpdateEmail 申请修改当前用户邮箱，新邮箱确认后生效
 func (h *MeHandler) UpdateEmail(c echo.Context) error {
 	ctx := c.Request().Context()
 
@@ -109,8 +153,8 @@ func (h *MeHandler) UpdateEmail(c echo.Context) error {
 		return err
 	}
 
-	// 更新当前用户邮箱
-	out, err := h.me.UpdateEmail(ctx, user.ID, &in)
+	// 保存待确认的新邮箱并发送确认邮件
+	out, err := h.email.Request(ctx, user.ID, &in)
 	if err != nil {
 		return err
 	}
@@ -138,11 +182,412 @@ func (h *MeHandler) ChangePassword(c echo.Context) error {
 		return err
 	}
 
-	// 修改当前用户密码
-	err := h.me.ChangePassword(ctx, user.ID, &in)
+	// 修改当前用户密码，撤销其他会话时返回当前会话的新令牌
+	out, err := h.me.ChangePassword(ctx, user.ID, &in)
+	if err != nil {
+		return err
+	}
+	if out == nil {
+		return Success(c, nil)
+	}
+
+	// 通过Cookie会话修改密码时同时更新Cookie
+	cookies := h.auth.SessionCookies()
+	if _, fromCookie, _ := middleware.TokenFromRequest(c, cookies); fromCookie {
+		cookies.Issue(c.Response(), out)
+		out.AccessToken = ""
+		out.RefreshToken = ""
+		return Success(c, out)
+	}
+
+	return authResponse(c, cookies, out)
+}
+
+// Reauthenticate 使用当前密码或两步验证码重新验证身份
+func (h *MeHandler) Reauthenticate(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	// 从上下文获取当前用户和会话
+	user, ok := appctx.GetUserFromContext(ctx)
+	if !ok {
+		return apperrs.ErrUnauthorized.Errorf("用户未登录")
+	}
+	token, _ := appctx.GetTokenFromContext(ctx)
+
+	var in types.ReauthenticateInput
+	if err := c.Bind(&in); err != nil {
+		return apperrs.ErrBadRequest.Wrap(err)
+	}
+
+	// 验证输入
+	if err := in.Validate(); err != nil {
+		return err
+	}
+
+	out, err := h.auth.Reauthenticate(ctx, user, token, &in)
+	if err != nil {
+		return err
+	}
+
+	return Success(c, out)
+}
+
+// DeleteAccount 注销当前用户账户
+func (h *MeHandler) DeleteAccount(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	// 从上下文获取当前用户ID
+	user, ok := appctx.GetUserFromContext(ctx)
+	if !ok {
+		return apperrs.ErrUnauthorized.Errorf("用户未登录")
+	}
+
+	if err := h.me.DeleteAccount(ctx, user.ID); err != nil {
+		return err
+	}
+
+	// 通过Cookie会话注销时同时删除Cookie
+	cookies := h.auth.SessionCookies()
+	if _, fromCookie, _ := middleware.TokenFromRequest(c, cookies); fromCookie {
+		cookies.Clear(c.Response())
+	}
+
+	return Success(c, nil)
+}
+
+// currentSessionID 获取当前请求所属的会话ID
+func currentSessionID(c echo.Context) string {
+	token, ok := appctx.GetTokenFromContext(c.Request().Context())
+	if !ok {
+		return ""
+	}
+	return token.FamilyID
+}
+
+// ListSessions 获取当前用户的登录会话
+func (h *MeHandler) ListSessions(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	// 从上下文获取当前用户ID
+	user, ok := appctx.GetUserFromContext(ctx)
+	if !ok {
+		return apperrs.ErrUnauthorized.Errorf("用户未登录")
+	}
+
+	out, err := h.session.ListSessions(ctx, user.ID, currentSessionID(c))
+	if err != nil {
+		return err
+	}
+
+	return Success(c, out)
+}
+
+// RevokeSession 撤销当前用户的指定会话
+func (h *MeHandler) RevokeSession(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	// 从上下文获取当前用户ID
+	user, ok := appctx.GetUserFromContext(ctx)
+	if !ok {
+		return apperrs.ErrUnauthorized.Errorf("用户未登录")
+	}
+
+	var in types.RevokeSessionInput
+	if err := c.Bind(&in); err != nil {
+		return apperrs.ErrBadRequest.Wrap(err)
+	}
+
+	// 验证输入
+	if err := in.Validate(); err != nil {
+		return err
+	}
+
+	if err := h.session.RevokeSession(ctx, user.ID, in.ID); err != nil {
+		return err
+	}
+
+	return Success(c, nil)
+}
+
+// RevokeOtherSessions 退出除当前设备外的所有会话
+func (h *MeHandler) RevokeOtherSessions(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	// 从上下文获取当前用户ID
+	user, ok := appctx.GetUserFromContext(ctx)
+	if !ok {
+		return apperrs.ErrUnauthorized.Errorf("用户未登录")
+	}
+
+	out, err := h.session.RevokeOtherSessions(ctx, user.ID, currentSessionID(c))
+	if err != nil {
+		return err
+	}
+
+	return Success(c, out)
+}
+
+// ListSecurityEvents 分页获取当前用户的安全事件
+func (h *MeHandler) ListSecurityEvents(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	// 从上下文获取当前用户ID
+	user, ok := appctx.GetUserFromContext(ctx)
+	if !ok {
+		return apperrs.ErrUnauthorized.Errorf("用户未登录")
+	}
+
+	var in types.ListSecurityEventsInput
+	if err := c.Bind(&in); err != nil {
+		return apperrs.ErrBadRequest.Wrap(err)
+	}
+
+	// 验证输入
+	if err := in.Validate(); err != nil {
+		return err
+	}
+
+	out, err := h.events.List(ctx, user.ID, &in)
+	if err != nil {
+		return err
+	}
+
+	return Success(c, out)
+}
+
+// TwoFactorStatus 获取当前用户的两步验证状态
+func (h *MeHandler) TwoFactorStatus(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	// 从上下文获取当前用户ID
+	user, ok := appctx.GetUserFromContext(ctx)
+	if !ok {
+		return apperrs.ErrUnauthorized.Errorf("用户未登录")
+	}
+
+	out, err := h.twoFactor.Status(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+
+	return Success(c, out)
+}
+
+// SetupTwoFactor 生成两步验证密钥
+func (h *MeHandler) SetupTwoFactor(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	// 从上下文获取当前用户ID
+	user, ok := appctx.GetUserFromContext(ctx)
+	if !ok {
+		return apperrs.ErrUnauthorized.Errorf("用户未登录")
+	}
+
+	out, err := h.twoFactor.Setup(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+
+	return Success(c, out)
+}
+
+// ConfirmTwoFactor 确认开启两步验证
+func (h *MeHandler) ConfirmTwoFactor(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	// 从上下文获取当前用户ID
+	user, ok := appctx.GetUserFromContext(ctx)
+	if !ok {
+		return apperrs.ErrUnauthorized.Errorf("用户未登录")
+	}
+
+	var in types.TwoFactorConfirmInput
+	if err := c.Bind(&in); err != nil {
+		return apperrs.ErrBadRequest.Wrap(err)
+	}
+
+	// 验证输入
+	if err := in.Validate(); err != nil {
+		return err
+	}
+
+	out, err := h.twoFactor.Confirm(ctx, user.ID, &in)
 	if err != nil {
 		return err
 	}
 
+	return Success(c, out)
+}
+
+// DisableTwoFactor 关闭两步验证
+func (h *MeHandler) DisableTwoFactor(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	// 从上下文获取当前用户ID
+	user, ok := appctx.GetUserFromContext(ctx)
+	if !ok {
+		return apperrs.ErrUnauthorized.Errorf("用户未登录")
+	}
+
+	var in types.TwoFactorDisableInput
+	if err := c.Bind(&in); err != nil {
+		return apperrs.ErrBadRequest.Wrap(err)
+	}
+
+	// 验证输入
+	if err := in.Validate(); err != nil {
+		return err
+	}
+
+	if err := h.twoFactor.Disable(ctx, user.ID, &in); err != nil {
+		return err
+	}
+
+	return Success(c, nil)
+}
+
+// ListPasskeys 获取当前用户注册的通行密钥
+func (h *MeHandler) ListPasskeys(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	// 从上下文获取当前用户ID
+	user, ok := appctx.GetUserFromContext(ctx)
+	if !ok {
+		return apperrs.ErrUnauthorized.Errorf("用户未登录")
+	}
+
+	out, err := h.passkey.ListPasskeys(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+
+	return Success(c, out)
+}
+
+// RenamePasskey 重命名当前用户的通行密钥
+func (h *MeHandler) RenamePasskey(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	// 从上下文获取当前用户ID
+	user, ok := appctx.GetUserFromContext(ctx)
+	if !ok {
+		return apperrs.ErrUnauthorized.Errorf("用户未登录")
+	}
+
+	var in types.RenamePasskeyInput
+	if err := c.Bind(&in); err != nil {
+		return apperrs.ErrBadRequest.Wrap(err)
+	}
+
+	// 验证输入
+	if err := in.Validate(); err != nil {
+		return err
+	}
+
+	out, err := h.passkey.RenamePasskey(ctx, user.ID, &in)
+	if err != nil {
+		return err
+	}
+
+	return Success(c, out)
+}
+
+// DeletePasskey 删除当前用户的通行密钥
+func (h *MeHandler) DeletePasskey(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	// 从上下文获取当前用户ID
+	user, ok := appctx.GetUserFromContext(ctx)
+	if !ok {
+		return apperrs.ErrUnauthorized.Errorf("用户未登录")
+	}
+
+	var in types.DeletePasskeyInput
+	if err := c.Bind(&in); err != nil {
+		return apperrs.ErrBadRequest.Wrap(err)
+	}
+
+	// 验证输入
+	if err := in.Validate(); err != nil {
+		return err
+	}
+
+	if err := h.passkey.DeletePasskey(ctx, user.ID, in.ID); err != nil {
+		return err
+	}
+
+	return Success(c, nil)
+}
+
+// ListPersonalTokens 获取当前用户的个人访问令牌
+func (h *MeHandler) ListPersonalTokens(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	// 从上下文获取当前用户ID
+	user, ok := appctx.GetUserFromContext(ctx)
+	if !ok {
+		return apperrs.ErrUnauthorized.Errorf("用户未登录")
+	}
+
+	out, err := h.tokens.List(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+
+	return Success(c, out)
+}
+
+// CreatePersonalToken 创建个人访问令牌
+func (h *MeHandler) CreatePersonalToken(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	// 从上下文获取当前用户ID
+	user, ok := appctx.GetUserFromContext(ctx)
+	if !ok {
+		return apperrs.ErrUnauthorized.Errorf("用户未登录")
+	}
+
+	var in types.CreatePersonalAccessTokenInput
+	if err := c.Bind(&in); err != nil {
+		return apperrs.ErrBadRequest.Wrap(err)
+	}
+
+	// 验证输入
+	if err := in.Validate(); err != nil {
+		return err
+	}
+
+	out, err := h.tokens.Create(ctx, user.ID, &in)
+	if err != nil {
+		return err
+	}
+
+	return Success(c, out)
+}
+
+// RevokePersonalToken 撤销个人访问令牌
+func (h *MeHandler) RevokePersonalToken(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	// 从上下文获取当前用户ID
+	user, ok := appctx.GetUserFromContext(ctx)
+	if !ok {
+		return apperrs.ErrUnauthorized.Errorf("用户未登录")
+	}
+
+	var in types.RevokePersonalAccessTokenInput
+	if err := c.Bind(&in); err != nil {
+		return apperrs.ErrBadRequest.Wrap(err)
+	}
+
+	// 验证输入
+	if err := in.Validate(); err != nil {
+		return err
+	}
+
+	if err := h.tokens.Revoke(ctx, user.ID, in.ID); err != nil {
+		return err
+	}
+
 	return Success(c, nil)
 }
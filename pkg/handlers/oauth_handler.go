@@ -0,0 +1,260 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/liukeshao/echo-template/pkg/appctx"
+	"github.com/liukeshao/echo-template/pkg/apperrs"
+	"github.com/liukeshao/echo-template/pkg/middleware"
+	"github.com/liukeshao/echo-template/pkg/services"
+	"github.com/liukeshao/echo-template/pkg/types"
+)
+
+// OAuthHandler OAuth 2.0 授权服务处理器
+type OAuthHandler struct {
+	auth  *services.AuthService
+	oauth *services.OAuthService
+}
+
+// 自动注册
+func init() {
+	Register(new(OAuthHandler))
+}
+
+// Init 依赖注入
+func (h *OAuthHandler) Init(c *services.Container) error {
+	h.auth = c.Auth
+	h.oauth = c.OAuth
+	return nil
+}
+
+// Routes 路由定义
+func (h *OAuthHandler) Routes(g *echo.Group) {
+	oauth := g.Group("/api/v1/oauth")
+
+	// 客户端调用的端点，使用客户端认证
+	oauth.POST("/token", h.Token)
+	oauth.POST("/introspect", h.Introspect)
+	oauth.POST("/revoke", h.Revoke)
+
+	// 授权确认页面与客户端管理，需要用户登录
+	protected := g.Group("/api/v1/oauth")
+	protected.Use(middleware.RequireAuth(h.auth))
+	protected.GET("/authorize", h.Authorize)
+	protected.POST("/authorize", h.Consent, middleware.DenyImpersonation())
+	protected.GET("/clients", h.ListClients)
+	protected.POST("/clients", h.CreateClient, middleware.DenyImpersonation())
+	protected.DELETE("/clients/:id", h.DeleteClient)
+}
+
+// Authorize 校验授权请求，返回授权确认页面展示的客户端与授权范围
+func (h *OAuthHandler) Authorize(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	var in types.OAuthAuthorizeInput
+	if err := c.Bind(&in); err != nil {
+		return apperrs.ErrBadRequest.Wrap(err)
+	}
+
+	if err := in.Validate(); err != nil {
+		return err
+	}
+
+	out, err := h.oauth.Authorize(ctx, &in)
+	if err != nil {
+		return err
+	}
+
+	return Success(c, out)
+}
+
+// Consent 用户同意或拒绝授权，返回跳转回客户端的地址
+func (h *OAuthHandler) Consent(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	// 从上下文获取当前用户
+	user, ok := appctx.GetUserFromContext(ctx)
+	if !ok {
+		return apperrs.ErrUnauthorized.Errorf("用户未登录")
+	}
+
+	var in types.OAuthConsentInput
+	if err := c.Bind(&in); err != nil {
+		return apperrs.ErrBadRequest.Wrap(err)
+	}
+
+	if err := in.Validate(); err != nil {
+		return err
+	}
+
+	out, err := h.oauth.Consent(ctx, user.ID, &in)
+	if err != nil {
+		return err
+	}
+
+	return Success(c, out)
+}
+
+// Token 令牌端点
+// 按 RFC 6749 直接输出令牌和错误，不使用统一响应结构，以便标准 OAuth 客户端库直接消费
+func (h *OAuthHandler) Token(c echo.Context) error {
+	ctx := c.Request().Context()
+	c.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+
+	var in types.OAuthTokenInput
+	if err := c.Bind(&in); err != nil {
+		return oauthError(c, &services.OAuthError{Status: http.StatusBadRequest, Code: services.OAuthErrInvalidRequest, Description: "请求参数格式错误"})
+	}
+
+	clientID, clientSecret := clientCredentials(c, in.ClientID, in.ClientSecret)
+	out, err := h.oauth.Token(ctx, clientID, clientSecret, &in)
+	if err != nil {
+		return oauthError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, out)
+}
+
+// Introspect 令牌内省端点（RFC 7662）
+func (h *OAuthHandler) Introspect(c echo.Context) error {
+	ctx := c.Request().Context()
+	c.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+
+	var in types.OAuthTokenActionInput
+	if err := c.Bind(&in); err != nil {
+		return oauthError(c, &services.OAuthError{Status: http.StatusBadRequest, Code: services.OAuthErrInvalidRequest, Description: "请求参数格式错误"})
+	}
+
+	clientID, clientSecret := clientCredentials(c, in.ClientID, in.ClientSecret)
+	out, err := h.oauth.Introspect(ctx, clientID, clientSecret, &in)
+	if err != nil {
+		return oauthError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, out)
+}
+
+// Revoke 令牌撤销端点（RFC 7009），令牌无效时同样返回200
+func (h *OAuthHandler) Revoke(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	var in types.OAuthTokenActionInput
+	if err := c.Bind(&in); err != nil {
+		return oauthError(c, &services.OAuthError{Status: http.StatusBadRequest, Code: services.OAuthErrInvalidRequest, Description: "请求参数格式错误"})
+	}
+
+	clientID, clientSecret := clientCredentials(c, in.ClientID, in.ClientSecret)
+	if err := h.oauth.Revoke(ctx, clientID, clientSecret, &in); err != nil {
+		return oauthError(c, err)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// ListClients 列出当前用户注册的OAuth客户端
+func (h *OAuthHandler) ListClients(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	// 从上下文获取当前用户
+	user, ok := appctx.GetUserFromContext(ctx)
+	if !ok {
+		return apperrs.ErrUnauthorized.Errorf("用户未登录")
+	}
+
+	out, err := h.oauth.ListClients(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+
+	return Success(c, out)
+}
+
+// CreateClient 注册OAuth客户端
+func (h *OAuthHandler) CreateClient(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	// 从上下文获取当前用户
+	user, ok := appctx.GetUserFromContext(ctx)
+	if !ok {
+		return apperrs.ErrUnauthorized.Errorf("用户未登录")
+	}
+
+	var in types.CreateOAuthClientInput
+	if err := c.Bind(&in); err != nil {
+		return apperrs.ErrBadRequest.Wrap(err)
+	}
+
+	if err := in.Validate(); err != nil {
+		return err
+	}
+
+	out, err := h.oauth.CreateClient(ctx, user.ID, &in)
+	if err != nil {
+		return err
+	}
+
+	return Success(c, out)
+}
+
+// DeleteClient 删除OAuth客户端并撤销其令牌
+func (h *OAuthHandler) DeleteClient(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	// 从上下文获取当前用户
+	user, ok := appctx.GetUserFromContext(ctx)
+	if !ok {
+		return apperrs.ErrUnauthorized.Errorf("用户未登录")
+	}
+
+	var in types.DeleteOAuthClientInput
+	if err := c.Bind(&in); err != nil {
+		return apperrs.ErrBadRequest.Wrap(err)
+	}
+
+	if err := in.Validate(); err != nil {
+		return err
+	}
+
+	if err := h.oauth.DeleteClient(ctx, user.ID, in.ID); err != nil {
+		return err
+	}
+
+	return Success(c, nil)
+}
+
+// clientCredentials 获取客户端认证信息，优先使用 HTTP Basic 认证（RFC 6749 第2.3.1节）
+func clientCredentials(c echo.Context, clientID string, clientSecret string) (string, string) {
+	id, secret, ok := c.Request().BasicAuth()
+	if !ok {
+		return clientID, clientSecret
+	}
+
+	// Basic 认证中的客户端ID和密钥需先进行表单编码
+	if decoded, err := url.QueryUnescape(id); err == nil {
+		id = decoded
+	}
+	if decoded, err := url.QueryUnescape(secret); err == nil {
+		secret = decoded
+	}
+	return id, secret
+}
+
+// oauthError 按 RFC 6749 第5.2节输出OAuth错误，其余错误交给统一错误处理器
+func oauthError(c echo.Context, err error) error {
+	var oauthErr *services.OAuthError
+	if !errors.As(err, &oauthErr) {
+		return err
+	}
+
+	if oauthErr.Status == http.StatusUnauthorized {
+		c.Response().Header().Set(echo.HeaderWWWAuthenticate, `Basic realm="oauth"`)
+	}
+	return c.JSON(oauthErr.Status, types.OAuthErrorOutput{
+		Error:            oauthErr.Code,
+		ErrorDescription: oauthErr.Description,
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/labstack/echo/v4"
 	echomw "github.com/labstack/echo/v4/middleware"
 	"github.com/liukeshao/echo-template/pkg/appctx"
+	"github.com/liukeshao/echo-template/pkg/middleware"
 	"github.com/liukeshao/echo-template/pkg/services"
 )
 
@@ -29,6 +30,7 @@ func BuildRouter(c *services.Container) error {
 				c.SetRequest(c.Request().WithContext(ctx))
 			},
 		}),
+		middleware.ClientInfo(),
 		echomw.Gzip(),
 		echomw.TimeoutWithConfig(echomw.TimeoutConfig{
 			Timeout: c.Config.App.Timeout,
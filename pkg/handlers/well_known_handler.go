@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/liukeshao/echo-template/pkg/services"
+)
+
+// WellKnownHandler 公开元数据处理器（/.well-known）
+type WellKnownHandler struct {
+	auth  *services.AuthService
+	oauth *services.OAuthService
+}
+
+// 自动注册
+func init() {
+	Register(new(WellKnownHandler))
+}
+
+// Init 依赖注入
+func (h *WellKnownHandler) Init(c *services.Container) error {
+	h.auth = c.Auth
+	h.oauth = c.OAuth
+	return nil
+}
+
+// Routes 路由定义
+func (h *WellKnownHandler) Routes(g *echo.Group) {
+	wellKnown := g.Group("/.well-known")
+	wellKnown.GET("/jwks.json", h.JWKS)
+	wellKnown.GET("/oauth-authorization-server", h.OAuthMetadata)
+}
+
+// JWKS 发布令牌验证公钥
+// 按 RFC 7517 格式直接输出，不使用统一响应结构，以便标准 JWT 库直接消费
+func (h *WellKnownHandler) JWKS(c echo.Context) error {
+	c.Response().Header().Set(echo.HeaderCacheControl, "public, max-age=300")
+	return c.JSON(http.StatusOK, h.auth.JWKS())
+}
+
+// OAuthMetadata 发布OAuth授权服务元数据（RFC 8414）
+func (h *WellKnownHandler) OAuthMetadata(c echo.Context) error {
+	c.Response().Header().Set(echo.HeaderCacheControl, "public, max-age=300")
+	return c.JSON(http.StatusOK, h.oauth.Metadata())
+}
@@ -19,6 +19,10 @@ func (h *ContextHandler) Handle(ctx context.Context, r slog.Record) error {
 	if ok {
 		r.AddAttrs(slog.String("request_id", requestID))
 	}
+	// 管理员代为登录时记录实际操作者
+	if adminID, ok := appctx.GetImpersonatorFromContext(ctx); ok {
+		r.AddAttrs(slog.String("impersonator_id", adminID))
+	}
 	return h.Handler.Handle(ctx, r)
 }
 
@@ -0,0 +1,44 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// FileMailer 将邮件追加写入本地文件，用于本地开发
+type FileMailer struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewFileMailer 创建写入指定文件的邮件发送器
+func NewFileMailer(path string) *FileMailer {
+	if path == "" {
+		path = "dbs/mail.log"
+	}
+	return &FileMailer{path: path}
+}
+
+// Send 将邮件写入文件
+func (m *FileMailer) Send(_ context.Context, msg *Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(m.path), 0o755); err != nil {
+		return fmt.Errorf("mail: 创建邮件目录失败: %w", err)
+	}
+
+	f, err := os.OpenFile(m.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("mail: 打开邮件文件失败: %w", err)
+	}
+	defer f.Close()
+
+	_, err = fmt.Fprintf(f, "Date: %s\nTo: %s\nSubject: %s\n\n%s\n\n----------\n\n",
+		time.Now().Format(time.RFC3339), msg.To, msg.Subject, msg.Body)
+	return err
+}
@@ -0,0 +1,41 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/liukeshao/echo-template/config"
+)
+
+// 邮件发送方式
+const (
+	DriverSMTP   = "smtp"
+	DriverFile   = "file"
+	DriverMemory = "memory"
+)
+
+// Message 邮件内容
+type Message struct {
+	To      string // 收件人
+	Subject string // 主题
+	Body    string // 纯文本正文
+}
+
+// Mailer 邮件发送接口
+type Mailer interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+// New 根据配置创建邮件发送器
+func New(cfg config.MailConfig) (Mailer, error) {
+	switch cfg.Driver {
+	case DriverSMTP:
+		return NewSMTPMailer(cfg), nil
+	case DriverFile:
+		return NewFileMailer(cfg.File), nil
+	case DriverMemory, "":
+		return NewMemoryMailer(), nil
+	default:
+		return nil, fmt.Errorf("mail: 不支持的发送方式 %q", cfg.Driver)
+	}
+}
@@ -0,0 +1,47 @@
+package mail
+
+import (
+	"context"
+	"sync"
+)
+
+// MemoryMailer 将邮件保存在内存中，用于测试
+type MemoryMailer struct {
+	mu     sync.Mutex
+	outbox []*Message
+}
+
+// NewMemoryMailer 创建内存邮件发送器
+func NewMemoryMailer() *MemoryMailer {
+	return &MemoryMailer{}
+}
+
+// Send 将邮件保存到发件箱
+func (m *MemoryMailer) Send(_ context.Context, msg *Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.outbox = append(m.outbox, msg)
+	return nil
+}
+
+// Messages 返回已发送的邮件
+func (m *MemoryMailer) Messages() []*Message {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]*Message(nil), m.outbox...)
+}
+
+// Last 返回发送给指定收件人的最后一封邮件
+func (m *MemoryMailer) Last(to string) (*Message, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for i := len(m.outbox) - 1; i >= 0; i-- {
+		if m.outbox[i].To == to {
+			return m.outbox[i], true
+		}
+	}
+	return nil, false
+}
@@ -2,10 +2,12 @@ package middleware
 
 import (
 	"log/slog"
+	"net/http"
 	"strings"
 
 	"github.com/labstack/echo/v4"
 
+	userEnt "github.com/liukeshao/echo-template/ent/user"
 	"github.com/liukeshao/echo-template/pkg/appctx"
 	"github.com/liukeshao/echo-template/pkg/apperrs"
 	"github.com/liukeshao/echo-template/pkg/services"
@@ -32,33 +34,123 @@ func extractTokenFromHeader(c echo.Context) (string, error) {
 	return tokenString, nil
 }
 
-// RequireAuth 要求用户认证的中间件
-func RequireAuth(authService *services.AuthService) echo.MiddlewareFunc {
+// TokenFromRequest 提取请求中的访问令牌，优先使用Authorization header，
+// 没有时使用Cookie会话模式的访问令牌Cookie，fromCookie表示令牌是否来自Cookie
+func TokenFromRequest(c echo.Context, cookies *services.SessionCookies) (token string, fromCookie bool, err error) {
+	if c.Request().Header.Get("Authorization") == "" {
+		if token := cookies.AccessToken(c.Request()); token != "" {
+			return token, true, nil
+		}
+	}
+
+	token, err = extractTokenFromHeader(c)
+	return token, false, err
+}
+
+// isSafeMethod 判断是否为不修改状态的请求方法
+func isSafeMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
+
+// RequireAuth 要求用户认证的中间件。
+// 不声明授权范围时只接受第一方登录会话；声明授权范围后，同时接受具有全部所需授权范围的
+// 个人访问令牌和第三方应用令牌，例如 RequireAuth(auth, types.ScopeProfileRead)。
+// 开启Cookie会话模式后同时接受访问令牌Cookie，通过Cookie认证的写请求需要提交CSRF令牌
+func RequireAuth(authService *services.AuthService, scopes ...string) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
 			ctx := c.Request().Context()
 
-			// 从header提取token
-			tokenString, err := extractTokenFromHeader(c)
+			// 从header或Cookie提取token
+			cookies := authService.SessionCookies()
+			tokenString, fromCookie, err := TokenFromRequest(c, cookies)
 			if err != nil {
 				slog.WarnContext(ctx, "认证失败：提取token失败", "error", err)
 				return err
 			}
 
-			// 进行认证验证
-			user, token, err := authService.AuthenticateUser(ctx, tokenString)
+			// 浏览器会自动携带Cookie，写请求需要校验CSRF令牌防止跨站请求伪造
+			if fromCookie && !isSafeMethod(c.Request().Method) {
+				if err := cookies.CheckCSRF(c.Request()); err != nil {
+					slog.WarnContext(ctx, "认证失败：CSRF令牌校验失败", "path", c.Path())
+					return err
+				}
+			}
+
+			// 进行认证验证并校验授权范围
+			user, token, granted, err := authService.Authenticate(ctx, tokenString, scopes)
 			if err != nil {
 				return err
 			}
 
-			// 更新token使用时间
-			authService.UpdateTokenUsage(token)
-
-			// 将用户信息存储到context中
+			// 将用户信息和当前令牌存储到context中，个人访问令牌没有对应的令牌记录
 			ctx = appctx.WithUser(ctx, user)
+			if token != nil {
+				// 更新token使用时间
+				authService.UpdateTokenUsage(token)
+				ctx = appctx.WithToken(ctx, token)
+				// 管理员代为登录时记录实际操作者
+				if token.ImpersonatorID != "" {
+					ctx = appctx.WithImpersonator(ctx, token.ImpersonatorID)
+				}
+			}
+			if granted != nil {
+				ctx = appctx.WithScopes(ctx, granted)
+			}
 
 			c.SetRequest(c.Request().WithContext(ctx))
 			return next(c)
 		}
 	}
 }
+
+// RequireAdmin 要求当前用户为管理员的中间件，需要在 RequireAuth 之后使用。
+// 代为登录的令牌即使属于管理员也会被拒绝
+func RequireAdmin() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			ctx := c.Request().Context()
+
+			user, ok := appctx.GetUserFromContext(ctx)
+			if !ok {
+				return apperrs.ErrUnauthorized.Errorf("用户未登录")
+			}
+			if _, impersonated := appctx.GetImpersonatorFromContext(ctx); impersonated || user.Role != userEnt.RoleAdmin {
+				slog.WarnContext(ctx, "非管理员访问管理接口", "user_id", user.ID, "path", c.Path())
+				return apperrs.ErrForbidden.With("user_id", user.ID).Public("需要管理员权限").Errorf("需要管理员权限")
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// DenyImpersonation 拒绝管理员代为登录时访问的中间件，用于修改密码、两步验证等敏感操作，
+// 需要在 RequireAuth 之后使用
+func DenyImpersonation() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if err := services.DenyImpersonation(c.Request().Context()); err != nil {
+				return err
+			}
+			return next(c)
+		}
+	}
+}
+
+// RequireRecentAuth 要求当前会话在时间窗口内完成过认证的中间件，用于修改邮箱、关闭两步验证、
+// 注销账户等敏感操作，需要在 RequireAuth 之后使用。超出时间窗口时返回 CodeReauthRequired，
+// 客户端调用 /api/v1/me/reauthenticate 重新验证身份后重试
+func RequireRecentAuth(authService *services.AuthService) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			ctx := c.Request().Context()
+
+			token, _ := appctx.GetTokenFromContext(ctx)
+			if err := authService.CheckRecentAuth(ctx, token); err != nil {
+				return err
+			}
+			return next(c)
+		}
+	}
+}
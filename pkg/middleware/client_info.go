@@ -0,0 +1,21 @@
+package middleware
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/liukeshao/echo-template/pkg/appctx"
+)
+
+// ClientInfo 将客户端IP和User-Agent写入请求context，供服务层记录会话信息
+func ClientInfo() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			ctx := c.Request().Context()
+			ctx = appctx.WithClientIP(ctx, c.RealIP())
+			ctx = appctx.WithUserAgent(ctx, c.Request().UserAgent())
+
+			c.SetRequest(c.Request().WithContext(ctx))
+			return next(c)
+		}
+	}
+}
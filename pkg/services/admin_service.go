@@ -0,0 +1,83 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/liukeshao/echo-template/ent"
+	"github.com/liukeshao/echo-template/pkg/apperrs"
+	"github.com/liukeshao/echo-template/pkg/types"
+	"github.com/liukeshao/echo-template/pkg/utils"
+)
+
+// AdminService 管理员用户管理服务
+type AdminService struct {
+	orm       *ent.Client
+	auth      *AuthService
+	passwords *PasswordPolicy
+	hasher    utils.PasswordHasher
+}
+
+// NewAdminService 创建管理员用户管理服务
+func NewAdminService(orm *ent.Client, auth *AuthService, passwords *PasswordPolicy, hasher utils.PasswordHasher) *AdminService {
+	return &AdminService{
+		orm:       orm,
+		auth:      auth,
+		passwords: passwords,
+		hasher:    hasher,
+	}
+}
+
+// ResetPassword 管理员强制重置用户密码。账户可能已被盗用，无论是否配置保留会话都撤销用户的所有会话
+func (s *AdminService) ResetPassword(ctx context.Context, adminID string, input *types.AdminResetPasswordInput) error {
+	tx, err := s.orm.Tx(ctx)
+	if err != nil {
+		return apperrs.ErrDatabase.With("user_id", input.UserID).With("原始错误", err).Errorf("开启事务失败")
+	}
+	defer tx.Rollback()
+
+	u, err := tx.User.Get(ctx, input.UserID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return apperrs.ErrNotFound.With("user_id", input.UserID).Public("用户不存在").Errorf("用户不存在")
+		}
+		slog.ErrorContext(ctx, "查询用户失败", "error", err, "user_id", input.UserID)
+		return apperrs.ErrDatabase.With("user_id", input.UserID).With("原始错误", err).Errorf("查询用户失败")
+	}
+
+	if err := s.passwords.Check("NewPassword", input.NewPassword, u.Username, u.Email); err != nil {
+		return err
+	}
+	if err := s.passwords.CheckReuse(ctx, tx.PasswordHistory, "NewPassword", u, input.NewPassword); err != nil {
+		return err
+	}
+
+	passwordHash, err := s.hasher.Hash(input.NewPassword)
+	if err != nil {
+		slog.ErrorContext(ctx, "密码加密失败", "error", err)
+		return apperrs.ErrInternal.With("user_id", u.ID).With("原始错误", err).Errorf("密码加密失败")
+	}
+	if err := s.passwords.Remember(ctx, tx.PasswordHistory, u); err != nil {
+		return err
+	}
+
+	if err := tx.User.UpdateOne(u).SetPasswordHash(passwordHash).Exec(ctx); err != nil {
+		slog.ErrorContext(ctx, "更新密码失败", "error", err, "user_id", u.ID)
+		return apperrs.ErrDatabase.With("user_id", u.ID).With("原始错误", err).Errorf("更新密码失败")
+	}
+
+	// 管理员不持有用户的会话，不签发新的令牌
+	revoked, err := revokeUserTokens(ctx, tx.Token, u.ID)
+	if err != nil {
+		return err
+	}
+
+	s.auth.cache.InvalidateUserOnCommit(tx, u.ID)
+	if err := tx.Commit(); err != nil {
+		return apperrs.ErrDatabase.With("user_id", u.ID).With("原始错误", err).Errorf("提交事务失败")
+	}
+
+	s.auth.events.Record(ctx, u.ID, types.SecurityEventPasswordChanged, "管理员重置密码")
+	slog.InfoContext(ctx, "管理员已重置用户密码", "admin_id", adminID, "user_id", u.ID, "revoked_tokens", revoked)
+	return nil
+}
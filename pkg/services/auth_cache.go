@@ -0,0 +1,167 @@
+package services
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/liukeshao/echo-template/ent"
+)
+
+// DefaultAuthCacheSize 认证缓存默认容量
+const DefaultAuthCacheSize = 10000
+
+// authCacheEntry 缓存的认证结果
+type authCacheEntry struct {
+	key       string
+	user      *ent.User
+	token     *ent.Token
+	expiresAt time.Time
+}
+
+// AuthCache 已验证的access token到用户快照的内存缓存，按LRU淘汰。
+// 撤销令牌、修改密码或用户信息等操作必须调用 InvalidateUser 使缓存立即失效。
+// nil 表示不启用缓存，所有方法均可安全调用。
+type AuthCache struct {
+	ttl  time.Duration
+	size int
+
+	mu         sync.Mutex
+	entries    map[string]*list.Element       // 令牌哈希 -> 缓存项
+	byUser     map[string]map[string]struct{} // 用户ID -> 令牌哈希集合
+	lru        *list.List
+	generation uint64 // 每次失效递增，防止并发查询写回失效前的结果
+}
+
+// NewAuthCache 创建认证缓存，ttl 不大于0时返回 nil 表示禁用缓存
+func NewAuthCache(size int, ttl time.Duration) *AuthCache {
+	if ttl <= 0 {
+		return nil
+	}
+	if size <= 0 {
+		size = DefaultAuthCacheSize
+	}
+
+	return &AuthCache{
+		ttl:     ttl,
+		size:    size,
+		entries: make(map[string]*list.Element),
+		byUser:  make(map[string]map[string]struct{}),
+		lru:     list.New(),
+	}
+}
+
+// Generation 返回当前失效代数，查询数据库前获取并在 Set 时传回
+func (c *AuthCache) Generation() uint64 {
+	if c == nil {
+		return 0
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.generation
+}
+
+// Get 根据令牌哈希获取缓存的认证结果
+func (c *AuthCache) Get(tokenHash string) (*ent.User, *ent.Token, bool) {
+	if c == nil {
+		return nil, nil, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[tokenHash]
+	if !ok {
+		return nil, nil, false
+	}
+	entry := elem.Value.(*authCacheEntry)
+	if time.Now().After(entry.expiresAt) {
+		c.remove(elem)
+		return nil, nil, false
+	}
+
+	c.lru.MoveToFront(elem)
+	return entry.user, entry.token, true
+}
+
+// Set 缓存认证结果。generation 与当前代数不一致时说明查询期间发生过失效，结果不再缓存
+func (c *AuthCache) Set(tokenHash string, user *ent.User, token *ent.Token, generation uint64) {
+	if c == nil {
+		return
+	}
+
+	expiresAt := time.Now().Add(c.ttl)
+	if token.ExpiresAt.Before(expiresAt) {
+		expiresAt = token.ExpiresAt
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if generation != c.generation {
+		return
+	}
+	if elem, ok := c.entries[tokenHash]; ok {
+		c.remove(elem)
+	}
+
+	entry := &authCacheEntry{key: tokenHash, user: user, token: token, expiresAt: expiresAt}
+	c.entries[tokenHash] = c.lru.PushFront(entry)
+	if c.byUser[user.ID] == nil {
+		c.byUser[user.ID] = make(map[string]struct{})
+	}
+	c.byUser[user.ID][tokenHash] = struct{}{}
+
+	for c.lru.Len() > c.size {
+		c.remove(c.lru.Back())
+	}
+}
+
+// InvalidateUser 移除用户的所有缓存项
+func (c *AuthCache) InvalidateUser(userID string) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+	for tokenHash := range c.byUser[userID] {
+		if elem, ok := c.entries[tokenHash]; ok {
+			c.remove(elem)
+		}
+	}
+}
+
+// InvalidateUserOnCommit 在事务提交成功后移除用户的所有缓存项
+func (c *AuthCache) InvalidateUserOnCommit(tx *ent.Tx, userID string) {
+	if c == nil {
+		return
+	}
+
+	tx.OnCommit(func(next ent.Committer) ent.Committer {
+		return ent.CommitFunc(func(ctx context.Context, tx *ent.Tx) error {
+			if err := next.Commit(ctx, tx); err != nil {
+				return err
+			}
+			c.InvalidateUser(userID)
+			return nil
+		})
+	})
+}
+
+// remove 删除缓存项，调用方需持有锁
+func (c *AuthCache) remove(elem *list.Element) {
+	entry := c.lru.Remove(elem).(*authCacheEntry)
+	delete(c.entries, entry.key)
+
+	if tokens := c.byUser[entry.user.ID]; tokens != nil {
+		delete(tokens, entry.key)
+		if len(tokens) == 0 {
+			delete(c.byUser, entry.user.ID)
+		}
+	}
+}
@@ -2,62 +2,189 @@ package services
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
+	"math"
+	"slices"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/samber/oops"
-	"golang.org/x/crypto/bcrypt"
 
 	"github.com/liukeshao/echo-template/config"
 	"github.com/liukeshao/echo-template/ent"
+	"github.com/liukeshao/echo-template/ent/onetimetoken"
 	"github.com/liukeshao/echo-template/ent/token"
 	userEnt "github.com/liukeshao/echo-template/ent/user"
+	"github.com/liukeshao/echo-template/pkg/appctx"
 	"github.com/liukeshao/echo-template/pkg/apperrs"
 	"github.com/liukeshao/echo-template/pkg/types"
 	"github.com/liukeshao/echo-template/pkg/utils"
 )
 
-// 安全常量
-const (
-	MinPasswordLength = 8  // 最小密码长度
-	MaxTokensPerUser  = 10 // 每个用户最大token数量
-)
-
 // JWTConfig JWT配置结构
 type JWTConfig struct {
 	Secret             string
 	AccessTokenExpiry  time.Duration
 	RefreshTokenExpiry time.Duration
 	Issuer             string
+	TokenHashKey       string
+	Keys               *KeySet       // 签名与验证密钥，为空时使用 Secret 进行 HS256 签名
+	MaxSessionsPerUser int           // 每个用户最多同时存在的会话数，0表示不限制
+	IdleTimeout        time.Duration // 会话空闲超时，0表示不限制
+	RecentAuthWindow   time.Duration // 敏感操作要求的最近认证时间窗口，为0时使用默认值
 }
 
 // NewJWTConfigFromConfig 从应用配置创建JWT配置
-func NewJWTConfigFromConfig(cfg config.JWTConfig) JWTConfig {
+func NewJWTConfigFromConfig(cfg config.JWTConfig) (JWTConfig, error) {
+	keys, err := NewKeySetFromConfig(cfg)
+	if err != nil {
+		return JWTConfig{}, err
+	}
+	if tokenHashKey(cfg) == "" {
+		return JWTConfig{}, fmt.Errorf("jwt: 未配置 secret 时必须配置 tokenHashKey")
+	}
+
 	return JWTConfig{
 		Secret:             cfg.Secret,
 		AccessTokenExpiry:  cfg.AccessTokenExpiry,
 		RefreshTokenExpiry: cfg.RefreshTokenExpiry,
 		Issuer:             cfg.Issuer,
+		TokenHashKey:       tokenHashKey(cfg),
+		Keys:               keys,
+		MaxSessionsPerUser: cfg.MaxSessionsPerUser,
+		IdleTimeout:        cfg.IdleTimeout,
+		RecentAuthWindow:   cfg.RecentAuthWindow,
+	}, nil
+}
+
+// tokenHashKey 返回令牌哈希密钥，未单独配置时使用JWT签名密钥
+func tokenHashKey(cfg config.JWTConfig) string {
+	if cfg.TokenHashKey != "" {
+		return cfg.TokenHashKey
 	}
+	return cfg.Secret
+}
+
+// tokenGrant 令牌的授权信息，第一方登录签发的令牌为零值
+type tokenGrant struct {
+	ClientID string        // 获得授权的OAuth客户端ID
+	Scope    string        // 授权范围，以空格分隔
+	Actor    string        // 代为登录的管理员ID
+	Expiry   time.Duration // 非零时代替配置的令牌有效期，只能缩短
+	AuthTime time.Time     // 用户最近一次完成认证的时间，OAuth授权和代为登录签发的令牌为零值
 }
 
 // AuthService 认证服务
 type AuthService struct {
-	orm       *ent.Client
-	jwtConfig JWTConfig
+	orm            *ent.Client
+	jwtConfig      JWTConfig
+	usage          *TokenUsageRecorder
+	cache          *AuthCache
+	loginGuard     *LoginGuard
+	passwords      *PasswordPolicy
+	hasher         utils.PasswordHasher
+	dummyHash      func() string
+	verification   *VerificationService
+	twoFactor      *TwoFactorService
+	personalTokens *PersonalTokenService
+	cookies        *SessionCookies
+	events         *SecurityEventService
+
+	keepSessionsOnPasswordChange bool
 }
 
 // NewAuthService 创建认证服务
 func NewAuthService(orm *ent.Client, jwtConfig JWTConfig) *AuthService {
-	return &AuthService{
-		orm:       orm,
-		jwtConfig: jwtConfig,
+	if jwtConfig.Keys == nil {
+		jwtConfig.Keys = NewHMACKeySet(jwtConfig.Secret)
 	}
+
+	s := &AuthService{
+		orm:        orm,
+		jwtConfig:  jwtConfig,
+		loginGuard: NewLoginGuard(config.LoginProtectionConfig{}),
+	}
+	s.SetPasswordHasher(utils.NewArgon2idHasher(utils.DefaultArgon2idParams, ""))
+	s.SetPasswordPolicy(NewPasswordPolicy(config.PasswordPolicyConfig{}, nil, s.hasher))
+	return s
+}
+
+// SetPasswordHasher 设置密码哈希算法，未设置时使用默认参数的 Argon2id
+func (s *AuthService) SetPasswordHasher(hasher utils.PasswordHasher) {
+	s.hasher = hasher
+	// 邮箱不存在时使用同一算法的哈希比较，使响应时间与密码错误时一致
+	s.dummyHash = sync.OnceValue(func() string {
+		hash, _ := hasher.Hash("echo-template-dummy-password")
+		return hash
+	})
 }
 
-// generateToken 生成JWT token（通用方法）
-func (s *AuthService) generateToken(ctx context.Context, userID string, tokenType string) (string, time.Time, error) {
+// SetPasswordPolicy 设置密码策略，未设置时只要求默认的最小长度
+func (s *AuthService) SetPasswordPolicy(passwords *PasswordPolicy) {
+	s.passwords = passwords
+}
+
+// SetVerificationService 设置邮箱验证服务，未设置时注册后直接激活账户
+func (s *AuthService) SetVerificationService(verification *VerificationService) {
+	s.verification = verification
+}
+
+// SetTwoFactorService 设置两步验证服务，开启两步验证的用户登录时需要额外校验验证码
+func (s *AuthService) SetTwoFactorService(twoFactor *TwoFactorService) {
+	s.twoFactor = twoFactor
+}
+
+// SetPersonalTokenService 设置个人访问令牌服务，未设置时不接受个人访问令牌
+func (s *AuthService) SetPersonalTokenService(personalTokens *PersonalTokenService) {
+	s.personalTokens = personalTokens
+}
+
+// SetSessionCookies 设置浏览器 Cookie 会话模式，未设置时只接受 Authorization 请求头中的令牌
+func (s *AuthService) SetSessionCookies(cookies *SessionCookies) {
+	s.cookies = cookies
+}
+
+// SessionCookies 返回浏览器 Cookie 会话模式，未设置时返回 nil
+func (s *AuthService) SessionCookies() *SessionCookies {
+	return s.cookies
+}
+
+// SetSecurityEvents 设置安全事件日志
+func (s *AuthService) SetSecurityEvents(events *SecurityEventService) {
+	s.events = events
+}
+
+// SetKeepSessionsOnPasswordChange 设置修改密码后是否保留用户的其他会话，默认全部撤销
+func (s *AuthService) SetKeepSessionsOnPasswordChange(keep bool) {
+	s.keepSessionsOnPasswordChange = keep
+}
+
+// SetLoginGuard 设置登录防暴力破解保护
+func (s *AuthService) SetLoginGuard(guard *LoginGuard) {
+	s.loginGuard = guard
+}
+
+// SetTokenUsageRecorder 设置令牌使用时间写入器，未设置时不记录令牌使用时间
+func (s *AuthService) SetTokenUsageRecorder(usage *TokenUsageRecorder) {
+	s.usage = usage
+}
+
+// SetAuthCache 设置认证缓存，未设置时每次认证都查询数据库
+func (s *AuthService) SetAuthCache(cache *AuthCache) {
+	s.cache = cache
+}
+
+// hashToken 计算令牌在数据库中存储和查找使用的哈希值
+func (s *AuthService) hashToken(tokenString string) string {
+	return utils.HashToken(s.jwtConfig.TokenHashKey, tokenString)
+}
+
+// generateToken 生成JWT token（通用方法），tokenID 作为 jti 写入声明并与数据库记录的主键一致。
+// 客户端凭据模式签发的令牌没有用户，以客户端ID作为 sub
+func (s *AuthService) generateToken(ctx context.Context, tokenID string, userID string, tokenType string, grant tokenGrant) (string, time.Time, error) {
 	var expiry time.Duration
 	switch tokenType {
 	case types.TokenTypeAccess:
@@ -67,24 +194,38 @@ func (s *AuthService) generateToken(ctx context.Context, userID string, tokenTyp
 	default:
 		return "", time.Time{}, apperrs.ErrBadRequest.With("token_type", tokenType).Errorf("无效的令牌类型")
 	}
+	if grant.Expiry > 0 {
+		expiry = min(expiry, grant.Expiry)
+	}
 
 	now := time.Now()
 	expirationTime := now.Add(expiry)
 
+	subject := userID
+	if subject == "" {
+		subject = grant.ClientID
+	}
+
 	claims := &types.JWTClaims{
 		UserID:    userID,
 		TokenType: tokenType,
+		ClientID:  grant.ClientID,
+		Scope:     grant.Scope,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(expirationTime),
 			IssuedAt:  jwt.NewNumericDate(now),
 			NotBefore: jwt.NewNumericDate(now),
 			Issuer:    s.jwtConfig.Issuer,
-			Subject:   userID,
+			Subject:   subject,
+			ID:        tokenID,
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString([]byte(s.jwtConfig.Secret))
+	if grant.Actor != "" {
+		claims.Actor = &types.Actor{Subject: grant.Actor}
+	}
+
+	tokenString, err := s.jwtConfig.Keys.Sign(claims)
 	if err != nil {
 		return "", time.Time{}, apperrs.ErrInternal.With("token_type", tokenType).With("user_id", userID).With("原始错误", err).Errorf("生成%s令牌失败", tokenType)
 	}
@@ -92,16 +233,14 @@ func (s *AuthService) generateToken(ctx context.Context, userID string, tokenTyp
 	return tokenString, expirationTime, nil
 }
 
-// ValidateToken 验证JWT token
-func (s *AuthService) ValidateToken(tokenString string) (*types.JWTClaims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &types.JWTClaims{}, func(token *jwt.Token) (interface{}, error) {
-		// 检查签名方法
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, apperrs.ErrUnauthorized.Errorf("无效的签名方法")
-		}
-		return []byte(s.jwtConfig.Secret), nil
-	})
+// JWKS 返回用于离线验证令牌的公钥集合
+func (s *AuthService) JWKS() *types.JWKSOutput {
+	return s.jwtConfig.Keys.JWKS()
+}
 
+// parseToken 解析JWT并校验签名（签名密钥由 kid 选择）
+func (s *AuthService) parseToken(tokenString string) (*types.JWTClaims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &types.JWTClaims{}, s.jwtConfig.Keys.Keyfunc)
 	if err != nil {
 		return nil, apperrs.ErrUnauthorized.With("原始错误", err).Errorf("JWT 解析失败")
 	}
@@ -111,6 +250,16 @@ func (s *AuthService) ValidateToken(tokenString string) (*types.JWTClaims, error
 		return nil, apperrs.ErrUnauthorized.Errorf("无效的token")
 	}
 
+	return claims, nil
+}
+
+// ValidateToken 验证JWT token
+func (s *AuthService) ValidateToken(tokenString string) (*types.JWTClaims, error) {
+	claims, err := s.parseToken(tokenString)
+	if err != nil {
+		return nil, err
+	}
+
 	// 验证token安全性
 	if err := s.validateTokenComplete(context.Background(), claims, types.TokenTypeAccess); err != nil {
 		return nil, err
@@ -125,6 +274,14 @@ func (s *AuthService) validateUser(ctx context.Context, user *ent.User) error {
 		return apperrs.ErrUnauthorized.Errorf("用户不存在")
 	}
 
+	if user.Status == userEnt.StatusInactive {
+		return apperrs.ErrForbidden.
+			With("status", user.Status).
+			With("user_id", user.ID).
+			Public("邮箱尚未验证").
+			Errorf("邮箱尚未验证，请先完成邮箱验证")
+	}
+
 	if user.Status != userEnt.StatusActive {
 		return apperrs.ErrForbidden.
 			With("status", user.Status).
@@ -134,7 +291,7 @@ func (s *AuthService) validateUser(ctx context.Context, user *ent.User) error {
 	return nil
 }
 
-// findUserByEmail 根据邮箱查找用户并验证状态
+// findUserByEmail 根据邮箱查找用户，用户不存在时返回 nil
 func (s *AuthService) findUserByEmail(ctx context.Context, email string) (*ent.User, error) {
 	user, err := s.orm.User.Query().
 		Where(userEnt.Email(email)).
@@ -143,7 +300,7 @@ func (s *AuthService) findUserByEmail(ctx context.Context, email string) (*ent.U
 	switch {
 	// 如果实体不满足特定条件，操作将返回 "ent.NotFoundError"
 	case ent.IsNotFound(err):
-		return nil, apperrs.ErrUnauthorized.With("email", email).Errorf("邮箱不存在")
+		return nil, nil
 	// 任何其他错误
 	case err != nil:
 		slog.ErrorContext(ctx, "查询用户失败", "error", err, "email", email)
@@ -218,7 +375,7 @@ func (s *AuthService) findValidToken(ctx context.Context, tokenString string, to
 
 	dbToken, err := s.orm.Token.Query().
 		Where(
-			token.Token(tokenString),
+			token.TokenHash(s.hashToken(tokenString)),
 			token.IsRevoked(false),
 			token.TypeEQ(dbTokenType),
 		).
@@ -236,8 +393,10 @@ func (s *AuthService) findValidToken(ctx context.Context, tokenString string, to
 	return dbToken, nil
 }
 
-// updateLastLoginTime 更新用户最后登录时间
+// updateLastLoginTime 更新用户最后登录时间并记录登录成功事件，所有登录方式成功签发令牌后调用
 func (s *AuthService) updateLastLoginTime(ctx context.Context, user *ent.User) (*ent.User, error) {
+	s.events.Record(ctx, user.ID, types.SecurityEventLoginSucceeded, "")
+
 	now := time.Now()
 	updatedUser, err := s.orm.User.UpdateOne(user).
 		SetLastLoginAt(now).
@@ -256,7 +415,7 @@ func (s *AuthService) updateLastLoginTime(ctx context.Context, user *ent.User) (
 }
 
 // saveTokenToDatabase 保存token到数据库
-func (s *AuthService) saveTokenToDatabase(ctx context.Context, tx *ent.Tx, userID string, tokenString string, tokenType string, expiresAt time.Time) error {
+func (s *AuthService) saveTokenToDatabase(ctx context.Context, tx *ent.Tx, tokenID string, userID string, familyID string, tokenString string, tokenType string, expiresAt time.Time, grant tokenGrant) error {
 	var dbTokenType token.Type
 	switch tokenType {
 	case types.TokenTypeAccess:
@@ -267,13 +426,29 @@ func (s *AuthService) saveTokenToDatabase(ctx context.Context, tx *ent.Tx, userI
 		return oops.Errorf("无效的令牌类型")
 	}
 
-	_, err := tx.Token.Create().
-		SetToken(tokenString).
-		SetUserID(userID).
+	create := tx.Token.Create().
+		SetID(tokenID).
+		SetTokenHash(s.hashToken(tokenString)).
+		SetFamilyID(familyID).
 		SetType(dbTokenType).
 		SetExpiresAt(expiresAt).
 		SetIsRevoked(false).
-		Save(ctx)
+		SetUserAgent(utils.Truncate(appctx.MustGetUserAgentFromContext(ctx), 512)).
+		SetIP(utils.Truncate(appctx.MustGetClientIPFromContext(ctx), 64))
+	if userID != "" {
+		create.SetUserID(userID)
+	}
+	if grant.ClientID != "" {
+		create.SetClientID(grant.ClientID).SetScope(grant.Scope)
+	}
+	if grant.Actor != "" {
+		create.SetImpersonatorID(grant.Actor)
+	}
+	if !grant.AuthTime.IsZero() {
+		create.SetAuthTime(grant.AuthTime)
+	}
+
+	_, err := create.Save(ctx)
 
 	if err != nil {
 		return apperrs.ErrDatabase.With("token_type", tokenType).With("user_id", userID).With("原始错误", err).Errorf("保存%s令牌失败", tokenType)
@@ -282,7 +457,7 @@ func (s *AuthService) saveTokenToDatabase(ctx context.Context, tx *ent.Tx, userI
 	return nil
 }
 
-// generateTokenPair 生成一对token（access + refresh）
+// generateTokenPair 生成一对token（access + refresh），开启一个新的令牌族
 func (s *AuthService) generateTokenPair(ctx context.Context, userID string) (*types.AuthOutput, error) {
 	// 开始数据库事务
 	tx, err := s.orm.Tx(ctx)
@@ -290,37 +465,99 @@ func (s *AuthService) generateTokenPair(ctx context.Context, userID string) (*ty
 		return nil, apperrs.ErrDatabase.With("user_id", userID).With("原始错误", err).Errorf("开启事务失败")
 	}
 
-	// 生成access token
-	accessToken, accessExpiry, err := s.generateToken(ctx, userID, types.TokenTypeAccess)
+	authOutput, err := s.issueTokenPair(ctx, tx, userID, utils.GenerateULID())
 	if err != nil {
 		tx.Rollback()
 		return nil, err
 	}
 
+	// 提交事务
+	if err := tx.Commit(); err != nil {
+		return nil, apperrs.ErrDatabase.With("user_id", userID).With("原始错误", err).Errorf("提交事务失败")
+	}
+
+	return authOutput, nil
+}
+
+// enforceSessionLimit 确保签发后用户的会话数不超过上限，超出时撤销最久未使用的其他会话
+func (s *AuthService) enforceSessionLimit(ctx context.Context, tx *ent.Tx, userID string, familyID string) error {
+	limit := s.jwtConfig.MaxSessionsPerUser
+	if limit <= 0 {
+		return nil
+	}
+
+	sessions, err := activeSessions(ctx, tx.Token, userID)
+	if err != nil {
+		slog.ErrorContext(ctx, "查询会话失败", "error", err, "user_id", userID)
+		return apperrs.ErrDatabase.With("user_id", userID).With("原始错误", err).Errorf("查询会话失败")
+	}
+
+	// 当前令牌族占用一个名额，其余会话按最后活跃时间倒序排列
+	others := make([]*types.SessionInfo, 0, len(sessions))
+	for _, session := range sessions {
+		if session.ID != familyID {
+			others = append(others, session)
+		}
+	}
+	if len(others) < limit {
+		return nil
+	}
+
+	s.cache.InvalidateUserOnCommit(tx, userID)
+	for _, session := range others[limit-1:] {
+		if _, err := revokeTokenFamily(ctx, tx.Token, session.ID); err != nil {
+			return err
+		}
+		slog.InfoContext(ctx, "会话数超出上限，撤销最久未使用的会话",
+			"user_id", userID,
+			"session_id", session.ID,
+			"last_used_at", session.LastUsedAt,
+			"limit", limit,
+		)
+	}
+
+	return nil
+}
+
+// issueTokenPair 在给定事务中签发属于指定令牌族的一对token，用户刚刚完成认证
+func (s *AuthService) issueTokenPair(ctx context.Context, tx *ent.Tx, userID string, familyID string) (*types.AuthOutput, error) {
+	return s.issueGrantTokenPair(ctx, tx, userID, familyID, tokenGrant{AuthTime: time.Now()})
+}
+
+// issueGrantTokenPair 在给定事务中签发带有授权信息的一对token，OAuth授权签发的令牌不计入用户的会话数
+func (s *AuthService) issueGrantTokenPair(ctx context.Context, tx *ent.Tx, userID string, familyID string, grant tokenGrant) (*types.AuthOutput, error) {
+	// 限制用户的并发会话数
+	if grant.ClientID == "" {
+		if err := s.enforceSessionLimit(ctx, tx, userID, familyID); err != nil {
+			return nil, err
+		}
+	}
+
+	accessTokenID := utils.GenerateULID()
+	refreshTokenID := utils.GenerateULID()
+
+	// 生成access token
+	accessToken, accessExpiry, err := s.generateToken(ctx, accessTokenID, userID, types.TokenTypeAccess, grant)
+	if err != nil {
+		return nil, err
+	}
+
 	// 生成refresh token
-	refreshToken, refreshExpiry, err := s.generateToken(ctx, userID, types.TokenTypeRefresh)
+	refreshToken, refreshExpiry, err := s.generateToken(ctx, refreshTokenID, userID, types.TokenTypeRefresh, grant)
 	if err != nil {
-		tx.Rollback()
 		return nil, err
 	}
 
 	// 保存access token到数据库
-	if err := s.saveTokenToDatabase(ctx, tx, userID, accessToken, types.TokenTypeAccess, accessExpiry); err != nil {
-		tx.Rollback()
+	if err := s.saveTokenToDatabase(ctx, tx, accessTokenID, userID, familyID, accessToken, types.TokenTypeAccess, accessExpiry, grant); err != nil {
 		return nil, apperrs.ErrDatabase.With("user_id", userID).With("token_type", types.TokenTypeAccess).With("原始错误", err).Errorf("保存访问令牌失败")
 	}
 
 	// 保存refresh token到数据库
-	if err := s.saveTokenToDatabase(ctx, tx, userID, refreshToken, types.TokenTypeRefresh, refreshExpiry); err != nil {
-		tx.Rollback()
+	if err := s.saveTokenToDatabase(ctx, tx, refreshTokenID, userID, familyID, refreshToken, types.TokenTypeRefresh, refreshExpiry, grant); err != nil {
 		return nil, apperrs.ErrDatabase.With("user_id", userID).With("token_type", types.TokenTypeRefresh).With("原始错误", err).Errorf("保存刷新令牌失败")
 	}
 
-	// 提交事务
-	if err := tx.Commit(); err != nil {
-		return nil, apperrs.ErrDatabase.With("user_id", userID).With("原始错误", err).Errorf("提交事务失败")
-	}
-
 	return &types.AuthOutput{
 		AccessToken:  accessToken,
 		RefreshToken: refreshToken,
@@ -328,18 +565,10 @@ func (s *AuthService) generateTokenPair(ctx context.Context, userID string) (*ty
 	}, nil
 }
 
-// validatePassword 验证密码强度
-func (s *AuthService) validatePassword(ctx context.Context, password string) error {
-	if len(password) < MinPasswordLength {
-		return apperrs.ErrBadRequest.Errorf("密码长度至少为%d位", MinPasswordLength)
-	}
-	return nil
-}
-
 // Register 用户注册
 func (s *AuthService) Register(ctx context.Context, input *types.RegisterInput) (*types.AuthOutput, error) {
-	// 密码强度验证
-	if err := s.validatePassword(ctx, input.Password); err != nil {
+	// 密码策略验证
+	if err := s.passwords.Check("Password", input.Password, input.Username, input.Email); err != nil {
 		return nil, err
 	}
 
@@ -372,7 +601,7 @@ func (s *AuthService) Register(ctx context.Context, input *types.RegisterInput)
 	}
 
 	// 加密密码
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(input.Password), bcrypt.DefaultCost)
+	hashedPassword, err := s.hasher.Hash(input.Password)
 	if err != nil {
 		slog.ErrorContext(ctx, "密码加密失败", "error", err)
 		return nil, apperrs.ErrInternal.With("username", input.Username).With("email", input.Email).With("原始错误", err).Errorf("密码加密失败")
@@ -381,19 +610,42 @@ func (s *AuthService) Register(ctx context.Context, input *types.RegisterInput)
 	// 生成用户ID
 	userID := utils.GenerateULID()
 
+	// 开启邮箱验证时新用户需验证邮箱后才能登录
+	status := userEnt.StatusActive
+	if s.verification.Enabled() {
+		status = userEnt.StatusInactive
+	}
+
 	// 创建用户
 	user, err := s.orm.User.Create().
 		SetID(userID).
 		SetUsername(input.Username).
 		SetEmail(input.Email).
-		SetPasswordHash(string(hashedPassword)).
-		SetStatus(userEnt.StatusActive).
+		SetPasswordHash(hashedPassword).
+		SetStatus(status).
 		Save(ctx)
 	if err != nil {
 		slog.ErrorContext(ctx, "创建用户失败", "error", err, "user_id", userID)
 		return nil, apperrs.ErrDatabase.With("user_id", userID).With("username", input.Username).With("email", input.Email).With("原始错误", err).Errorf("创建用户失败")
 	}
 
+	// 待验证的用户不签发令牌，发送失败时用户可以重新发送验证邮件
+	if status == userEnt.StatusInactive {
+		if err := s.verification.SendVerification(ctx, user); err != nil {
+			slog.WarnContext(ctx, "注册时发送验证邮件失败", "error", err, "user_id", user.ID)
+		}
+		return &types.AuthOutput{
+			User: &types.UserInfo{
+				ID:        user.ID,
+				Username:  user.Username,
+				Email:     user.Email,
+				Status:    string(user.Status),
+				CreatedAt: user.CreatedAt,
+			},
+			VerificationRequired: true,
+		}, nil
+	}
+
 	// 生成token对
 	authOutput, err := s.generateTokenPair(ctx, user.ID)
 	if err != nil {
@@ -412,23 +664,59 @@ func (s *AuthService) Register(ctx context.Context, input *types.RegisterInput)
 
 // Login 用户登录
 func (s *AuthService) Login(ctx context.Context, input *types.LoginInput) (*types.AuthOutput, error) {
+	ip := appctx.MustGetClientIPFromContext(ctx)
+
+	// 账户或IP处于退避或锁定期时直接拒绝
+	if wait := s.loginGuard.Check(input.Email, ip); wait > 0 {
+		retryAfter := int(math.Ceil(wait.Seconds()))
+		slog.WarnContext(ctx, "登录尝试过于频繁", "email", input.Email, "ip", ip, "retry_after", retryAfter)
+		return nil, apperrs.ErrTooManyRequests.
+			With("email", input.Email).
+			With("ip", ip).
+			With("retry_after", retryAfter).
+			Public(fmt.Sprintf("登录尝试过于频繁，请%d秒后重试", retryAfter)).
+			Errorf("登录尝试过于频繁")
+	}
+
 	// 查找用户
 	user, err := s.findUserByEmail(ctx, input.Email)
 	if err != nil {
 		return nil, err
 	}
 
-	// 验证用户状态
+	// 验证密码，邮箱不存在时同样执行一次密码比较，不通过响应内容或耗时暴露账户是否存在
+	passwordHash := s.dummyHash()
+	if user != nil {
+		passwordHash = user.PasswordHash
+	}
+	ok, rehash, err := s.hasher.Verify(passwordHash, input.Password)
+	if err != nil {
+		slog.ErrorContext(ctx, "校验密码哈希失败", "error", err, "email", input.Email)
+	}
+	if user == nil || !ok {
+		s.loginGuard.RecordFailure(input.Email, ip)
+		slog.WarnContext(ctx, "登录失败", "email", input.Email, "ip", ip)
+		if user != nil {
+			s.events.Record(ctx, user.ID, types.SecurityEventLoginFailed, "密码错误")
+		}
+		return nil, apperrs.ErrUnauthorized.With("email", input.Email).Errorf("邮箱或密码错误")
+	}
+
+	// 验证用户状态，仅在密码正确后提示，避免泄露账户信息
 	if err := s.validateUser(ctx, user); err != nil {
 		return nil, err
 	}
 
-	// 验证密码
-	err = bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(input.Password))
-	if err != nil {
-		slog.WarnContext(ctx, "密码验证失败", "user_id", user.ID, "email", input.Email)
-		return nil, apperrs.ErrUnauthorized.With("user_id", user.ID).With("email", input.Email).Errorf("邮箱或密码错误")
+	// 旧算法或旧参数生成的哈希在密码校验通过后按当前配置重新生成
+	if rehash {
+		s.rehashPassword(ctx, user, input.Password)
+	}
+
+	// 开启两步验证的用户只返回挑战令牌，失败计数在完成两步验证后才清除
+	if user.TwoFactorEnabled {
+		return s.issueMFAChallenge(ctx, user)
 	}
+	s.loginGuard.RecordSuccess(input.Email)
 
 	// 生成token对
 	authOutput, err := s.generateTokenPair(ctx, user.ID)
@@ -446,61 +734,305 @@ func (s *AuthService) Login(ctx context.Context, input *types.LoginInput) (*type
 	return authOutput, nil
 }
 
-// RefreshToken 刷新令牌
-func (s *AuthService) RefreshToken(ctx context.Context, input *types.RefreshTokenInput) (*types.AuthOutput, error) {
-	// 验证refresh token
-	claims, err := jwt.ParseWithClaims(input.RefreshToken, &types.JWTClaims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, apperrs.ErrUnauthorized.Errorf("无效的签名方法")
-		}
-		return []byte(s.jwtConfig.Secret), nil
-	})
+// rehashPassword 按当前算法和参数重新生成用户的密码哈希，失败时不影响登录
+func (s *AuthService) rehashPassword(ctx context.Context, user *ent.User, password string) {
+	hash, err := s.hasher.Hash(password)
+	if err != nil {
+		slog.WarnContext(ctx, "重新生成密码哈希失败", "error", err, "user_id", user.ID)
+		return
+	}
 
+	// 仅在密码未被同时修改时更新
+	updated, err := s.orm.User.Update().
+		Where(userEnt.ID(user.ID), userEnt.PasswordHash(user.PasswordHash)).
+		SetPasswordHash(hash).
+		Save(ctx)
 	if err != nil {
-		return nil, apperrs.ErrUnauthorized.With("原始错误", err).Errorf("refresh token 解析失败")
+		slog.WarnContext(ctx, "更新密码哈希失败", "error", err, "user_id", user.ID)
+		return
+	}
+	if updated > 0 {
+		user.PasswordHash = hash
+		s.cache.InvalidateUser(user.ID)
+		slog.InfoContext(ctx, "已升级密码哈希", "user_id", user.ID)
 	}
+}
 
-	jwtClaims, ok := claims.Claims.(*types.JWTClaims)
-	if !ok || !claims.Valid {
-		return nil, apperrs.ErrUnauthorized.Errorf("无效的refresh token")
+// issueMFAChallenge 签发两步验证挑战令牌
+func (s *AuthService) issueMFAChallenge(ctx context.Context, user *ent.User) (*types.AuthOutput, error) {
+	raw, err := issueOneTimeToken(ctx, s.orm.OneTimeToken, s.jwtConfig.TokenHashKey, user.ID, onetimetoken.PurposeMfaChallenge, MFAChallengeExpiry)
+	if err != nil {
+		return nil, err
 	}
 
-	// 验证token完整性
-	if err := s.validateTokenComplete(ctx, jwtClaims, types.TokenTypeRefresh); err != nil {
+	slog.InfoContext(ctx, "密码验证通过，等待两步验证", "user_id", user.ID)
+	return &types.AuthOutput{
+		MFARequired:  true,
+		MFAToken:     raw,
+		MFAExpiresAt: time.Now().Add(MFAChallengeExpiry).Unix(),
+	}, nil
+}
+
+// LoginTwoFactor 使用挑战令牌和验证码完成两步验证登录
+func (s *AuthService) LoginTwoFactor(ctx context.Context, input *types.LoginTwoFactorInput) (*types.AuthOutput, error) {
+	ip := appctx.MustGetClientIPFromContext(ctx)
+
+	challenge, err := findOneTimeToken(ctx, s.orm.OneTimeToken, s.jwtConfig.TokenHashKey, input.MFAToken, onetimetoken.PurposeMfaChallenge)
+	if err != nil {
 		return nil, err
 	}
+	if challenge == nil || s.twoFactor == nil {
+		return nil, apperrs.ErrUnauthorized.Public("两步验证已过期，请重新登录").Errorf("两步验证挑战令牌无效")
+	}
 
-	// 验证数据库中的token记录
-	_, err = s.findValidToken(ctx, input.RefreshToken, types.TokenTypeRefresh)
+	user, err := s.findUserByID(ctx, challenge.UserID)
 	if err != nil {
 		return nil, err
 	}
+	if err := s.validateUser(ctx, user); err != nil {
+		return nil, err
+	}
 
-	// 验证用户状态
-	user, err := s.findUserByID(ctx, jwtClaims.UserID)
+	// 验证码与密码共用失败计数，防止暴力破解验证码
+	if wait := s.loginGuard.Check(user.Email, ip); wait > 0 {
+		retryAfter := int(math.Ceil(wait.Seconds()))
+		slog.WarnContext(ctx, "两步验证尝试过于频繁", "user_id", user.ID, "ip", ip, "retry_after", retryAfter)
+		return nil, apperrs.ErrTooManyRequests.
+			With("user_id", user.ID).
+			With("ip", ip).
+			With("retry_after", retryAfter).
+			Public(fmt.Sprintf("登录尝试过于频繁，请%d秒后重试", retryAfter)).
+			Errorf("两步验证尝试过于频繁")
+	}
+
+	tx, err := s.orm.Tx(ctx)
+	if err != nil {
+		return nil, apperrs.ErrDatabase.With("user_id", user.ID).With("原始错误", err).Errorf("开启事务失败")
+	}
+	defer tx.Rollback()
+
+	ok, err := s.twoFactor.VerifyCode(ctx, tx, user, input.Code)
 	if err != nil {
 		return nil, err
 	}
+	if !ok {
+		tx.Rollback()
+		s.loginGuard.RecordFailure(user.Email, ip)
+		slog.WarnContext(ctx, "两步验证失败", "user_id", user.ID, "ip", ip)
+		s.events.Record(ctx, user.ID, types.SecurityEventLoginFailed, "两步验证码错误")
+		return nil, apperrs.ErrUnauthorized.With("user_id", user.ID).Public("验证码错误").Errorf("验证码错误")
+	}
 
-	if err := s.validateUser(ctx, user); err != nil {
+	used, err := useOneTimeToken(ctx, tx.OneTimeToken, challenge)
+	if err != nil {
 		return nil, err
 	}
+	if !used {
+		return nil, apperrs.ErrUnauthorized.With("user_id", user.ID).Public("两步验证已过期，请重新登录").Errorf("两步验证挑战令牌已使用")
+	}
 
-	// 生成新的token对
-	authOutput, err := s.generateTokenPair(ctx, user.ID)
+	authOutput, err := s.issueTokenPair(ctx, tx, user.ID, utils.GenerateULID())
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, apperrs.ErrDatabase.With("user_id", user.ID).With("原始错误", err).Errorf("提交事务失败")
+	}
+	s.loginGuard.RecordSuccess(user.Email)
+
+	// 更新最后登录时间
+	if _, err := s.updateLastLoginTime(ctx, user); err != nil {
+		slog.WarnContext(ctx, "更新最后登录时间失败", "error", err, "user_id", user.ID)
+	}
+
+	return authOutput, nil
+}
+
+// RefreshToken 刷新令牌
+func (s *AuthService) RefreshToken(ctx context.Context, input *types.RefreshTokenInput) (*types.AuthOutput, error) {
+	// 验证refresh token
+	jwtClaims, err := s.parseToken(input.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// 验证token完整性
+	if err := s.validateTokenComplete(ctx, jwtClaims, types.TokenTypeRefresh); err != nil {
+		return nil, err
+	}
+
+	// 在同一事务中完成校验、轮换和签发，保证一个refresh token只能被使用一次
+	tx, err := s.orm.Tx(ctx)
+	if err != nil {
+		return nil, apperrs.ErrDatabase.With("user_id", jwtClaims.UserID).With("原始错误", err).Errorf("开启事务失败")
+	}
+
+	authOutput, err := s.rotateRefreshToken(ctx, tx, input.RefreshToken, jwtClaims, "")
 	if err != nil {
+		tx.Rollback()
 		return nil, err
 	}
 
-	// 撤销旧的refresh token
-	if err := s.revokeToken(ctx, input.RefreshToken, types.TokenTypeRefresh); err != nil {
-		// 非致命错误，记录日志
-		slog.WarnContext(ctx, "撤销旧refresh token失败", "error", err, "user_id", user.ID)
+	// 提交事务
+	if err := tx.Commit(); err != nil {
+		return nil, apperrs.ErrDatabase.With("user_id", jwtClaims.UserID).With("原始错误", err).Errorf("提交事务失败")
 	}
+	s.events.Record(ctx, jwtClaims.UserID, types.SecurityEventTokenRefreshed, "")
 
 	return authOutput, nil
 }
 
+// rotateRefreshToken 轮换refresh token：作废旧令牌并在同一令牌族中签发新的令牌对。
+// 已被轮换过的refresh token再次出现时视为令牌被盗用，整个令牌族都会被撤销。
+// clientID 为使用该令牌的OAuth客户端，第一方刷新时为空，令牌只能由签发时的客户端使用。
+func (s *AuthService) rotateRefreshToken(ctx context.Context, tx *ent.Tx, tokenString string, claims *types.JWTClaims, clientID string) (*types.AuthOutput, error) {
+	dbToken, err := tx.Token.Query().
+		Where(
+			token.TokenHash(s.hashToken(tokenString)),
+			token.TypeEQ(token.TypeRefresh),
+		).
+		Only(ctx)
+
+	switch {
+	case ent.IsNotFound(err):
+		return nil, apperrs.ErrUnauthorized.With("user_id", claims.UserID).Errorf("refresh令牌不存在或已撤销")
+	case err != nil:
+		slog.ErrorContext(ctx, "查询token失败", "error", err, "type", types.TokenTypeRefresh)
+		return nil, apperrs.ErrDatabase.With("user_id", claims.UserID).With("原始错误", err).Errorf("查询令牌失败")
+	}
+
+	if dbToken.ClientID != clientID {
+		return nil, apperrs.ErrUnauthorized.With("user_id", dbToken.UserID).With("client_id", clientID).Errorf("refresh令牌不存在或已撤销")
+	}
+
+	if dbToken.IsRevoked {
+		// 未被轮换的撤销令牌（如已登出）直接拒绝
+		if dbToken.RotatedAt == nil || dbToken.FamilyID == "" {
+			return nil, apperrs.ErrUnauthorized.With("user_id", dbToken.UserID).Errorf("refresh令牌不存在或已撤销")
+		}
+
+		// 已轮换的令牌被重放：撤销整个令牌族，并需要提交事务使撤销生效
+		revoked, err := revokeTokenFamily(ctx, tx.Token, dbToken.FamilyID)
+		if err != nil {
+			return nil, err
+		}
+		if err := tx.Commit(); err != nil {
+			return nil, apperrs.ErrDatabase.With("user_id", dbToken.UserID).With("原始错误", err).Errorf("提交事务失败")
+		}
+		s.cache.InvalidateUser(dbToken.UserID)
+
+		slog.WarnContext(ctx, "检测到refresh token重用，已撤销整个令牌族",
+			"event", "refresh_token_reuse",
+			"user_id", dbToken.UserID,
+			"family_id", dbToken.FamilyID,
+			"token_id", dbToken.ID,
+			"rotated_at", dbToken.RotatedAt,
+			"revoked_count", revoked,
+		)
+		return nil, apperrs.ErrUnauthorized.
+			With("user_id", dbToken.UserID).
+			With("family_id", dbToken.FamilyID).
+			Tags(apperrs.TagSecurity).
+			Errorf("refresh令牌已被使用")
+	}
+
+	// 验证用户状态
+	user, err := tx.User.Get(ctx, dbToken.UserID)
+	if err != nil && !ent.IsNotFound(err) {
+		slog.ErrorContext(ctx, "查询用户失败", "error", err, "user_id", dbToken.UserID)
+		return nil, apperrs.ErrDatabase.With("user_id", dbToken.UserID).With("原始错误", err).Errorf("查询用户失败")
+	}
+	if err := s.validateUser(ctx, user); err != nil {
+		return nil, err
+	}
+
+	// 带条件地标记旧令牌为已轮换，防止并发请求重复使用同一令牌
+	affected, err := tx.Token.Update().
+		Where(
+			token.ID(dbToken.ID),
+			token.IsRevoked(false),
+		).
+		SetIsRevoked(true).
+		SetRotatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "轮换refresh token失败", "error", err, "user_id", user.ID)
+		return nil, apperrs.ErrDatabase.With("user_id", user.ID).With("原始错误", err).Errorf("轮换刷新令牌失败")
+	}
+	if affected == 0 {
+		return nil, apperrs.ErrUnauthorized.With("user_id", user.ID).Errorf("refresh令牌不存在或已撤销")
+	}
+
+	// 旧版本签发的令牌没有族ID，从本次轮换开始建立新的令牌族
+	familyID := dbToken.FamilyID
+	if familyID == "" {
+		familyID = utils.GenerateULID()
+	}
+
+	// 刷新不代表用户重新完成了认证，新令牌沿用原来的认证时间
+	grant := tokenGrant{ClientID: dbToken.ClientID, Scope: dbToken.Scope}
+	if dbToken.AuthTime != nil {
+		grant.AuthTime = *dbToken.AuthTime
+	}
+	return s.issueGrantTokenPair(ctx, tx, user.ID, familyID, grant)
+}
+
+// revokeTokenFamily 撤销令牌族中所有未撤销的令牌（access 与 refresh）
+func revokeTokenFamily(ctx context.Context, tc *ent.TokenClient, familyID string) (int, error) {
+	revoked, err := tc.Update().
+		Where(
+			token.FamilyID(familyID),
+			token.IsRevoked(false),
+		).
+		SetIsRevoked(true).
+		Save(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "撤销令牌族失败", "error", err, "family_id", familyID)
+		return 0, apperrs.ErrDatabase.With("family_id", familyID).With("原始错误", err).Errorf("撤销令牌族失败")
+	}
+
+	return revoked, nil
+}
+
+// revokeUserTokens 撤销用户所有未撤销的令牌，包括OAuth授权签发的令牌
+func revokeUserTokens(ctx context.Context, tc *ent.TokenClient, userID string) (int, error) {
+	revoked, err := tc.Update().
+		Where(
+			token.UserID(userID),
+			token.IsRevoked(false),
+		).
+		SetIsRevoked(true).
+		Save(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "撤销用户令牌失败", "error", err, "user_id", userID)
+		return 0, apperrs.ErrDatabase.With("user_id", userID).With("原始错误", err).Errorf("撤销用户令牌失败")
+	}
+
+	return revoked, nil
+}
+
+// passwordChanged 修改密码后的会话处理，需要在更新密码的事务中调用，与密码同时生效。
+// 未配置保留会话时撤销用户的所有令牌；current 为发起修改的会话，不为 nil 时在同一令牌族中签发新的令牌对，
+// 使调用方保持登录。保留会话或没有当前会话时返回 nil
+func (s *AuthService) passwordChanged(ctx context.Context, tx *ent.Tx, userID string, current *ent.Token) (*types.AuthOutput, error) {
+	if s.keepSessionsOnPasswordChange {
+		return nil, nil
+	}
+
+	revoked, err := revokeUserTokens(ctx, tx.Token, userID)
+	if err != nil {
+		return nil, err
+	}
+	s.cache.InvalidateUserOnCommit(tx, userID)
+	slog.InfoContext(ctx, "修改密码后已撤销用户的所有会话", "user_id", userID, "revoked_tokens", revoked)
+
+	// 个人访问令牌和OAuth令牌不代表登录会话，不重新签发
+	if current == nil || current.ClientID != "" || current.FamilyID == "" {
+		return nil, nil
+	}
+	return s.issueTokenPair(ctx, tx, userID, current.FamilyID)
+}
+
 // revokeToken 撤销令牌
 func (s *AuthService) revokeToken(ctx context.Context, tokenString string, tokenType string) error {
 	var dbTokenType token.Type
@@ -516,7 +1048,7 @@ func (s *AuthService) revokeToken(ctx context.Context, tokenString string, token
 	// 更新token状态为已撤销
 	_, err := s.orm.Token.Update().
 		Where(
-			token.Token(tokenString),
+			token.TokenHash(s.hashToken(tokenString)),
 			token.TypeEQ(dbTokenType),
 		).
 		SetIsRevoked(true).
@@ -530,7 +1062,7 @@ func (s *AuthService) revokeToken(ctx context.Context, tokenString string, token
 	return nil
 }
 
-// Logout 用户登出
+// Logout 用户登出，结束当前令牌所属的会话
 func (s *AuthService) Logout(ctx context.Context, accessToken string) error {
 	// 验证access token
 	claims, err := s.ValidateToken(accessToken)
@@ -538,12 +1070,32 @@ func (s *AuthService) Logout(ctx context.Context, accessToken string) error {
 		return err
 	}
 
-	// 撤销access token
+	dbToken, err := s.findValidToken(ctx, accessToken, types.TokenTypeAccess)
+	if err != nil {
+		return err
+	}
+
+	// 退出后已缓存的认证结果立即失效
+	defer s.cache.InvalidateUser(claims.UserID)
+
+	// 撤销当前会话（令牌族）中的所有令牌
+	if dbToken.FamilyID != "" {
+		if _, err := revokeTokenFamily(ctx, s.orm.Token, dbToken.FamilyID); err != nil {
+			return err
+		}
+		s.events.Record(ctx, claims.UserID, types.SecurityEventLogout, dbToken.FamilyID)
+		// 代为登录的管理员退出时记录结束时间
+		if dbToken.ImpersonatorID != "" {
+			return endImpersonation(ctx, s.orm.Impersonation, dbToken.FamilyID)
+		}
+		return nil
+	}
+
+	// 旧版本签发的令牌没有族ID：撤销access token及用户所有的refresh token
 	if err := s.revokeToken(ctx, accessToken, types.TokenTypeAccess); err != nil {
 		return err
 	}
 
-	// 撤销用户所有的refresh token
 	userID := claims.UserID
 	_, err = s.orm.Token.Update().
 		Where(
@@ -558,24 +1110,101 @@ func (s *AuthService) Logout(ctx context.Context, accessToken string) error {
 		slog.ErrorContext(ctx, "撤销refresh token失败", "error", err, "user_id", userID)
 		return apperrs.ErrDatabase.With("user_id", userID).With("原始错误", err).Errorf("撤销刷新令牌失败")
 	}
+	s.events.Record(ctx, userID, types.SecurityEventLogout, "")
 
 	return nil
 }
 
-// AuthenticateUser 认证用户 - 用于中间件
+// AuthenticateUser 认证第一方登录会话的访问令牌
 func (s *AuthService) AuthenticateUser(ctx context.Context, tokenString string) (*ent.User, *ent.Token, error) {
+	user, dbToken, _, err := s.Authenticate(ctx, tokenString, nil)
+	return user, dbToken, err
+}
+
+// Authenticate 认证访问令牌（JWT 或个人访问令牌）并校验授权范围 - 用于中间件。
+// 第一方登录会话不受授权范围限制；个人访问令牌和第三方应用令牌只能访问声明了授权范围的接口，
+// 且必须具有声明的全部授权范围。个人访问令牌没有对应的令牌记录，返回的 dbToken 为 nil；
+// 第一方登录会话返回的授权范围为 nil
+func (s *AuthService) Authenticate(ctx context.Context, tokenString string, required []string) (*ent.User, *ent.Token, []string, error) {
+	if IsPersonalAccessToken(tokenString) {
+		if s.personalTokens == nil {
+			return nil, nil, nil, apperrs.ErrUnauthorized.Errorf("未启用个人访问令牌")
+		}
+		pat, err := s.personalTokens.Authenticate(ctx, tokenString)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if err := checkScopes(pat.Scopes, required); err != nil {
+			return nil, nil, nil, err
+		}
+		if err := s.validateUser(ctx, pat.Edges.User); err != nil {
+			return nil, nil, nil, err
+		}
+		return pat.Edges.User, nil, pat.Scopes, nil
+	}
+
+	user, dbToken, err := s.authenticateJWT(ctx, tokenString)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if dbToken.ClientID == "" {
+		return user, dbToken, nil, nil
+	}
+
+	scopes := strings.Fields(dbToken.Scope)
+	if err := checkScopes(scopes, required); err != nil {
+		return nil, nil, nil, err
+	}
+	return user, dbToken, scopes, nil
+}
+
+// checkScopes 校验受限令牌是否具有接口声明的全部授权范围，未声明授权范围的接口只接受第一方登录会话
+func checkScopes(granted []string, required []string) error {
+	if len(required) == 0 {
+		return apperrs.ErrForbidden.Public("该令牌不能访问此接口").Errorf("受限令牌不能访问未声明授权范围的接口")
+	}
+	for _, scope := range required {
+		if !slices.Contains(granted, scope) {
+			return apperrs.ErrForbidden.With("scope", scope).Public("令牌缺少所需的授权范围").Errorf("令牌缺少授权范围 %s", scope)
+		}
+	}
+	return nil
+}
+
+// authenticateJWT 认证JWT访问令牌，返回令牌所属的用户和令牌记录
+func (s *AuthService) authenticateJWT(ctx context.Context, tokenString string) (*ent.User, *ent.Token, error) {
 	// 验证 JWT token
 	claims, err := s.ValidateToken(tokenString)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	// 客户端凭据模式签发的令牌不代表任何用户
+	if claims.UserID == "" {
+		return nil, nil, apperrs.ErrUnauthorized.With("client_id", claims.ClientID).Errorf("该令牌不属于任何用户")
+	}
+
+	// 优先使用缓存的认证结果，避免每个请求都查询数据库
+	tokenHash := s.hashToken(tokenString)
+	if user, dbToken, ok := s.cache.Get(tokenHash); ok {
+		if err := s.checkIdleTimeout(ctx, dbToken); err != nil {
+			return nil, nil, err
+		}
+		return user, dbToken, nil
+	}
+	generation := s.cache.Generation()
+
 	// 验证数据库中的token记录
 	dbToken, err := s.findValidToken(ctx, tokenString, types.TokenTypeAccess)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	// 检查会话是否空闲超时
+	if err := s.checkIdleTimeout(ctx, dbToken); err != nil {
+		return nil, nil, err
+	}
+
 	// 获取用户信息
 	user, err := s.findUserByID(ctx, claims.UserID)
 	if err != nil {
@@ -587,13 +1216,47 @@ func (s *AuthService) AuthenticateUser(ctx context.Context, tokenString string)
 		return nil, nil, err
 	}
 
+	s.cache.Set(tokenHash, user, dbToken, generation)
 	return user, dbToken, nil
 }
 
-// UpdateTokenUsage 更新token使用时间 - 用于中间件
+// lastActivity 返回令牌的最后活跃时间，包括尚未写入数据库的使用记录
+func (s *AuthService) lastActivity(dbToken *ent.Token) time.Time {
+	last := dbToken.CreatedAt
+	if dbToken.LastUsedAt != nil && dbToken.LastUsedAt.After(last) {
+		last = *dbToken.LastUsedAt
+	}
+	if s.usage != nil {
+		if pending, ok := s.usage.LastUsedAt(dbToken.ID); ok && pending.After(last) {
+			last = pending
+		}
+	}
+	return last
+}
+
+// checkIdleTimeout 拒绝空闲时间超过配置的access token
+func (s *AuthService) checkIdleTimeout(ctx context.Context, dbToken *ent.Token) error {
+	if s.jwtConfig.IdleTimeout <= 0 {
+		return nil
+	}
+
+	idle := time.Since(s.lastActivity(dbToken))
+	if idle <= s.jwtConfig.IdleTimeout {
+		return nil
+	}
+
+	slog.InfoContext(ctx, "会话空闲超时", "token_id", dbToken.ID, "user_id", dbToken.UserID, "idle", idle)
+	return apperrs.ErrUnauthorized.
+		With("token_id", dbToken.ID).
+		With("idle", idle).
+		Errorf("会话已超时，请重新登录")
+}
+
+// UpdateTokenUsage 记录token使用时间 - 用于中间件
+// 使用时间由后台写入器合并后批量写入数据库，不会阻塞请求
 func (s *AuthService) UpdateTokenUsage(token *ent.Token) {
-	// 这是一个非阻塞的异步操作，用于更新token的最后使用时间
-	// 在实际项目中，这里可以实现token使用统计等功能
-	// 目前为了简化，我们只记录日志
-	slog.Debug("Token usage recorded", "token_id", token.ID, "user_id", token.UserID)
+	if s.usage == nil {
+		return
+	}
+	s.usage.Record(token, time.Now())
 }
@@ -0,0 +1,193 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/liukeshao/echo-template/config"
+	"github.com/liukeshao/echo-template/ent"
+	"github.com/liukeshao/echo-template/ent/enttest"
+	"github.com/liukeshao/echo-template/ent/token"
+	"github.com/liukeshao/echo-template/pkg/apperrs"
+	"github.com/liukeshao/echo-template/pkg/types"
+	"github.com/liukeshao/echo-template/pkg/utils"
+)
+
+// newTestAuthService 创建基于内存SQLite的认证服务
+func newTestAuthService(t *testing.T) (*AuthService, *ent.Client) {
+	t.Helper()
+
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name())
+	client := enttest.Open(t, "sqlite3", dsn)
+	t.Cleanup(func() { client.Close() })
+
+	auth := NewAuthService(client, JWTConfig{
+		Secret:             "test-secret",
+		AccessTokenExpiry:  time.Hour,
+		RefreshTokenExpiry: 24 * time.Hour,
+		Issuer:             "test",
+		TokenHashKey:       "test-hash-key",
+	})
+	auth.SetAuthCache(NewAuthCache(100, time.Minute))
+	return auth, client
+}
+
+// registerTestUser 注册测试用户并返回首次签发的令牌
+func registerTestUser(t *testing.T, auth *AuthService) *types.AuthOutput {
+	t.Helper()
+
+	out, err := auth.Register(context.Background(), &types.RegisterInput{
+		Username: "tester",
+		Email:    "tester@example.com",
+		Password: "password123",
+	})
+	require.NoError(t, err)
+	return out
+}
+
+func TestRefreshTokenRotation(t *testing.T) {
+	auth, client := newTestAuthService(t)
+	ctx := context.Background()
+	first := registerTestUser(t, auth)
+
+	second, err := auth.RefreshToken(ctx, &types.RefreshTokenInput{RefreshToken: first.RefreshToken})
+	require.NoError(t, err)
+	assert.NotEqual(t, first.RefreshToken, second.RefreshToken, "应该签发新的refresh token")
+
+	// 新旧令牌属于同一令牌族
+	oldToken := client.Token.Query().Where(token.TokenHash(auth.hashToken(first.RefreshToken))).OnlyX(ctx)
+	newToken := client.Token.Query().Where(token.TokenHash(auth.hashToken(second.RefreshToken))).OnlyX(ctx)
+	assert.Equal(t, oldToken.FamilyID, newToken.FamilyID, "轮换后应保持同一令牌族")
+	assert.True(t, oldToken.IsRevoked, "旧refresh token应被撤销")
+	assert.NotNil(t, oldToken.RotatedAt, "旧refresh token应记录轮换时间")
+
+	// 新令牌可以继续正常使用
+	_, _, err = auth.AuthenticateUser(ctx, second.AccessToken)
+	assert.NoError(t, err)
+}
+
+func TestRefreshTokenReuseRevokesFamily(t *testing.T) {
+	auth, client := newTestAuthService(t)
+	ctx := context.Background()
+	first := registerTestUser(t, auth)
+
+	second, err := auth.RefreshToken(ctx, &types.RefreshTokenInput{RefreshToken: first.RefreshToken})
+	require.NoError(t, err)
+
+	// 重放已轮换的refresh token
+	_, err = auth.RefreshToken(ctx, &types.RefreshTokenInput{RefreshToken: first.RefreshToken})
+	assert.Error(t, err, "重放已轮换的refresh token应失败")
+
+	// 整个令牌族（包括最新签发的令牌）都应被撤销
+	familyID := client.Token.Query().Where(token.TokenHash(auth.hashToken(first.RefreshToken))).OnlyX(ctx).FamilyID
+	active := client.Token.Query().Where(token.FamilyID(familyID), token.IsRevoked(false)).CountX(ctx)
+	assert.Zero(t, active, "令牌族中不应再有有效令牌")
+
+	_, err = auth.RefreshToken(ctx, &types.RefreshTokenInput{RefreshToken: second.RefreshToken})
+	assert.Error(t, err, "令牌族撤销后新的refresh token也应失效")
+
+	_, _, err = auth.AuthenticateUser(ctx, second.AccessToken)
+	assert.Error(t, err, "令牌族撤销后access token也应失效")
+}
+
+func TestAuthCacheInvalidatedOnLogout(t *testing.T) {
+	auth, _ := newTestAuthService(t)
+	ctx := context.Background()
+	out := registerTestUser(t, auth)
+
+	// 首次认证后结果被缓存
+	_, _, err := auth.AuthenticateUser(ctx, out.AccessToken)
+	require.NoError(t, err)
+	_, _, ok := auth.cache.Get(auth.hashToken(out.AccessToken))
+	require.True(t, ok, "认证结果应被缓存")
+
+	require.NoError(t, auth.Logout(ctx, out.AccessToken))
+	_, _, err = auth.AuthenticateUser(ctx, out.AccessToken)
+	assert.Error(t, err, "退出后缓存应立即失效")
+}
+
+func TestLoginBruteForceProtection(t *testing.T) {
+	auth, _ := newTestAuthService(t)
+	ctx := context.Background()
+	registerTestUser(t, auth)
+
+	now := time.Now()
+	guard := NewLoginGuard(config.LoginProtectionConfig{
+		AccountThreshold: 3,
+		BaseDelay:        time.Second,
+		LockoutDuration:  time.Minute,
+	})
+	guard.now = func() time.Time { return now }
+	auth.SetLoginGuard(guard)
+
+	wrong := &types.LoginInput{Email: "tester@example.com", Password: "wrong-password"}
+	correct := &types.LoginInput{Email: "tester@example.com", Password: "password123"}
+
+	// 邮箱不存在与密码错误返回相同的错误
+	_, unknownErr := auth.Login(ctx, &types.LoginInput{Email: "nobody@example.com", Password: "wrong-password"})
+	_, wrongErr := auth.Login(ctx, wrong)
+	require.Error(t, unknownErr)
+	require.Error(t, wrongErr)
+	assert.Equal(t, unknownErr.Error(), wrongErr.Error())
+
+	// 退避期内即使密码正确也被拒绝
+	_, err := auth.Login(ctx, correct)
+	assertErrorCode(t, err, apperrs.CodeTooManyRequests)
+
+	// 达到阈值后锁定
+	now = now.Add(2 * time.Second)
+	_, err = auth.Login(ctx, wrong)
+	require.Error(t, err)
+	now = now.Add(4 * time.Second)
+	_, err = auth.Login(ctx, wrong)
+	require.Error(t, err)
+	now = now.Add(30 * time.Second)
+	_, err = auth.Login(ctx, correct)
+	assertErrorCode(t, err, apperrs.CodeTooManyRequests)
+
+	// 锁定结束后可以正常登录
+	now = now.Add(time.Minute)
+	_, err = auth.Login(ctx, correct)
+	assert.NoError(t, err)
+}
+
+func TestLoginUpgradesPasswordHash(t *testing.T) {
+	auth, client := newTestAuthService(t)
+	ctx := context.Background()
+	registerTestUser(t, auth)
+	userID := client.User.Query().OnlyIDX(ctx)
+	login := &types.LoginInput{Email: "tester@example.com", Password: "password123"}
+
+	// 旧版本保存的 bcrypt 哈希在登录成功后升级为 Argon2id
+	legacy, err := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.MinCost)
+	require.NoError(t, err)
+	client.User.UpdateOneID(userID).SetPasswordHash(string(legacy)).ExecX(ctx)
+
+	_, err = auth.Login(ctx, login)
+	require.NoError(t, err)
+	upgraded := client.User.GetX(ctx, userID).PasswordHash
+	assert.True(t, strings.HasPrefix(upgraded, "$argon2id$"), "应升级为 Argon2id 哈希: %s", upgraded)
+
+	// 参数调整后同样按新参数重新生成
+	auth.SetPasswordHasher(utils.NewArgon2idHasher(utils.Argon2idParams{Iterations: 3}, ""))
+	_, err = auth.Login(ctx, login)
+	require.NoError(t, err)
+	assert.Contains(t, client.User.GetX(ctx, userID).PasswordHash, ",t=3,")
+}
+
+// assertErrorCode 断言错误为指定错误码的 oops 错误
+func assertErrorCode(t *testing.T, err error, code apperrs.Code) {
+	t.Helper()
+
+	oopsErr, ok := oops.AsOops(err)
+	require.True(t, ok, "应为 oops 错误: %v", err)
+	assert.Equal(t, code.ToString(), oopsErr.Code())
+}
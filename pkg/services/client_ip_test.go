@@ -0,0 +1,35 @@
+package services
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIPExtractor(t *testing.T) {
+	request := func(remoteAddr string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/login", nil)
+		req.RemoteAddr = remoteAddr
+		req.Header.Set("X-Forwarded-For", "198.51.100.1")
+		req.Header.Set("X-Real-IP", "198.51.100.2")
+		return req
+	}
+
+	// 未配置可信代理时忽略客户端提供的转发请求头
+	direct, err := NewIPExtractor(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "203.0.113.9", direct(request("203.0.113.9:1234")))
+
+	// 只信任来自已配置代理的 X-Forwarded-For
+	proxied, err := NewIPExtractor([]string{"10.0.0.1", "192.0.2.0/24"})
+	require.NoError(t, err)
+	assert.Equal(t, "198.51.100.1", proxied(request("10.0.0.1:1234")))
+	assert.Equal(t, "198.51.100.1", proxied(request("192.0.2.7:1234")))
+	assert.Equal(t, "10.0.0.2", proxied(request("10.0.0.2:1234")))
+
+	_, err = NewIPExtractor([]string{"not-an-ip"})
+	assert.Error(t, err)
+}
@@ -12,6 +12,8 @@ import (
 	"github.com/liukeshao/echo-template/config"
 	"github.com/liukeshao/echo-template/ent"
 	"github.com/liukeshao/echo-template/pkg/log"
+	"github.com/liukeshao/echo-template/pkg/mail"
+	"github.com/liukeshao/echo-template/pkg/utils"
 	_ "github.com/mattn/go-sqlite3"
 
 	// Required by ent.
@@ -33,8 +35,42 @@ type Container struct {
 	// ORM stores a client to the ORM.
 	ORM *ent.Client
 
-	Auth *AuthService
-	Me   *MeService
+	// Mail stores the mail sender.
+	Mail mail.Mailer
+
+	// AuthCache caches validated access tokens.
+	AuthCache *AuthCache
+
+	// PasswordHasher hashes and verifies passwords.
+	PasswordHasher utils.PasswordHasher
+
+	// PasswordPolicy validates new passwords.
+	PasswordPolicy *PasswordPolicy
+
+	// SecurityEvents records the per-user security event log.
+	SecurityEvents *SecurityEventService
+
+	Auth    *AuthService
+	Me      *MeService
+	Session *SessionService
+
+	Verification   *VerificationService
+	PasswordReset  *PasswordResetService
+	MagicLink      *MagicLinkService
+	EmailChange    *EmailChangeService
+	TwoFactor      *TwoFactorService
+	PersonalTokens *PersonalTokenService
+	Passkey        *PasskeyService
+	OIDC           *OIDCService
+	OAuth          *OAuthService
+	Impersonation  *ImpersonationService
+	Admin          *AdminService
+
+	// TokenUsage records token last used times in the background.
+	TokenUsage *TokenUsageRecorder
+
+	// TokenCleaner periodically purges expired tokens.
+	TokenCleaner *TokenCleaner
 }
 
 // NewContainer creates and initializes a new Container.
@@ -44,8 +80,26 @@ func NewContainer() *Container {
 	c.initWeb()
 	c.initDatabase()
 	c.initORM()
+	c.initMail()
+	c.initAuthCache()
+	c.initPasswordHasher()
+	c.initPasswordPolicy()
+	c.initSecurityEvents()
+	c.initVerification()
+	c.initPasswordReset()
+	c.initEmailChange()
+	c.initTwoFactor()
+	c.initPersonalTokens()
 	c.initAuth()
+	c.initMagicLink()
+	c.initPasskey()
+	c.initOIDC()
+	c.initOAuth()
+	c.initImpersonation()
+	c.initAdmin()
 	c.initMe()
+	c.initSession()
+	c.initTokenCleaner()
 	return c
 }
 
@@ -58,6 +112,25 @@ func (c *Container) Shutdown() error {
 		return err
 	}
 
+	// Stop background tasks before the database is closed.
+	taskCtx, taskCancel := context.WithTimeout(context.Background(), c.Config.HTTP.ShutdownTimeout)
+	defer taskCancel()
+	if err := c.TokenCleaner.Stop(taskCtx); err != nil {
+		return err
+	}
+	if err := c.TokenUsage.Stop(taskCtx); err != nil {
+		return err
+	}
+	if err := c.PasswordReset.Wait(taskCtx); err != nil {
+		return err
+	}
+	if err := c.MagicLink.Wait(taskCtx); err != nil {
+		return err
+	}
+	if err := c.SecurityEvents.Stop(taskCtx); err != nil {
+		return err
+	}
+
 	// Shutdown the ORM.
 	if err := c.ORM.Close(); err != nil {
 		return err
@@ -87,6 +160,13 @@ func (c *Container) initConfig() {
 func (c *Container) initWeb() {
 	c.Web = echo.New()
 	c.Web.HideBanner = true
+
+	// 只信任已配置代理转发的客户端IP，防止伪造请求头绕过按IP的限制
+	extractor, err := NewIPExtractor(c.Config.HTTP.TrustedProxies)
+	if err != nil {
+		panic(fmt.Sprintf("failed to configure trusted proxies: %v", err))
+	}
+	c.Web.IPExtractor = extractor
 }
 
 // initDatabase initializes the database.
@@ -103,19 +183,153 @@ func (c *Container) initORM() {
 	drv := entsql.OpenDB(c.Config.Database.Driver, c.Database)
 	c.ORM = ent.NewClient(ent.Driver(drv))
 
+	// Migrate legacy plaintext tokens before the schema is brought up to date.
+	if err := migrateLegacyTokens(context.Background(), c.Database, tokenHashKey(c.Config.JWT)); err != nil {
+		panic(err)
+	}
+
 	// Run the auto migration tool.
 	if err := c.ORM.Schema.Create(context.Background()); err != nil {
 		panic(err)
 	}
 }
 
+// initMail initializes the mail sender.
+func (c *Container) initMail() {
+	var err error
+	c.Mail, err = mail.New(c.Config.Mail)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// initPasswordHasher initializes the Argon2id password hasher.
+func (c *Container) initPasswordHasher() {
+	c.PasswordHasher = utils.NewArgon2idHasher(utils.Argon2idParams{
+		Memory:      c.Config.PasswordHash.Memory,
+		Iterations:  c.Config.PasswordHash.Iterations,
+		Parallelism: c.Config.PasswordHash.Parallelism,
+	}, c.Config.PasswordHash.Pepper)
+}
+
+// initPasswordPolicy initializes the password policy.
+func (c *Container) initPasswordPolicy() {
+	common, err := LoadCommonPasswords(c.Config.Password.CommonPasswordsFile)
+	if err != nil {
+		panic(fmt.Sprintf("failed to load common passwords: %v", err))
+	}
+	c.PasswordPolicy = NewPasswordPolicy(c.Config.Password, common, c.PasswordHasher)
+}
+
+// initSecurityEvents initializes the user security event log.
+func (c *Container) initSecurityEvents() {
+	c.SecurityEvents = NewSecurityEventService(c.ORM, c.Config.Security)
+	c.SecurityEvents.Start()
+}
+
+// initVerification initializes the email verification service.
+func (c *Container) initVerification() {
+	c.Verification = NewVerificationService(c.ORM, c.Mail, tokenHashKey(c.Config.JWT), c.Config.App.Host, c.Config.Account)
+}
+
+// initPasswordReset initializes the password reset service.
+func (c *Container) initPasswordReset() {
+	c.PasswordReset = NewPasswordResetService(c.ORM, c.Mail, c.AuthCache, c.PasswordPolicy, c.PasswordHasher, tokenHashKey(c.Config.JWT), c.Config.App.Host, c.Config.Account)
+	c.PasswordReset.SetSecurityEvents(c.SecurityEvents)
+}
+
+// initEmailChange initializes the confirmed email change service.
+func (c *Container) initEmailChange() {
+	c.EmailChange = NewEmailChangeService(c.ORM, c.Mail, c.AuthCache, tokenHashKey(c.Config.JWT), c.Config.App.Host, c.Config.Account)
+	c.EmailChange.SetSecurityEvents(c.SecurityEvents)
+}
+
+// initTwoFactor initializes the TOTP two-factor authentication service.
+func (c *Container) initTwoFactor() {
+	c.TwoFactor = NewTwoFactorService(c.ORM, c.AuthCache, c.PasswordHasher, tokenHashKey(c.Config.JWT), c.Config.App.Name)
+	c.TwoFactor.SetSecurityEvents(c.SecurityEvents)
+}
+
+// initPersonalTokens initializes the personal access token service.
+func (c *Container) initPersonalTokens() {
+	c.PersonalTokens = NewPersonalTokenService(c.ORM, tokenHashKey(c.Config.JWT))
+}
+
+// initAuthCache initializes the authentication cache.
+func (c *Container) initAuthCache() {
+	c.AuthCache = NewAuthCache(c.Config.JWT.AuthCacheSize, c.Config.JWT.AuthCacheTTL)
+}
+
 func (c *Container) initAuth() {
-	jwtConfig := NewJWTConfigFromConfig(c.Config.JWT)
+	jwtConfig, err := NewJWTConfigFromConfig(c.Config.JWT)
+	if err != nil {
+		panic(fmt.Sprintf("failed to load jwt keys: %v", err))
+	}
 	c.Auth = NewAuthService(c.ORM, jwtConfig)
+
+	c.TokenUsage = NewTokenUsageRecorder(c.ORM, c.Config.JWT.UsageFlushInterval)
+	c.TokenUsage.Start()
+	c.Auth.SetTokenUsageRecorder(c.TokenUsage)
+	c.Auth.SetAuthCache(c.AuthCache)
+	c.Auth.SetLoginGuard(NewLoginGuard(c.Config.Login))
+	c.Auth.SetPasswordHasher(c.PasswordHasher)
+	c.Auth.SetPasswordPolicy(c.PasswordPolicy)
+	c.Auth.SetVerificationService(c.Verification)
+	c.Auth.SetTwoFactorService(c.TwoFactor)
+	c.Auth.SetPersonalTokenService(c.PersonalTokens)
+	c.Auth.SetSessionCookies(NewSessionCookies(c.Config.Cookie, c.Config.JWT.RefreshTokenExpiry))
+	c.Auth.SetKeepSessionsOnPasswordChange(c.Config.Account.KeepSessionsOnPasswordChange)
+	c.Auth.SetSecurityEvents(c.SecurityEvents)
+}
+
+// initMagicLink initializes the passwordless email link login service.
+func (c *Container) initMagicLink() {
+	c.MagicLink = NewMagicLinkService(c.ORM, c.Mail, c.Auth, tokenHashKey(c.Config.JWT), c.Config.App.Host, c.Config.Account)
+}
+
+// initPasskey initializes the passkey (WebAuthn) service.
+func (c *Container) initPasskey() {
+	var err error
+	c.Passkey, err = NewPasskeyService(c.ORM, c.Auth, tokenHashKey(c.Config.JWT), c.Config.App, c.Config.WebAuthn)
+	if err != nil {
+		panic(fmt.Sprintf("failed to configure webauthn: %v", err))
+	}
+}
+
+// initOIDC initializes external OpenID Connect login.
+func (c *Container) initOIDC() {
+	c.OIDC = NewOIDCService(c.ORM, c.Auth, tokenHashKey(c.Config.JWT), c.Config.App.Host, c.Config.OIDC)
+}
+
+// initOAuth initializes the OAuth 2.0 authorization server.
+func (c *Container) initOAuth() {
+	c.OAuth = NewOAuthService(c.ORM, c.Auth, tokenHashKey(c.Config.JWT), c.Config.App.Host, c.Config.OAuth)
+}
+
+// initImpersonation initializes admin impersonation.
+func (c *Container) initImpersonation() {
+	c.Impersonation = NewImpersonationService(c.ORM, c.Auth, c.Config.Admin)
+}
+
+// initAdmin initializes admin user management.
+func (c *Container) initAdmin() {
+	c.Admin = NewAdminService(c.ORM, c.Auth, c.PasswordPolicy, c.PasswordHasher)
 }
 
 func (c *Container) initMe() {
-	c.Me = NewMeService(c.ORM)
+	c.Me = NewMeService(c.ORM, c.Auth, c.PasswordPolicy, c.PasswordHasher)
+}
+
+func (c *Container) initSession() {
+	c.Session = NewSessionService(c.ORM, c.AuthCache)
+	c.Session.SetSecurityEvents(c.SecurityEvents)
+}
+
+// initTokenCleaner starts the expired token cleanup task.
+func (c *Container) initTokenCleaner() {
+	c.TokenCleaner = NewTokenCleaner(c.ORM, c.Config.TokenCleanup)
+	c.TokenCleaner.SetSecurityEventRetention(c.SecurityEvents.Retention())
+	c.TokenCleaner.Start()
 }
 
 // openDB opens a database connection.
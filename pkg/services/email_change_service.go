@@ -0,0 +1,295 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/liukeshao/echo-template/config"
+	"github.com/liukeshao/echo-template/ent"
+	"github.com/liukeshao/echo-template/ent/onetimetoken"
+	userEnt "github.com/liukeshao/echo-template/ent/user"
+	"github.com/liukeshao/echo-template/pkg/apperrs"
+	"github.com/liukeshao/echo-template/pkg/mail"
+	"github.com/liukeshao/echo-template/pkg/types"
+)
+
+// 修改邮箱链接默认有效期
+const (
+	DefaultEmailChangeExpiry = 24 * time.Hour
+	DefaultEmailRevertExpiry = 7 * 24 * time.Hour
+)
+
+// EmailChangeService 修改邮箱服务。申请修改后新邮箱处于待确认状态，
+// 通过发送到新邮箱的链接确认后生效；原邮箱收到通知和撤销链接，可以撤销修改并退出所有设备
+type EmailChangeService struct {
+	orm          *ent.Client
+	mailer       mail.Mailer
+	cache        *AuthCache
+	events       *SecurityEventService
+	hashKey      string
+	confirmURL   string
+	revertURL    string
+	expiry       time.Duration
+	revertExpiry time.Duration
+}
+
+// NewEmailChangeService 创建修改邮箱服务，未配置页面地址时使用 host + /confirm-email 和 host + /revert-email
+func NewEmailChangeService(orm *ent.Client, mailer mail.Mailer, cache *AuthCache, hashKey string, host string, cfg config.AccountConfig) *EmailChangeService {
+	expiry := cfg.EmailChangeExpiry
+	if expiry <= 0 {
+		expiry = DefaultEmailChangeExpiry
+	}
+	revertExpiry := cfg.EmailRevertExpiry
+	if revertExpiry <= 0 {
+		revertExpiry = DefaultEmailRevertExpiry
+	}
+	confirmURL := cfg.EmailChangeURL
+	if confirmURL == "" {
+		confirmURL = strings.TrimRight(host, "/") + "/confirm-email"
+	}
+	revertURL := cfg.EmailRevertURL
+	if revertURL == "" {
+		revertURL = strings.TrimRight(host, "/") + "/revert-email"
+	}
+
+	return &EmailChangeService{
+		orm:          orm,
+		mailer:       mailer,
+		cache:        cache,
+		hashKey:      hashKey,
+		confirmURL:   confirmURL,
+		revertURL:    revertURL,
+		expiry:       expiry,
+		revertExpiry: revertExpiry,
+	}
+}
+
+// SetSecurityEvents 设置安全事件日志
+func (s *EmailChangeService) SetSecurityEvents(events *SecurityEventService) {
+	s.events = events
+}
+
+// Request 申请修改邮箱：保存待确认的新邮箱，向新邮箱发送确认链接，向原邮箱发送通知和撤销链接。
+// 再次申请时之前的确认链接失效，已发送的撤销链接仍然有效
+func (s *EmailChangeService) Request(ctx context.Context, userID string, input *types.UpdateEmailInput) (*types.UserOutput, error) {
+	user, err := s.orm.User.Get(ctx, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperrs.ErrNotFound.With("user_id", userID).Public("用户不存在").Errorf("用户不存在")
+		}
+		slog.ErrorContext(ctx, "查询用户失败", "error", err, "user_id", userID)
+		return nil, apperrs.ErrDatabase.With("user_id", userID).With("原始错误", err).Errorf("查询用户失败")
+	}
+	if strings.EqualFold(user.Email, input.Email) {
+		return nil, apperrs.ErrBadRequest.With("user_id", userID).Public("新邮箱与当前邮箱相同").Errorf("新邮箱与当前邮箱相同")
+	}
+	if err := s.checkEmailAvailable(ctx, s.orm.User, userID, input.Email); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.orm.Tx(ctx)
+	if err != nil {
+		return nil, apperrs.ErrDatabase.With("user_id", userID).With("原始错误", err).Errorf("开启事务失败")
+	}
+	defer tx.Rollback()
+
+	user, err = tx.User.UpdateOne(user).SetPendingEmail(input.Email).Save(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "保存待确认邮箱失败", "error", err, "user_id", userID)
+		return nil, apperrs.ErrDatabase.With("user_id", userID).With("原始错误", err).Errorf("保存待确认邮箱失败")
+	}
+
+	if err := voidOneTimeTokens(ctx, tx.OneTimeToken, userID, onetimetoken.PurposeChangeEmail); err != nil {
+		return nil, err
+	}
+	confirmRaw, err := s.saveToken(ctx, tx.OneTimeToken, userID, onetimetoken.PurposeChangeEmail, s.expiry, input.Email)
+	if err != nil {
+		return nil, err
+	}
+	// 撤销链接不随再次申请失效，防止盗用会话的人连续修改邮箱使原邮箱收到的链接作废
+	revertRaw, err := s.saveToken(ctx, tx.OneTimeToken, userID, onetimetoken.PurposeRevertEmail, s.revertExpiry, user.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	s.cache.InvalidateUserOnCommit(tx, userID)
+	if err := tx.Commit(); err != nil {
+		return nil, apperrs.ErrDatabase.With("user_id", userID).With("原始错误", err).Errorf("提交事务失败")
+	}
+
+	confirm := &mail.Message{
+		To:      input.Email,
+		Subject: "确认修改邮箱",
+		Body: fmt.Sprintf("%s，您好：\n\n您申请将账户邮箱修改为 %s，请点击以下链接确认，链接%s内有效：\n\n%s\n\n如果这不是您本人的操作，请忽略此邮件。",
+			user.Username, input.Email, formatExpiry(s.expiry), s.confirmURL+"?token="+url.QueryEscape(confirmRaw)),
+	}
+	if err := s.mailer.Send(ctx, confirm); err != nil {
+		slog.ErrorContext(ctx, "发送修改邮箱确认邮件失败", "error", err, "user_id", userID)
+		return nil, apperrs.ErrExternalAPI.With("user_id", userID).With("原始错误", err).Errorf("发送修改邮箱确认邮件失败")
+	}
+
+	notice := &mail.Message{
+		To:      user.Email,
+		Subject: "您的账户正在修改邮箱",
+		Body: fmt.Sprintf("%s，您好：\n\n您的账户申请将邮箱修改为 %s，确认后将使用新邮箱登录。\n\n如果这不是您本人的操作，请点击以下链接撤销修改并退出所有设备，链接%s内有效：\n\n%s",
+			user.Username, input.Email, formatExpiry(s.revertExpiry), s.revertURL+"?token="+url.QueryEscape(revertRaw)),
+	}
+	if err := s.mailer.Send(ctx, notice); err != nil {
+		// 通知发送失败不影响申请，新邮箱仍需确认后才生效
+		slog.ErrorContext(ctx, "发送修改邮箱通知邮件失败", "error", err, "user_id", userID)
+	}
+
+	s.events.Record(ctx, userID, types.SecurityEventEmailChangeRequested, input.Email)
+	slog.InfoContext(ctx, "已申请修改邮箱", "user_id", userID)
+	return &types.UserOutput{
+		UserInfo: &types.UserInfo{
+			ID:               user.ID,
+			Username:         user.Username,
+			Email:            user.Email,
+			PendingEmail:     user.PendingEmail,
+			Status:           string(user.Status),
+			TwoFactorEnabled: user.TwoFactorEnabled,
+			LastLoginAt:      user.LastLoginAt,
+			CreatedAt:        user.CreatedAt,
+		},
+	}, nil
+}
+
+// Confirm 使用发送到新邮箱的链接确认修改，在事务中再次检查新邮箱是否已被其他用户使用
+func (s *EmailChangeService) Confirm(ctx context.Context, input *types.EmailChangeTokenInput) error {
+	tx, err := s.orm.Tx(ctx)
+	if err != nil {
+		return apperrs.ErrDatabase.With("原始错误", err).Errorf("开启事务失败")
+	}
+	defer tx.Rollback()
+
+	ott, err := consumeOneTimeToken(ctx, tx.OneTimeToken, s.hashKey, input.Token, onetimetoken.PurposeChangeEmail)
+	if err != nil {
+		return err
+	}
+
+	user, err := tx.User.Get(ctx, ott.UserID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return apperrs.ErrBadRequest.With("user_id", ott.UserID).Public("链接无效或已过期").Errorf("用户不存在")
+		}
+		slog.ErrorContext(ctx, "查询用户失败", "error", err, "user_id", ott.UserID)
+		return apperrs.ErrDatabase.With("user_id", ott.UserID).With("原始错误", err).Errorf("查询用户失败")
+	}
+	// 申请已被撤销或被新的申请替换
+	if user.PendingEmail == "" || user.PendingEmail != ott.Email {
+		return apperrs.ErrBadRequest.With("user_id", user.ID).Public("链接无效或已过期").Errorf("待确认邮箱与链接不一致")
+	}
+
+	if err := s.checkEmailAvailable(ctx, tx.User, user.ID, ott.Email); err != nil {
+		return err
+	}
+	err = tx.User.UpdateOne(user).
+		SetEmail(ott.Email).
+		ClearPendingEmail().
+		Exec(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return apperrs.ErrConflict.With("user_id", user.ID).Public("邮箱已被使用").Errorf("邮箱已存在")
+		}
+		slog.ErrorContext(ctx, "更新邮箱失败", "error", err, "user_id", user.ID)
+		return apperrs.ErrDatabase.With("user_id", user.ID).With("原始错误", err).Errorf("更新邮箱失败")
+	}
+
+	s.cache.InvalidateUserOnCommit(tx, user.ID)
+	if err := tx.Commit(); err != nil {
+		return apperrs.ErrDatabase.With("user_id", user.ID).With("原始错误", err).Errorf("提交事务失败")
+	}
+
+	s.events.Record(ctx, user.ID, types.SecurityEventEmailChanged, ott.Email)
+	slog.InfoContext(ctx, "已确认修改邮箱", "user_id", user.ID)
+	return nil
+}
+
+// Revert 使用发送到原邮箱的链接撤销修改：取消待确认的修改或恢复原邮箱，并撤销用户的所有会话
+func (s *EmailChangeService) Revert(ctx context.Context, input *types.EmailChangeTokenInput) error {
+	tx, err := s.orm.Tx(ctx)
+	if err != nil {
+		return apperrs.ErrDatabase.With("原始错误", err).Errorf("开启事务失败")
+	}
+	defer tx.Rollback()
+
+	ott, err := consumeOneTimeToken(ctx, tx.OneTimeToken, s.hashKey, input.Token, onetimetoken.PurposeRevertEmail)
+	if err != nil {
+		return err
+	}
+
+	user, err := tx.User.Get(ctx, ott.UserID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return apperrs.ErrBadRequest.With("user_id", ott.UserID).Public("链接无效或已过期").Errorf("用户不存在")
+		}
+		slog.ErrorContext(ctx, "查询用户失败", "error", err, "user_id", ott.UserID)
+		return apperrs.ErrDatabase.With("user_id", ott.UserID).With("原始错误", err).Errorf("查询用户失败")
+	}
+
+	update := tx.User.UpdateOne(user).ClearPendingEmail()
+	if user.Email != ott.Email {
+		if err := s.checkEmailAvailable(ctx, tx.User, user.ID, ott.Email); err != nil {
+			return err
+		}
+		update.SetEmail(ott.Email)
+	}
+	if err := update.Exec(ctx); err != nil {
+		if ent.IsConstraintError(err) {
+			return apperrs.ErrConflict.With("user_id", user.ID).Public("邮箱已被使用").Errorf("邮箱已存在")
+		}
+		slog.ErrorContext(ctx, "恢复邮箱失败", "error", err, "user_id", user.ID)
+		return apperrs.ErrDatabase.With("user_id", user.ID).With("原始错误", err).Errorf("恢复邮箱失败")
+	}
+
+	// 修改可能来自被盗用的会话，撤销未确认的申请和所有会话
+	if err := voidOneTimeTokens(ctx, tx.OneTimeToken, user.ID, onetimetoken.PurposeChangeEmail); err != nil {
+		return err
+	}
+	revoked, err := revokeUserTokens(ctx, tx.Token, user.ID)
+	if err != nil {
+		return err
+	}
+
+	s.cache.InvalidateUserOnCommit(tx, user.ID)
+	if err := tx.Commit(); err != nil {
+		return apperrs.ErrDatabase.With("user_id", user.ID).With("原始错误", err).Errorf("提交事务失败")
+	}
+
+	s.events.Record(ctx, user.ID, types.SecurityEventEmailChangeReverted, ott.Email)
+	slog.InfoContext(ctx, "已撤销修改邮箱", "user_id", user.ID, "revoked_tokens", revoked)
+	return nil
+}
+
+// checkEmailAvailable 检查邮箱是否已被其他用户使用
+func (s *EmailChangeService) checkEmailAvailable(ctx context.Context, uc *ent.UserClient, userID string, email string) error {
+	exists, err := uc.Query().
+		Where(
+			userEnt.EmailEQ(email),
+			userEnt.IDNEQ(userID),
+		).
+		Exist(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "检查邮箱是否存在失败", "error", err, "user_id", userID)
+		return apperrs.ErrDatabase.With("user_id", userID).With("原始错误", err).Errorf("检查邮箱失败")
+	}
+	if exists {
+		return apperrs.ErrConflict.With("user_id", userID).With("email", email).Public("邮箱已被使用").Errorf("邮箱已存在")
+	}
+	return nil
+}
+
+// saveToken 签发关联邮箱的一次性令牌
+func (s *EmailChangeService) saveToken(ctx context.Context, tc *ent.OneTimeTokenClient, userID string, purpose onetimetoken.Purpose, ttl time.Duration, email string) (string, error) {
+	raw, create := newOneTimeToken(tc, s.hashKey, userID, purpose, ttl)
+	if err := create.SetEmail(email).Exec(ctx); err != nil {
+		slog.ErrorContext(ctx, "保存一次性令牌失败", "error", err, "user_id", userID, "purpose", purpose)
+		return "", apperrs.ErrDatabase.With("user_id", userID).With("purpose", purpose).With("原始错误", err).Errorf("保存一次性令牌失败")
+	}
+	return raw, nil
+}
@@ -0,0 +1,62 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/liukeshao/echo-template/config"
+	"github.com/liukeshao/echo-template/pkg/apperrs"
+	"github.com/liukeshao/echo-template/pkg/mail"
+	"github.com/liukeshao/echo-template/pkg/types"
+)
+
+func TestEmailChange(t *testing.T) {
+	auth, client := newTestAuthService(t)
+	ctx := context.Background()
+	session := registerTestUser(t, auth)
+	userID := client.User.Query().OnlyIDX(ctx)
+
+	mailer := mail.NewMemoryMailer()
+	emails := NewEmailChangeService(client, mailer, auth.cache, "test-hash-key", "http://localhost", config.AccountConfig{})
+
+	// 申请后邮箱保持不变，新邮箱收到确认链接，原邮箱收到撤销链接
+	out, err := emails.Request(ctx, userID, &types.UpdateEmailInput{Email: "first@example.com"})
+	require.NoError(t, err)
+	assert.Equal(t, "tester@example.com", out.Email)
+	assert.Equal(t, "first@example.com", out.PendingEmail)
+	firstConfirm := tokenFromMail(t, mailer, "first@example.com")
+	firstRevert := tokenFromMail(t, mailer, "tester@example.com")
+
+	// 再次申请后之前的确认链接失效
+	_, err = emails.Request(ctx, userID, &types.UpdateEmailInput{Email: "new@example.com"})
+	require.NoError(t, err)
+	confirm := tokenFromMail(t, mailer, "new@example.com")
+	assertErrorCode(t, emails.Confirm(ctx, &types.EmailChangeTokenInput{Token: firstConfirm}), apperrs.CodeBadRequest)
+
+	require.NoError(t, emails.Confirm(ctx, &types.EmailChangeTokenInput{Token: confirm}))
+	updated := client.User.GetX(ctx, userID)
+	assert.Equal(t, "new@example.com", updated.Email)
+	assert.Empty(t, updated.PendingEmail)
+
+	// 原邮箱收到的第一个撤销链接仍然有效，撤销后恢复原邮箱并退出所有设备
+	require.NoError(t, emails.Revert(ctx, &types.EmailChangeTokenInput{Token: firstRevert}))
+	assert.Equal(t, "tester@example.com", client.User.GetX(ctx, userID).Email)
+	_, _, err = auth.AuthenticateUser(ctx, session.AccessToken)
+	assertErrorCode(t, err, apperrs.CodeUnauthorized)
+
+	// 确认时再次检查新邮箱是否已被其他用户注册
+	_, err = emails.Request(ctx, userID, &types.UpdateEmailInput{Email: "taken@example.com"})
+	require.NoError(t, err)
+	confirm = tokenFromMail(t, mailer, "taken@example.com")
+	_, err = auth.Register(ctx, &types.RegisterInput{Username: "other", Email: "taken@example.com", Password: "password123"})
+	require.NoError(t, err)
+	assertErrorCode(t, emails.Confirm(ctx, &types.EmailChangeTokenInput{Token: confirm}), apperrs.CodeConflict)
+	assert.Equal(t, "tester@example.com", client.User.GetX(ctx, userID).Email)
+
+	// 已被使用的邮箱不能申请
+	_, err = emails.Request(ctx, userID, &types.UpdateEmailInput{Email: "taken@example.com"})
+	assertErrorCode(t, err, apperrs.CodeConflict)
+}
@@ -0,0 +1,118 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/liukeshao/echo-template/config"
+	"github.com/liukeshao/echo-template/pkg/apperrs"
+)
+
+// 邮件发送频率限制默认参数
+const (
+	DefaultEmailCooldown = time.Minute
+	DefaultEmailIPLimit  = 10
+	DefaultEmailIPWindow = time.Hour
+
+	// emailThrottleMaxEntries 记录数超过该值时清理已过期的记录
+	emailThrottleMaxEntries = 10000
+)
+
+// emailRequests 某个IP在当前时间窗口内的请求记录
+type emailRequests struct {
+	count int
+	start time.Time
+}
+
+// EmailThrottle 限制登录链接、重置密码等邮件的申请频率。
+// 同一邮箱在冷却时间内只能申请一次，防止反复申请作废他人的链接或轰炸收件箱；
+// 同一IP在时间窗口内的申请次数有上限。限制与邮箱是否注册无关，不暴露账户是否存在
+type EmailThrottle struct {
+	cooldown time.Duration
+	ipLimit  int
+	window   time.Duration
+	now      func() time.Time
+
+	mu     sync.Mutex
+	emails map[string]time.Time // 邮箱 -> 上次申请时间
+	ips    map[string]*emailRequests
+}
+
+// NewEmailThrottle 创建邮件发送频率限制，未配置的参数使用默认值
+func NewEmailThrottle(cfg config.AccountConfig) *EmailThrottle {
+	t := &EmailThrottle{
+		cooldown: cfg.EmailCooldown,
+		ipLimit:  cfg.EmailIPLimit,
+		window:   cfg.EmailIPWindow,
+		now:      time.Now,
+		emails:   make(map[string]time.Time),
+		ips:      make(map[string]*emailRequests),
+	}
+	if t.cooldown <= 0 {
+		t.cooldown = DefaultEmailCooldown
+	}
+	if t.ipLimit <= 0 {
+		t.ipLimit = DefaultEmailIPLimit
+	}
+	if t.window <= 0 {
+		t.window = DefaultEmailIPWindow
+	}
+	return t
+}
+
+// Allow 检查并记录一次申请，超过频率限制时返回 ErrTooManyRequests
+func (t *EmailThrottle) Allow(email string, ip string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.now()
+	if len(t.emails)+len(t.ips) >= emailThrottleMaxEntries {
+		t.sweep(now)
+	}
+
+	email = strings.ToLower(strings.TrimSpace(email))
+	var wait time.Duration
+	if last, ok := t.emails[email]; ok {
+		wait = max(last.Add(t.cooldown).Sub(now), 0)
+	}
+	r := t.ips[ip]
+	if ip != "" && r != nil && now.Sub(r.start) < t.window && r.count >= t.ipLimit {
+		wait = max(wait, r.start.Add(t.window).Sub(now))
+	}
+	if wait > 0 {
+		retryAfter := int(math.Ceil(wait.Seconds()))
+		return apperrs.ErrTooManyRequests.
+			With("email", email).
+			With("ip", ip).
+			With("retry_after", retryAfter).
+			Public(fmt.Sprintf("请求过于频繁，请%d秒后重试", retryAfter)).
+			Errorf("邮件申请过于频繁")
+	}
+
+	t.emails[email] = now
+	if ip != "" {
+		if r == nil || now.Sub(r.start) >= t.window {
+			r = &emailRequests{start: now}
+			t.ips[ip] = r
+		}
+		r.count++
+	}
+	return nil
+}
+
+// sweep 清理已过期的记录，调用方需持有锁
+func (t *EmailThrottle) sweep(now time.Time) {
+	for email, last := range t.emails {
+		if now.Sub(last) >= t.cooldown {
+			delete(t.emails, email)
+		}
+	}
+	for ip, r := range t.ips {
+		if now.Sub(r.start) >= t.window {
+			delete(t.ips, ip)
+		}
+	}
+}
@@ -0,0 +1,229 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/liukeshao/echo-template/config"
+	"github.com/liukeshao/echo-template/ent"
+	"github.com/liukeshao/echo-template/ent/impersonation"
+	userEnt "github.com/liukeshao/echo-template/ent/user"
+	"github.com/liukeshao/echo-template/pkg/appctx"
+	"github.com/liukeshao/echo-template/pkg/apperrs"
+	"github.com/liukeshao/echo-template/pkg/types"
+	"github.com/liukeshao/echo-template/pkg/utils"
+)
+
+// DefaultImpersonationExpiry 代为登录令牌默认有效期
+const DefaultImpersonationExpiry = 15 * time.Minute
+
+// ImpersonationService 管理员代为登录服务，签发标记了实际操作者的短期访问令牌并记录审计日志
+type ImpersonationService struct {
+	orm    *ent.Client
+	auth   *AuthService
+	expiry time.Duration
+}
+
+// NewImpersonationService 创建代为登录服务
+func NewImpersonationService(orm *ent.Client, auth *AuthService, cfg config.AdminConfig) *ImpersonationService {
+	expiry := cfg.ImpersonationExpiry
+	if expiry <= 0 {
+		expiry = DefaultImpersonationExpiry
+	}
+
+	return &ImpersonationService{
+		orm:    orm,
+		auth:   auth,
+		expiry: expiry,
+	}
+}
+
+// Start 管理员代为登录用户账户，只签发访问令牌，过期后需要重新发起
+func (s *ImpersonationService) Start(ctx context.Context, adminID string, input *types.ImpersonateInput) (*types.ImpersonateOutput, error) {
+	if adminID == input.UserID {
+		return nil, apperrs.ErrBadRequest.With("admin_id", adminID).Public("不能代为登录自己的账户").Errorf("不能代为登录自己的账户")
+	}
+
+	target, err := s.orm.User.Get(ctx, input.UserID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperrs.ErrNotFound.With("user_id", input.UserID).Public("用户不存在").Errorf("用户不存在")
+		}
+		slog.ErrorContext(ctx, "查询用户失败", "error", err, "user_id", input.UserID)
+		return nil, apperrs.ErrDatabase.With("user_id", input.UserID).With("原始错误", err).Errorf("查询用户失败")
+	}
+	if err := s.auth.validateUser(ctx, target); err != nil {
+		return nil, err
+	}
+	// 不允许借助代为登录获得其他管理员的权限
+	if target.Role == userEnt.RoleAdmin {
+		return nil, apperrs.ErrForbidden.With("user_id", target.ID).Public("不能代为登录管理员账户").Errorf("不能代为登录管理员账户")
+	}
+
+	grant := tokenGrant{Actor: adminID, Expiry: s.expiry}
+	tokenID := utils.GenerateULID()
+	familyID := utils.GenerateULID()
+	accessToken, expiresAt, err := s.auth.generateToken(ctx, tokenID, target.ID, types.TokenTypeAccess, grant)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.orm.Tx(ctx)
+	if err != nil {
+		return nil, apperrs.ErrDatabase.With("user_id", target.ID).With("原始错误", err).Errorf("开启事务失败")
+	}
+	defer tx.Rollback()
+
+	if err := s.auth.saveTokenToDatabase(ctx, tx, tokenID, target.ID, familyID, accessToken, types.TokenTypeAccess, expiresAt, grant); err != nil {
+		return nil, err
+	}
+
+	record, err := tx.Impersonation.Create().
+		SetID(utils.GenerateULID()).
+		SetAdminID(adminID).
+		SetUserID(target.ID).
+		SetReason(input.Reason).
+		SetFamilyID(familyID).
+		SetStartedAt(time.Now()).
+		SetExpiresAt(expiresAt).
+		SetUserAgent(utils.Truncate(appctx.MustGetUserAgentFromContext(ctx), 512)).
+		SetIP(utils.Truncate(appctx.MustGetClientIPFromContext(ctx), 64)).
+		Save(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "保存代为登录记录失败", "error", err, "admin_id", adminID, "user_id", target.ID)
+		return nil, apperrs.ErrDatabase.With("admin_id", adminID).With("user_id", target.ID).With("原始错误", err).Errorf("保存代为登录记录失败")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, apperrs.ErrDatabase.With("user_id", target.ID).With("原始错误", err).Errorf("提交事务失败")
+	}
+
+	slog.InfoContext(ctx, "管理员开始代为登录",
+		"admin_id", adminID,
+		"user_id", target.ID,
+		"impersonation_id", record.ID,
+		"reason", input.Reason,
+		"expires_at", expiresAt,
+	)
+	return &types.ImpersonateOutput{
+		ID: record.ID,
+		User: &types.UserInfo{
+			ID:               target.ID,
+			Username:         target.Username,
+			Email:            target.Email,
+			Status:           string(target.Status),
+			TwoFactorEnabled: target.TwoFactorEnabled,
+			LastLoginAt:      target.LastLoginAt,
+			CreatedAt:        target.CreatedAt,
+		},
+		AccessToken: accessToken,
+		ExpiresAt:   expiresAt.Unix(),
+	}, nil
+}
+
+// End 结束代为登录并撤销签发的令牌，已结束的记录重复结束时直接返回
+func (s *ImpersonationService) End(ctx context.Context, input *types.EndImpersonationInput) error {
+	record, err := s.orm.Impersonation.Get(ctx, input.ID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return apperrs.ErrNotFound.With("impersonation_id", input.ID).Public("代为登录记录不存在").Errorf("代为登录记录不存在")
+		}
+		slog.ErrorContext(ctx, "查询代为登录记录失败", "error", err, "impersonation_id", input.ID)
+		return apperrs.ErrDatabase.With("impersonation_id", input.ID).With("原始错误", err).Errorf("查询代为登录记录失败")
+	}
+
+	tx, err := s.orm.Tx(ctx)
+	if err != nil {
+		return apperrs.ErrDatabase.With("impersonation_id", input.ID).With("原始错误", err).Errorf("开启事务失败")
+	}
+	defer tx.Rollback()
+
+	if _, err := revokeTokenFamily(ctx, tx.Token, record.FamilyID); err != nil {
+		return err
+	}
+	if err := endImpersonation(ctx, tx.Impersonation, record.FamilyID); err != nil {
+		return err
+	}
+	s.auth.cache.InvalidateUserOnCommit(tx, record.UserID)
+
+	if err := tx.Commit(); err != nil {
+		return apperrs.ErrDatabase.With("impersonation_id", input.ID).With("原始错误", err).Errorf("提交事务失败")
+	}
+
+	slog.InfoContext(ctx, "已结束代为登录", "impersonation_id", record.ID, "user_id", record.UserID)
+	return nil
+}
+
+// List 分页查询代为登录审计记录，按开始时间倒序
+func (s *ImpersonationService) List(ctx context.Context, input *types.ListImpersonationsInput) (*types.ListImpersonationsOutput, error) {
+	types.NormalizePage(&input.PageInput)
+
+	query := s.orm.Impersonation.Query()
+	if input.AdminID != "" {
+		query = query.Where(impersonation.AdminID(input.AdminID))
+	}
+	if input.UserID != "" {
+		query = query.Where(impersonation.UserID(input.UserID))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "统计代为登录记录失败", "error", err)
+		return nil, apperrs.ErrDatabase.With("原始错误", err).Errorf("查询代为登录记录失败")
+	}
+
+	records, err := query.
+		Order(ent.Desc(impersonation.FieldStartedAt), ent.Desc(impersonation.FieldID)).
+		Offset(input.Offset()).
+		Limit(input.Limit()).
+		All(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "查询代为登录记录失败", "error", err)
+		return nil, apperrs.ErrDatabase.With("原始错误", err).Errorf("查询代为登录记录失败")
+	}
+
+	out := &types.ListImpersonationsOutput{
+		Impersonations: make([]*types.ImpersonationInfo, 0, len(records)),
+		PageOutput:     types.NewPageOutput(input.PageInput, total),
+	}
+	for _, record := range records {
+		out.Impersonations = append(out.Impersonations, &types.ImpersonationInfo{
+			ID:        record.ID,
+			AdminID:   record.AdminID,
+			UserID:    record.UserID,
+			Reason:    record.Reason,
+			IP:        record.IP,
+			UserAgent: record.UserAgent,
+			StartedAt: record.StartedAt,
+			ExpiresAt: record.ExpiresAt,
+			EndedAt:   record.EndedAt,
+		})
+	}
+	return out, nil
+}
+
+// endImpersonation 记录代为登录的结束时间
+func endImpersonation(ctx context.Context, ic *ent.ImpersonationClient, familyID string) error {
+	err := ic.Update().
+		Where(
+			impersonation.FamilyID(familyID),
+			impersonation.EndedAtIsNil(),
+		).
+		SetEndedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "记录代为登录结束时间失败", "error", err, "family_id", familyID)
+		return apperrs.ErrDatabase.With("family_id", familyID).With("原始错误", err).Errorf("记录代为登录结束时间失败")
+	}
+	return nil
+}
+
+// DenyImpersonation 拒绝管理员代为登录时执行敏感操作，例如修改密码
+func DenyImpersonation(ctx context.Context) error {
+	if adminID, ok := appctx.GetImpersonatorFromContext(ctx); ok {
+		slog.WarnContext(ctx, "代为登录时尝试执行敏感操作")
+		return apperrs.ErrForbidden.With("impersonator_id", adminID).Public("代为登录时不能执行此操作").Errorf("代为登录时不能执行敏感操作")
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/liukeshao/echo-template/config"
+	"github.com/liukeshao/echo-template/ent/user"
+	"github.com/liukeshao/echo-template/pkg/appctx"
+	"github.com/liukeshao/echo-template/pkg/apperrs"
+	"github.com/liukeshao/echo-template/pkg/types"
+)
+
+func TestImpersonation(t *testing.T) {
+	auth, client := newTestAuthService(t)
+	ctx := context.Background()
+	registerTestUser(t, auth)
+	userID := client.User.Query().OnlyIDX(ctx)
+
+	admin := client.User.Create().
+		SetID("01HZADMIN00000000000000000").
+		SetUsername("admin").
+		SetEmail("admin@example.com").
+		SetPasswordHash("unused").
+		SetRole(user.RoleAdmin).
+		SaveX(ctx)
+
+	impersonation := NewImpersonationService(client, auth, config.AdminConfig{ImpersonationExpiry: 10 * time.Minute})
+
+	// 不能代为登录管理员账户
+	_, err := impersonation.Start(ctx, userID, &types.ImpersonateInput{UserID: admin.ID, Reason: "test"})
+	assertErrorCode(t, err, apperrs.CodeForbidden)
+
+	out, err := impersonation.Start(ctx, admin.ID, &types.ImpersonateInput{UserID: userID, Reason: "工单 #42"})
+	require.NoError(t, err)
+	assert.Equal(t, userID, out.User.ID)
+	assert.LessOrEqual(t, out.ExpiresAt, time.Now().Add(10*time.Minute).Unix())
+
+	// 令牌声明和令牌记录都标记了实际操作者
+	claims, err := auth.ValidateToken(out.AccessToken)
+	require.NoError(t, err)
+	require.NotNil(t, claims.Actor)
+	assert.Equal(t, admin.ID, claims.Actor.Subject)
+	authed, dbToken, err := auth.AuthenticateUser(ctx, out.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, userID, authed.ID)
+	assert.Equal(t, admin.ID, dbToken.ImpersonatorID)
+
+	// 代为登录时不能修改密码
+	me := NewMeService(client, auth, auth.passwords, auth.hasher)
+	impersonated := appctx.WithImpersonator(ctx, dbToken.ImpersonatorID)
+	_, err = me.ChangePassword(impersonated, userID, &types.ChangePasswordInput{OldPassword: "password123", NewPassword: "new-password-456"})
+	assertErrorCode(t, err, apperrs.CodeForbidden)
+
+	// 退出后记录结束时间并撤销令牌
+	require.NoError(t, auth.Logout(ctx, out.AccessToken))
+	record := client.Impersonation.GetX(ctx, out.ID)
+	assert.Equal(t, admin.ID, record.AdminID)
+	assert.Equal(t, "工单 #42", record.Reason)
+	assert.NotNil(t, record.EndedAt)
+	_, _, err = auth.AuthenticateUser(ctx, out.AccessToken)
+	assertErrorCode(t, err, apperrs.CodeUnauthorized)
+
+	// 管理员可以提前结束代为登录
+	second, err := impersonation.Start(ctx, admin.ID, &types.ImpersonateInput{UserID: userID, Reason: "复现问题"})
+	require.NoError(t, err)
+	require.NoError(t, impersonation.End(ctx, &types.EndImpersonationInput{ID: second.ID}))
+	_, _, err = auth.AuthenticateUser(ctx, second.AccessToken)
+	assertErrorCode(t, err, apperrs.CodeUnauthorized)
+
+	listed, err := impersonation.List(ctx, &types.ListImpersonationsInput{UserID: userID})
+	require.NoError(t, err)
+	assert.Equal(t, 2, listed.Total)
+	assert.Equal(t, second.ID, listed.Impersonations[0].ID)
+}
@@ -0,0 +1,221 @@
+package services
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"os"
+	"sort"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/liukeshao/echo-template/config"
+	"github.com/liukeshao/echo-template/pkg/apperrs"
+	"github.com/liukeshao/echo-template/pkg/types"
+)
+
+// jwtKey 单个JWT密钥
+type jwtKey struct {
+	id         string
+	method     jwt.SigningMethod
+	signingKey any // 仅验证用的密钥为 nil
+	verifyKey  any
+}
+
+// KeySet JWT签名与验证密钥集合
+type KeySet struct {
+	signing *jwtKey            // 当前签名密钥
+	keys    map[string]*jwtKey // 按 kid 索引的非对称密钥
+	legacy  *jwtKey            // 不带 kid 的 HS256 密钥
+}
+
+// NewHMACKeySet 创建只使用共享密钥进行 HS256 签名的密钥集合
+func NewHMACKeySet(secret string) *KeySet {
+	key := &jwtKey{
+		method:     jwt.SigningMethodHS256,
+		signingKey: []byte(secret),
+		verifyKey:  []byte(secret),
+	}
+	return &KeySet{signing: key, keys: map[string]*jwtKey{}, legacy: key}
+}
+
+// NewKeySetFromConfig 根据配置加载密钥集合。
+// 未配置非对称密钥时退化为 HS256；配置了 Secret 时继续接受不带 kid 的 HS256 令牌，便于平滑迁移。
+func NewKeySetFromConfig(cfg config.JWTConfig) (*KeySet, error) {
+	if len(cfg.Keys) == 0 {
+		if cfg.Secret == "" {
+			return nil, fmt.Errorf("jwt: 未配置 secret 或签名密钥")
+		}
+		return NewHMACKeySet(cfg.Secret), nil
+	}
+
+	ks := &KeySet{keys: make(map[string]*jwtKey, len(cfg.Keys))}
+	for _, kc := range cfg.Keys {
+		key, err := loadJWTKey(kc)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := ks.keys[key.id]; ok {
+			return nil, fmt.Errorf("jwt: 重复的密钥ID %q", key.id)
+		}
+		ks.keys[key.id] = key
+	}
+
+	if cfg.Secret != "" {
+		ks.legacy = NewHMACKeySet(cfg.Secret).legacy
+	}
+
+	if cfg.SigningKeyID == "" {
+		if ks.legacy == nil {
+			return nil, fmt.Errorf("jwt: 未指定 signingKeyID")
+		}
+		ks.signing = ks.legacy
+		return ks, nil
+	}
+
+	signing, ok := ks.keys[cfg.SigningKeyID]
+	if !ok {
+		return nil, fmt.Errorf("jwt: 签名密钥 %q 不存在", cfg.SigningKeyID)
+	}
+	if signing.signingKey == nil {
+		return nil, fmt.Errorf("jwt: 签名密钥 %q 未配置私钥", cfg.SigningKeyID)
+	}
+	ks.signing = signing
+
+	return ks, nil
+}
+
+// loadJWTKey 从PEM文件加载单个非对称密钥
+func loadJWTKey(kc config.JWTKeyConfig) (*jwtKey, error) {
+	if kc.ID == "" {
+		return nil, fmt.Errorf("jwt: 密钥缺少 id")
+	}
+
+	method := jwt.GetSigningMethod(kc.Algorithm)
+	if method == nil {
+		return nil, fmt.Errorf("jwt: 密钥 %q 使用了不支持的算法 %q", kc.ID, kc.Algorithm)
+	}
+
+	var parsePrivate func([]byte) (crypto.PrivateKey, error)
+	var parsePublic func([]byte) (crypto.PublicKey, error)
+	switch method.(type) {
+	case *jwt.SigningMethodRSA, *jwt.SigningMethodRSAPSS:
+		parsePrivate = func(b []byte) (crypto.PrivateKey, error) { return jwt.ParseRSAPrivateKeyFromPEM(b) }
+		parsePublic = func(b []byte) (crypto.PublicKey, error) { return jwt.ParseRSAPublicKeyFromPEM(b) }
+	case *jwt.SigningMethodECDSA:
+		parsePrivate = func(b []byte) (crypto.PrivateKey, error) { return jwt.ParseECPrivateKeyFromPEM(b) }
+		parsePublic = func(b []byte) (crypto.PublicKey, error) { return jwt.ParseECPublicKeyFromPEM(b) }
+	case *jwt.SigningMethodEd25519:
+		parsePrivate = jwt.ParseEdPrivateKeyFromPEM
+		parsePublic = jwt.ParseEdPublicKeyFromPEM
+	default:
+		return nil, fmt.Errorf("jwt: 密钥 %q 的算法 %q 不是非对称算法", kc.ID, kc.Algorithm)
+	}
+
+	key := &jwtKey{id: kc.ID, method: method}
+	switch {
+	case kc.PrivateKeyFile != "":
+		data, err := os.ReadFile(kc.PrivateKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("jwt: 读取密钥 %q 的私钥失败: %w", kc.ID, err)
+		}
+		priv, err := parsePrivate(data)
+		if err != nil {
+			return nil, fmt.Errorf("jwt: 解析密钥 %q 的私钥失败: %w", kc.ID, err)
+		}
+		signer, ok := priv.(crypto.Signer)
+		if !ok {
+			return nil, fmt.Errorf("jwt: 密钥 %q 的私钥类型不受支持", kc.ID)
+		}
+		key.signingKey = priv
+		key.verifyKey = signer.Public()
+	case kc.PublicKeyFile != "":
+		data, err := os.ReadFile(kc.PublicKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("jwt: 读取密钥 %q 的公钥失败: %w", kc.ID, err)
+		}
+		pub, err := parsePublic(data)
+		if err != nil {
+			return nil, fmt.Errorf("jwt: 解析密钥 %q 的公钥失败: %w", kc.ID, err)
+		}
+		key.verifyKey = pub
+	default:
+		return nil, fmt.Errorf("jwt: 密钥 %q 未配置私钥或公钥文件", kc.ID)
+	}
+
+	// ECDSA 算法与曲线必须匹配，例如 ES256 只能使用 P-256
+	if m, ok := method.(*jwt.SigningMethodECDSA); ok {
+		pub, _ := key.verifyKey.(*ecdsa.PublicKey)
+		if pub == nil || pub.Curve.Params().BitSize != m.CurveBits {
+			return nil, fmt.Errorf("jwt: 密钥 %q 的曲线与算法 %q 不匹配", kc.ID, kc.Algorithm)
+		}
+	}
+
+	return key, nil
+}
+
+// Sign 使用当前签名密钥签发令牌
+func (ks *KeySet) Sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(ks.signing.method, claims)
+	if ks.signing.id != "" {
+		token.Header["kid"] = ks.signing.id
+	}
+	return token.SignedString(ks.signing.signingKey)
+}
+
+// Keyfunc 根据令牌头部的 kid 和 alg 选择验证密钥
+func (ks *KeySet) Keyfunc(token *jwt.Token) (any, error) {
+	key := ks.legacy
+	if kid, _ := token.Header["kid"].(string); kid != "" {
+		key = ks.keys[kid]
+	}
+	if key == nil {
+		return nil, apperrs.ErrUnauthorized.With("kid", token.Header["kid"]).Errorf("未知的签名密钥")
+	}
+
+	// 签名算法必须与密钥声明的算法一致，防止算法混淆攻击
+	if token.Method.Alg() != key.method.Alg() {
+		return nil, apperrs.ErrUnauthorized.With("alg", token.Method.Alg()).Errorf("无效的签名方法")
+	}
+
+	return key.verifyKey, nil
+}
+
+// JWKS 返回所有非对称密钥的公钥集合
+func (ks *KeySet) JWKS() *types.JWKSOutput {
+	out := &types.JWKSOutput{Keys: make([]types.JWK, 0, len(ks.keys))}
+	for _, key := range ks.keys {
+		jwk := types.JWK{Kid: key.id, Use: "sig", Alg: key.method.Alg()}
+		switch pub := key.verifyKey.(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = base64URL(pub.N.Bytes())
+			jwk.E = base64URL(big.NewInt(int64(pub.E)).Bytes())
+		case *ecdsa.PublicKey:
+			size := (pub.Curve.Params().BitSize + 7) / 8
+			jwk.Kty = "EC"
+			jwk.Crv = pub.Curve.Params().Name
+			jwk.X = base64URL(pub.X.FillBytes(make([]byte, size)))
+			jwk.Y = base64URL(pub.Y.FillBytes(make([]byte, size)))
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = base64URL(pub)
+		default:
+			continue
+		}
+		out.Keys = append(out.Keys, jwk)
+	}
+
+	sort.Slice(out.Keys, func(i, j int) bool { return out.Keys[i].Kid < out.Keys[j].Kid })
+	return out
+}
+
+// base64URL 按 JWK 要求进行无填充的 base64url 编码
+func base64URL(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
@@ -0,0 +1,130 @@
+package services
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/liukeshao/echo-template/config"
+)
+
+// writePrivateKeyPEM 将私钥以PKCS#8 PEM格式写入临时目录
+func writePrivateKeyPEM(t *testing.T, name string, key crypto.PrivateKey) string {
+	t.Helper()
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), name+".pem")
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600))
+	return path
+}
+
+func testClaims() jwt.Claims {
+	return jwt.RegisteredClaims{
+		Subject:   "user",
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}
+}
+
+func TestKeySetSignAndVerify(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	keys := []config.JWTKeyConfig{
+		{ID: "rsa", Algorithm: "RS256", PrivateKeyFile: writePrivateKeyPEM(t, "rsa", rsaKey)},
+		{ID: "ec", Algorithm: "ES256", PrivateKeyFile: writePrivateKeyPEM(t, "ec", ecKey)},
+		{ID: "ed", Algorithm: "EdDSA", PrivateKeyFile: writePrivateKeyPEM(t, "ed", edKey)},
+	}
+
+	for _, kc := range keys {
+		t.Run(kc.Algorithm, func(t *testing.T) {
+			ks, err := NewKeySetFromConfig(config.JWTConfig{SigningKeyID: kc.ID, Keys: keys})
+			require.NoError(t, err)
+
+			signed, err := ks.Sign(testClaims())
+			require.NoError(t, err)
+
+			token, err := jwt.Parse(signed, ks.Keyfunc)
+			require.NoError(t, err)
+			assert.Equal(t, kc.ID, token.Header["kid"])
+			assert.Equal(t, kc.Algorithm, token.Method.Alg())
+		})
+	}
+
+	ks, err := NewKeySetFromConfig(config.JWTConfig{SigningKeyID: "rsa", Keys: keys})
+	require.NoError(t, err)
+	jwks := ks.JWKS()
+	require.Len(t, jwks.Keys, 3, "JWKS应包含所有非对称公钥")
+	assert.Equal(t, "EC", jwks.Keys[0].Kty)
+	assert.Equal(t, "P-256", jwks.Keys[0].Crv)
+	assert.Equal(t, "OKP", jwks.Keys[1].Kty)
+	assert.Equal(t, "RSA", jwks.Keys[2].Kty)
+	assert.Equal(t, "AQAB", jwks.Keys[2].E)
+}
+
+func TestKeySetRotation(t *testing.T) {
+	oldKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	newKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	oldConfig := config.JWTKeyConfig{ID: "old", Algorithm: "ES256", PrivateKeyFile: writePrivateKeyPEM(t, "old", oldKey)}
+	newConfig := config.JWTKeyConfig{ID: "new", Algorithm: "ES256", PrivateKeyFile: writePrivateKeyPEM(t, "new", newKey)}
+
+	before, err := NewKeySetFromConfig(config.JWTConfig{SigningKeyID: "old", Keys: []config.JWTKeyConfig{oldConfig}})
+	require.NoError(t, err)
+	issued, err := before.Sign(testClaims())
+	require.NoError(t, err)
+
+	// 轮换后旧密钥仍在验证集合中，之前签发的令牌依然有效
+	after, err := NewKeySetFromConfig(config.JWTConfig{SigningKeyID: "new", Keys: []config.JWTKeyConfig{newConfig, oldConfig}})
+	require.NoError(t, err)
+	_, err = jwt.Parse(issued, after.Keyfunc)
+	assert.NoError(t, err)
+
+	// 旧密钥移除后令牌失效
+	removed, err := NewKeySetFromConfig(config.JWTConfig{SigningKeyID: "new", Keys: []config.JWTKeyConfig{newConfig}})
+	require.NoError(t, err)
+	_, err = jwt.Parse(issued, removed.Keyfunc)
+	assert.Error(t, err)
+}
+
+func TestKeySetRejectsAlgorithmConfusion(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	ks, err := NewKeySetFromConfig(config.JWTConfig{
+		SigningKeyID: "rsa",
+		Keys:         []config.JWTKeyConfig{{ID: "rsa", Algorithm: "RS256", PrivateKeyFile: writePrivateKeyPEM(t, "rsa", rsaKey)}},
+	})
+	require.NoError(t, err)
+
+	// 使用公钥作为HMAC密钥伪造的令牌必须被拒绝
+	pubDER, err := x509.MarshalPKIXPublicKey(&rsaKey.PublicKey)
+	require.NoError(t, err)
+	forged := jwt.NewWithClaims(jwt.SigningMethodHS256, testClaims())
+	forged.Header["kid"] = "rsa"
+	signed, err := forged.SignedString(pubDER)
+	require.NoError(t, err)
+
+	_, err = jwt.Parse(signed, ks.Keyfunc)
+	assert.Error(t, err)
+}
@@ -0,0 +1,174 @@
+package services
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/liukeshao/echo-template/config"
+)
+
+// 登录保护默认参数
+const (
+	DefaultLoginAccountThreshold = 5
+	DefaultLoginIPThreshold      = 20
+	DefaultLoginBaseDelay        = time.Second
+	DefaultLoginMaxDelay         = time.Minute
+	DefaultLoginLockoutDuration  = 15 * time.Minute
+	DefaultLoginWindow           = 15 * time.Minute
+
+	// loginGuardMaxEntries 记录数超过该值时清理已过期的记录
+	loginGuardMaxEntries = 10000
+)
+
+// loginFailures 某个账户或IP的连续失败记录
+type loginFailures struct {
+	count       int
+	lastFailure time.Time
+}
+
+// LoginGuard 按账户和IP记录登录失败次数的内存防暴力破解保护。
+// 每次失败后需等待指数增长的时间才能再次尝试，达到阈值后锁定一段时间。
+type LoginGuard struct {
+	accountThreshold int
+	ipThreshold      int
+	baseDelay        time.Duration
+	maxDelay         time.Duration
+	lockout          time.Duration
+	window           time.Duration
+	now              func() time.Time
+
+	mu       sync.Mutex
+	failures map[string]*loginFailures
+}
+
+// NewLoginGuard 创建登录保护，未配置的参数使用默认值
+func NewLoginGuard(cfg config.LoginProtectionConfig) *LoginGuard {
+	g := &LoginGuard{
+		accountThreshold: cfg.AccountThreshold,
+		ipThreshold:      cfg.IPThreshold,
+		baseDelay:        cfg.BaseDelay,
+		maxDelay:         cfg.MaxDelay,
+		lockout:          cfg.LockoutDuration,
+		window:           cfg.Window,
+		now:              time.Now,
+		failures:         make(map[string]*loginFailures),
+	}
+	if g.accountThreshold <= 0 {
+		g.accountThreshold = DefaultLoginAccountThreshold
+	}
+	if g.ipThreshold <= 0 {
+		g.ipThreshold = DefaultLoginIPThreshold
+	}
+	if g.baseDelay <= 0 {
+		g.baseDelay = DefaultLoginBaseDelay
+	}
+	if g.maxDelay <= 0 {
+		g.maxDelay = DefaultLoginMaxDelay
+	}
+	if g.lockout <= 0 {
+		g.lockout = DefaultLoginLockoutDuration
+	}
+	if g.window <= 0 {
+		g.window = DefaultLoginWindow
+	}
+	// 失败记录至少保留到锁定结束
+	g.window = max(g.window, g.lockout)
+	return g
+}
+
+// accountKey 账户维度的记录键，邮箱不区分大小写
+func accountKey(email string) string {
+	return "account:" + strings.ToLower(strings.TrimSpace(email))
+}
+
+// ipKey IP维度的记录键
+func ipKey(ip string) string {
+	return "ip:" + ip
+}
+
+// Check 返回账户或IP仍需等待的时间，0表示允许尝试登录
+func (g *LoginGuard) Check(email string, ip string) time.Duration {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	now := g.now()
+	wait := g.wait(accountKey(email), g.accountThreshold, now)
+	if ip != "" {
+		wait = max(wait, g.wait(ipKey(ip), g.ipThreshold, now))
+	}
+	return wait
+}
+
+// RecordFailure 记录一次登录失败
+func (g *LoginGuard) RecordFailure(email string, ip string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	now := g.now()
+	if len(g.failures) >= loginGuardMaxEntries {
+		g.sweep(now)
+	}
+
+	keys := []string{accountKey(email)}
+	if ip != "" {
+		keys = append(keys, ipKey(ip))
+	}
+	for _, key := range keys {
+		f := g.failures[key]
+		if f == nil || now.Sub(f.lastFailure) > g.window {
+			f = &loginFailures{}
+			g.failures[key] = f
+		}
+		f.count++
+		f.lastFailure = now
+	}
+}
+
+// RecordSuccess 登录成功后清除账户的失败记录。
+// IP的失败记录不清除，避免攻击者用自己的账户登录来重置计数。
+func (g *LoginGuard) RecordSuccess(email string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	delete(g.failures, accountKey(email))
+}
+
+// wait 计算某个键仍需等待的时间，调用方需持有锁
+func (g *LoginGuard) wait(key string, threshold int, now time.Time) time.Duration {
+	f := g.failures[key]
+	if f == nil {
+		return 0
+	}
+	if now.Sub(f.lastFailure) > g.window {
+		delete(g.failures, key)
+		return 0
+	}
+
+	return max(f.lastFailure.Add(g.delay(f.count, threshold)).Sub(now), 0)
+}
+
+// delay 连续失败 count 次后需要等待的时间：达到阈值后锁定，之前按指数退避
+func (g *LoginGuard) delay(count int, threshold int) time.Duration {
+	if count >= threshold {
+		return g.lockout
+	}
+	if count <= 0 || g.baseDelay == 0 {
+		return 0
+	}
+
+	delay := g.baseDelay
+	for i := 1; i < count && delay < g.maxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, g.maxDelay)
+}
+
+// sweep 清理超出时间窗口的记录，调用方需持有锁
+func (g *LoginGuard) sweep(now time.Time) {
+	for key, f := range g.failures {
+		if now.Sub(f.lastFailure) > g.window {
+			delete(g.failures, key)
+		}
+	}
+}
@@ -0,0 +1,217 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/liukeshao/echo-template/config"
+	"github.com/liukeshao/echo-template/ent"
+	"github.com/liukeshao/echo-template/ent/onetimetoken"
+	userEnt "github.com/liukeshao/echo-template/ent/user"
+	"github.com/liukeshao/echo-template/pkg/appctx"
+	"github.com/liukeshao/echo-template/pkg/apperrs"
+	"github.com/liukeshao/echo-template/pkg/mail"
+	"github.com/liukeshao/echo-template/pkg/types"
+	"github.com/liukeshao/echo-template/pkg/utils"
+)
+
+// DefaultMagicLinkExpiry 登录链接默认有效期
+const DefaultMagicLinkExpiry = 15 * time.Minute
+
+// MagicLinkService 邮件链接免密码登录服务
+type MagicLinkService struct {
+	orm      *ent.Client
+	mailer   mail.Mailer
+	auth     *AuthService
+	hashKey  string
+	enabled  bool
+	linkURL  string
+	expiry   time.Duration
+	throttle *EmailThrottle
+
+	pending sync.WaitGroup // 正在后台发送的登录链接邮件
+}
+
+// NewMagicLinkService 创建邮件链接登录服务，未配置登录页面地址时使用 host + /magic-link
+func NewMagicLinkService(orm *ent.Client, mailer mail.Mailer, auth *AuthService, hashKey string, host string, cfg config.AccountConfig) *MagicLinkService {
+	expiry := cfg.MagicLinkExpiry
+	if expiry <= 0 {
+		expiry = DefaultMagicLinkExpiry
+	}
+	linkURL := cfg.MagicLinkURL
+	if linkURL == "" {
+		linkURL = strings.TrimRight(host, "/") + "/magic-link"
+	}
+
+	return &MagicLinkService{
+		orm:      orm,
+		mailer:   mailer,
+		auth:     auth,
+		hashKey:  hashKey,
+		enabled:  cfg.MagicLinkEnabled,
+		linkURL:  linkURL,
+		expiry:   expiry,
+		throttle: NewEmailThrottle(cfg),
+	}
+}
+
+// Enabled 是否允许通过邮件链接登录
+func (s *MagicLinkService) Enabled() bool {
+	return s != nil && s.enabled
+}
+
+// RequestLink 发送登录链接，链接绑定到返回给发起请求的浏览器的随机值。
+// 无论邮箱是否注册都返回同样的结果，不暴露账户是否存在；签发令牌和发送邮件在后台完成，两种情况下请求的耗时相同
+func (s *MagicLinkService) RequestLink(ctx context.Context, input *types.MagicLinkInput) (*types.MagicLinkOutput, error) {
+	if !s.Enabled() {
+		return nil, apperrs.ErrForbidden.Public("未开启邮件链接登录").Errorf("未开启邮件链接登录")
+	}
+
+	// 限制申请频率，防止反复申请作废用户刚收到的链接
+	if err := s.throttle.Allow(input.Email, appctx.MustGetClientIPFromContext(ctx)); err != nil {
+		slog.WarnContext(ctx, "邮件链接登录：申请过于频繁", "email", input.Email)
+		return nil, err
+	}
+
+	out := &types.MagicLinkOutput{
+		Nonce:     utils.GenerateRandomToken(),
+		ExpiresAt: time.Now().Add(s.expiry).Unix(),
+	}
+
+	user, err := s.orm.User.Query().
+		Where(userEnt.Email(input.Email)).
+		Only(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		slog.InfoContext(ctx, "邮件链接登录：邮箱未注册", "email", input.Email)
+		return out, nil
+	case err != nil:
+		slog.ErrorContext(ctx, "查询用户失败", "error", err, "email", input.Email)
+		return nil, apperrs.ErrDatabase.With("email", input.Email).With("原始错误", err).Errorf("查询用户失败")
+	}
+
+	if user.Status == userEnt.StatusSuspended {
+		slog.InfoContext(ctx, "邮件链接登录：账户已被停用", "user_id", user.ID)
+		return out, nil
+	}
+
+	// 请求结束后仍需完成发送，后台任务不随请求取消
+	s.pending.Add(1)
+	go func() {
+		defer s.pending.Done()
+		s.sendLinkMail(context.WithoutCancel(ctx), user, out.Nonce)
+	}()
+	return out, nil
+}
+
+// Wait 等待后台发送中的登录链接邮件完成
+func (s *MagicLinkService) Wait(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		s.pending.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// sendLinkMail 签发绑定随机值的登录令牌并发送登录链接邮件，失败时只记录日志
+func (s *MagicLinkService) sendLinkMail(ctx context.Context, user *ent.User, nonce string) {
+	raw, err := issueBoundOneTimeToken(ctx, s.orm.OneTimeToken, s.hashKey, user.ID, onetimetoken.PurposeMagicLink, s.expiry, nonce)
+	if err != nil {
+		return
+	}
+
+	link := s.linkURL + "?token=" + url.QueryEscape(raw)
+	msg := &mail.Message{
+		To:      user.Email,
+		Subject: "登录链接",
+		Body: fmt.Sprintf("%s，您好：\n\n请在申请登录的浏览器中点击以下链接登录，链接%s内有效且只能使用一次：\n\n%s\n\n如果这不是您本人的操作，请忽略此邮件。",
+			user.Username, formatExpiry(s.expiry), link),
+	}
+	if err := s.mailer.Send(ctx, msg); err != nil {
+		slog.ErrorContext(ctx, "发送登录链接邮件失败", "error", err, "user_id", user.ID)
+		return
+	}
+
+	slog.InfoContext(ctx, "已发送登录链接邮件", "user_id", user.ID)
+}
+
+// Verify 使用登录链接登录，只有提供申请链接时返回的随机值才能使用，防止链接被转发后在其他设备登录
+func (s *MagicLinkService) Verify(ctx context.Context, input *types.MagicLinkVerifyInput) (*types.AuthOutput, error) {
+	if !s.Enabled() {
+		return nil, apperrs.ErrForbidden.Public("未开启邮件链接登录").Errorf("未开启邮件链接登录")
+	}
+
+	ott, err := findOneTimeToken(ctx, s.orm.OneTimeToken, s.hashKey, input.Token, onetimetoken.PurposeMagicLink)
+	if err != nil {
+		return nil, err
+	}
+	if ott == nil {
+		return nil, apperrs.ErrBadRequest.Public("链接无效或已过期").Errorf("登录链接无效或已过期")
+	}
+
+	// 随机值不匹配时不使用令牌，发起请求的浏览器仍可以完成登录
+	if !hmac.Equal([]byte(ott.NonceHash), []byte(utils.HashToken(s.hashKey, input.Nonce))) {
+		slog.WarnContext(ctx, "登录链接与浏览器不匹配", "user_id", ott.UserID)
+		return nil, apperrs.ErrBadRequest.With("user_id", ott.UserID).Public("请在申请登录链接的浏览器中打开此链接").Errorf("登录链接随机值不匹配")
+	}
+
+	ok, err := useOneTimeToken(ctx, s.orm.OneTimeToken, ott)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, apperrs.ErrBadRequest.With("user_id", ott.UserID).Public("链接无效或已过期").Errorf("登录链接已被使用")
+	}
+
+	user, err := s.orm.User.Get(ctx, ott.UserID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperrs.ErrBadRequest.With("user_id", ott.UserID).Public("链接无效或已过期").Errorf("用户不存在")
+		}
+		slog.ErrorContext(ctx, "查询用户失败", "error", err, "user_id", ott.UserID)
+		return nil, apperrs.ErrDatabase.With("user_id", ott.UserID).With("原始错误", err).Errorf("查询用户失败")
+	}
+
+	// 能收到登录邮件说明邮箱有效，待验证的账户同时完成激活
+	if user.Status == userEnt.StatusInactive {
+		user, err = s.orm.User.UpdateOne(user).SetStatus(userEnt.StatusActive).Save(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "激活账户失败", "error", err, "user_id", ott.UserID)
+			return nil, apperrs.ErrDatabase.With("user_id", ott.UserID).With("原始错误", err).Errorf("激活账户失败")
+		}
+	}
+	if err := s.auth.validateUser(ctx, user); err != nil {
+		return nil, err
+	}
+
+	// 开启两步验证的用户仍需完成两步验证
+	if user.TwoFactorEnabled {
+		return s.auth.issueMFAChallenge(ctx, user)
+	}
+
+	authOutput, err := s.auth.generateTokenPair(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 更新最后登录时间
+	if _, err := s.auth.updateLastLoginTime(ctx, user); err != nil {
+		slog.WarnContext(ctx, "更新最后登录时间失败", "error", err, "user_id", user.ID)
+	}
+
+	slog.InfoContext(ctx, "邮件链接登录成功", "user_id", user.ID)
+	return authOutput, nil
+}
@@ -0,0 +1,84 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/liukeshao/echo-template/config"
+	"github.com/liukeshao/echo-template/pkg/appctx"
+	"github.com/liukeshao/echo-template/pkg/apperrs"
+	"github.com/liukeshao/echo-template/pkg/mail"
+	"github.com/liukeshao/echo-template/pkg/types"
+)
+
+func TestMagicLinkLogin(t *testing.T) {
+	auth, _ := newTestAuthService(t)
+	ctx := context.Background()
+	registerTestUser(t, auth)
+
+	mailer := mail.NewMemoryMailer()
+	disabled := NewMagicLinkService(auth.orm, mailer, auth, "test-hash-key", "http://localhost", config.AccountConfig{})
+	_, err := disabled.RequestLink(ctx, &types.MagicLinkInput{Email: "tester@example.com"})
+	assertErrorCode(t, err, apperrs.CodeForbidden)
+
+	links := NewMagicLinkService(auth.orm, mailer, auth, "test-hash-key", "http://localhost", config.AccountConfig{MagicLinkEnabled: true})
+	now := time.Now()
+	links.throttle.now = func() time.Time { return now }
+
+	// 未注册的邮箱同样返回随机值且不发送邮件
+	unknown, err := links.RequestLink(ctx, &types.MagicLinkInput{Email: "nobody@example.com"})
+	require.NoError(t, err)
+	require.NoError(t, links.Wait(ctx))
+	assert.NotEmpty(t, unknown.Nonce)
+	assert.Empty(t, mailer.Messages())
+
+	// 邮件在后台发送
+	requested, err := links.RequestLink(ctx, &types.MagicLinkInput{Email: "tester@example.com"})
+	require.NoError(t, err)
+	require.NoError(t, links.Wait(ctx))
+	raw := tokenFromMail(t, mailer, "tester@example.com")
+
+	// 在其他浏览器打开转发的链接时拒绝，且不消耗链接
+	_, err = links.Verify(ctx, &types.MagicLinkVerifyInput{Token: raw, Nonce: unknown.Nonce})
+	assertErrorCode(t, err, apperrs.CodeBadRequest)
+
+	out, err := links.Verify(ctx, &types.MagicLinkVerifyInput{Token: raw, Nonce: requested.Nonce})
+	require.NoError(t, err)
+	_, _, err = auth.AuthenticateUser(ctx, out.AccessToken)
+	require.NoError(t, err)
+
+	// 链接只能使用一次
+	_, err = links.Verify(ctx, &types.MagicLinkVerifyInput{Token: raw, Nonce: requested.Nonce})
+	assertErrorCode(t, err, apperrs.CodeBadRequest)
+
+	// 冷却时间内不能再次申请，已发送的链接不会被作废
+	now = now.Add(DefaultEmailCooldown)
+	first, err := links.RequestLink(ctx, &types.MagicLinkInput{Email: "tester@example.com"})
+	require.NoError(t, err)
+	require.NoError(t, links.Wait(ctx))
+	firstRaw := tokenFromMail(t, mailer, "tester@example.com")
+	_, err = links.RequestLink(ctx, &types.MagicLinkInput{Email: "Tester@example.com"})
+	assertErrorCode(t, err, apperrs.CodeTooManyRequests)
+
+	// 冷却时间过后重新申请，之前的链接失效
+	now = now.Add(DefaultEmailCooldown)
+	_, err = links.RequestLink(ctx, &types.MagicLinkInput{Email: "tester@example.com"})
+	require.NoError(t, err)
+	require.NoError(t, links.Wait(ctx))
+	_, err = links.Verify(ctx, &types.MagicLinkVerifyInput{Token: firstRaw, Nonce: first.Nonce})
+	assertErrorCode(t, err, apperrs.CodeBadRequest)
+
+	// 同一IP的申请次数有上限，未注册的邮箱同样计数
+	ipCtx := appctx.WithClientIP(ctx, "203.0.113.7")
+	for i := range DefaultEmailIPLimit {
+		_, err = links.RequestLink(ipCtx, &types.MagicLinkInput{Email: fmt.Sprintf("user%d@example.com", i)})
+		require.NoError(t, err)
+	}
+	_, err = links.RequestLink(ipCtx, &types.MagicLinkInput{Email: "another@example.com"})
+	assertErrorCode(t, err, apperrs.CodeTooManyRequests)
+}
@@ -3,25 +3,42 @@ package services
 import (
 	"context"
 	"log/slog"
+	"time"
 
 	"github.com/samber/oops"
-	"golang.org/x/crypto/bcrypt"
 
 	"github.com/liukeshao/echo-template/ent"
+	"github.com/liukeshao/echo-template/ent/identity"
+	"github.com/liukeshao/echo-template/ent/oauthclient"
+	"github.com/liukeshao/echo-template/ent/passkey"
+	"github.com/liukeshao/echo-template/ent/personalaccesstoken"
+	"github.com/liukeshao/echo-template/ent/token"
 	"github.com/liukeshao/echo-template/ent/user"
+	"github.com/liukeshao/echo-template/pkg/appctx"
 	"github.com/liukeshao/echo-template/pkg/apperrs"
 	"github.com/liukeshao/echo-template/pkg/types"
+	"github.com/liukeshao/echo-template/pkg/utils"
 )
 
 // MeService 用户服务
 type MeService struct {
-	orm *ent.Client
+	orm       *ent.Client
+	auth      *AuthService
+	cache     *AuthCache
+	events    *SecurityEventService
+	passwords *PasswordPolicy
+	hasher    utils.PasswordHasher
 }
 
 // NewMeService 创建用户服务实例
-func NewMeService(orm *ent.Client) *MeService {
+func NewMeService(orm *ent.Client, auth *AuthService, passwords *PasswordPolicy, hasher utils.PasswordHasher) *MeService {
 	return &MeService{
-		orm: orm,
+		orm:       orm,
+		auth:      auth,
+		cache:     auth.cache,
+		events:    auth.events,
+		passwords: passwords,
+		hasher:    hasher,
 	}
 }
 
@@ -45,12 +62,14 @@ func (s *MeService) GetByID(ctx context.Context, userID string) (*types.UserOutp
 
 	return &types.UserOutput{
 		UserInfo: &types.UserInfo{
-			ID:          u.ID,
-			Username:    u.Username,
-			Email:       u.Email,
-			Status:      string(u.Status),
-			LastLoginAt: u.LastLoginAt,
-			CreatedAt:   u.CreatedAt,
+			ID:               u.ID,
+			Username:         u.Username,
+			Email:            u.Email,
+			PendingEmail:     u.PendingEmail,
+			Status:           string(u.Status),
+			TwoFactorEnabled: u.TwoFactorEnabled,
+			LastLoginAt:      u.LastLoginAt,
+			CreatedAt:        u.CreatedAt,
 		},
 	}, nil
 }
@@ -88,41 +107,8 @@ func (s *MeService) updateUsername(ctx context.Context, userID string, username
 		return errorBuilder.Wrapf(err, "更新用户名失败")
 	}
 
-	return nil
-}
-
-// updateEmail 更新邮箱
-func (s *MeService) updateEmail(ctx context.Context, userID string, email string) error {
-	// 创建带有服务上下文的错误构建器
-	errorBuilder := oops.FromContext(ctx).
-		In("me").
-		With("user_id", userID).
-		With("email", email)
-
-	// 检查邮箱是否已被其他用户使用
-	exists, err := s.orm.User.Query().
-		Where(
-			user.EmailEQ(email),
-			user.IDNEQ(userID),
-		).
-		Exist(ctx)
-	if err != nil {
-		slog.ErrorContext(ctx, "检查邮箱是否存在失败", "error", err)
-		return errorBuilder.Wrapf(err, "检查邮箱失败")
-	}
-	if exists {
-		return apperrs.ErrConflict.
-			Wrapf(errorBuilder.Errorf("邮箱已存在"), "邮箱冲突")
-	}
-
-	// 更新邮箱
-	err = s.orm.User.UpdateOneID(userID).
-		SetEmail(email).
-		Exec(ctx)
-	if err != nil {
-		slog.ErrorContext(ctx, "更新邮箱失败", "error", err, "user_id", userID)
-		return errorBuilder.Wrapf(err, "更新邮箱失败")
-	}
+	// 用户信息变更后已缓存的用户快照立即失效
+	s.cache.InvalidateUser(userID)
 
 	return nil
 }
@@ -166,26 +152,31 @@ func (s *MeService) UpdateUsername(ctx context.Context, userID string, input *ty
 
 	return &types.UserOutput{
 		UserInfo: &types.UserInfo{
-			ID:          updatedUser.ID,
-			Username:    updatedUser.Username,
-			Email:       updatedUser.Email,
-			Status:      string(updatedUser.Status),
-			LastLoginAt: updatedUser.LastLoginAt,
-			CreatedAt:   updatedUser.CreatedAt,
+			ID:               updatedUser.ID,
+			Username:         updatedUser.Username,
+			Email:            updatedUser.Email,
+			Status:           string(updatedUser.Status),
+			TwoFactorEnabled: updatedUser.TwoFactorEnabled,
+			LastLoginAt:      updatedUser.LastLoginAt,
+			CreatedAt:        updatedUser.CreatedAt,
 		},
 	}, nil
 }
 
-// UpdateEmail 更新邮箱
-func (s *MeService) UpdateEmail(ctx context.Context, userID string, input *types.UpdateEmailInput) (*types.UserOutput, error) {
+// ChangePassword 修改用户密码，默认同时撤销用户的所有其他会话，并为当前会话返回新的令牌对
+func (s *MeService) ChangePassword(ctx context.Context, userID string, input *types.ChangePasswordInput) (*types.AuthOutput, error) {
 	// 创建带有服务上下文的错误构建器
 	errorBuilder := oops.FromContext(ctx).
 		In("me").
-		With("user_id", userID).
-		With("email", input.Email)
+		With("user_id", userID)
 
-	// 检查用户是否存在
-	_, err := s.orm.User.Query().
+	// 管理员代为登录时不能修改用户密码
+	if err := DenyImpersonation(ctx); err != nil {
+		return nil, err
+	}
+
+	// 获取用户
+	u, err := s.orm.User.Query().
 		Where(user.IDEQ(userID)).
 		First(ctx)
 	if err != nil {
@@ -198,77 +189,178 @@ func (s *MeService) UpdateEmail(ctx context.Context, userID string, input *types
 		return nil, errorBuilder.Wrapf(err, "获取用户失败")
 	}
 
-	// 更新邮箱
-	err = s.updateEmail(ctx, userID, input.Email)
+	// 验证旧密码
+	if ok, _, err := s.hasher.Verify(u.PasswordHash, input.OldPassword); !ok {
+		if err != nil {
+			slog.ErrorContext(ctx, "校验密码哈希失败", "error", err, "user_id", userID)
+		}
+		slog.WarnContext(ctx, "旧密码验证失败", "user_id", userID)
+		return nil, apperrs.ErrUnauthorized.
+			Wrapf(errorBuilder.Errorf("旧密码不正确"), "密码验证失败")
+	}
+
+	// 校验新密码是否满足密码策略
+	if err := s.passwords.Check("NewPassword", input.NewPassword, u.Username, u.Email); err != nil {
+		return nil, err
+	}
+	if err := s.passwords.CheckReuse(ctx, s.orm.PasswordHistory, "NewPassword", u, input.NewPassword); err != nil {
+		return nil, err
+	}
+
+	// 生成新密码哈希
+	newPasswordHash, err := s.hasher.Hash(input.NewPassword)
 	if err != nil {
+		slog.ErrorContext(ctx, "生成新密码哈希失败", "error", err)
+		return nil, errorBuilder.Wrapf(err, "密码加密失败")
+	}
+
+	tx, err := s.orm.Tx(ctx)
+	if err != nil {
+		return nil, errorBuilder.Wrapf(err, "开启事务失败")
+	}
+	defer tx.Rollback()
+
+	// 保存旧密码用于防止重复使用
+	if err := s.passwords.Remember(ctx, tx.PasswordHistory, u); err != nil {
 		return nil, err
 	}
 
-	// 获取更新后的用户信息
-	updatedUser, err := s.orm.User.Query().
-		Where(user.IDEQ(userID)).
-		First(ctx)
+	// 更新密码
+	err = tx.User.UpdateOneID(userID).
+		SetPasswordHash(newPasswordHash).
+		Exec(ctx)
 	if err != nil {
-		slog.ErrorContext(ctx, "获取更新后用户信息失败", "error", err, "user_id", userID)
-		return nil, errorBuilder.Wrapf(err, "获取更新后用户信息失败")
+		slog.ErrorContext(ctx, "更新密码失败", "error", err, "user_id", userID)
+		return nil, errorBuilder.Wrapf(err, "更新密码失败")
 	}
 
-	return &types.UserOutput{
-		UserInfo: &types.UserInfo{
-			ID:          updatedUser.ID,
-			Username:    updatedUser.Username,
-			Email:       updatedUser.Email,
-			Status:      string(updatedUser.Status),
-			LastLoginAt: updatedUser.LastLoginAt,
-			CreatedAt:   updatedUser.CreatedAt,
-		},
-	}, nil
+	// 在同一事务中撤销其他会话，避免已泄露的会话在修改密码后继续有效
+	current, _ := appctx.GetTokenFromContext(ctx)
+	authOutput, err := s.auth.passwordChanged(ctx, tx, userID, current)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errorBuilder.Wrapf(err, "提交事务失败")
+	}
+
+	// 用户信息变更后已缓存的用户快照立即失效
+	s.cache.InvalidateUser(userID)
+	s.events.Record(ctx, userID, types.SecurityEventPasswordChanged, "")
+
+	return authOutput, nil
 }
 
-// ChangePassword 修改用户密码
-func (s *MeService) ChangePassword(ctx context.Context, userID string, input *types.ChangePasswordInput) error {
-	// 创建带有服务上下文的错误构建器
-	errorBuilder := oops.FromContext(ctx).
-		In("me").
-		With("user_id", userID)
+// DeleteAccount 注销当前用户账户：撤销所有会话和个人访问令牌，逻辑删除账户及其登录凭据，
+// 释放用户名和邮箱供重新注册
+func (s *MeService) DeleteAccount(ctx context.Context, userID string) error {
+	// 管理员代为登录时不能注销用户账户
+	if err := DenyImpersonation(ctx); err != nil {
+		return err
+	}
 
-	// 获取用户
-	u, err := s.orm.User.Query().
+	tx, err := s.orm.Tx(ctx)
+	if err != nil {
+		return apperrs.ErrDatabase.With("user_id", userID).With("原始错误", err).Errorf("开启事务失败")
+	}
+	defer tx.Rollback()
+
+	deletedAt := time.Now().UnixMilli()
+	n, err := tx.User.Update().
 		Where(user.IDEQ(userID)).
-		First(ctx)
+		SetDeletedAt(deletedAt).
+		Save(ctx)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			slog.WarnContext(ctx, "用户不存在", "user_id", userID)
-			return apperrs.ErrNotFound.
-				Wrapf(errorBuilder.Errorf("用户不存在"), "用户查询失败")
-		}
-		slog.ErrorContext(ctx, "获取用户失败", "error", err, "user_id", userID)
-		return errorBuilder.Wrapf(err, "获取用户失败")
+		slog.ErrorContext(ctx, "注销账户失败", "error", err, "user_id", userID)
+		return apperrs.ErrDatabase.With("user_id", userID).With("原始错误", err).Errorf("注销账户失败")
+	}
+	if n == 0 {
+		return apperrs.ErrNotFound.With("user_id", userID).Public("用户不存在").Errorf("用户不存在")
 	}
 
-	// 验证旧密码
-	err = bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(input.OldPassword))
+	if _, err := revokeUserTokens(ctx, tx.Token, userID); err != nil {
+		return err
+	}
+	err = tx.PersonalAccessToken.Update().
+		Where(
+			personalaccesstoken.UserID(userID),
+			personalaccesstoken.RevokedAtIsNil(),
+		).
+		SetRevokedAt(time.Now()).
+		Exec(ctx)
 	if err != nil {
-		slog.WarnContext(ctx, "旧密码验证失败", "user_id", userID)
-		return apperrs.ErrUnauthorized.
-			Wrapf(errorBuilder.Errorf("旧密码不正确"), "密码验证失败")
+		slog.ErrorContext(ctx, "撤销个人访问令牌失败", "error", err, "user_id", userID)
+		return apperrs.ErrDatabase.With("user_id", userID).With("原始错误", err).Errorf("撤销个人访问令牌失败")
 	}
 
-	// 生成新密码哈希
-	newPasswordHash, err := bcrypt.GenerateFromPassword([]byte(input.NewPassword), bcrypt.DefaultCost)
+	// 第三方账户和通行密钥随账户一起删除，之后可以绑定到新账户；注册的OAuth客户端同时停用
+	if err := tx.Identity.Update().Where(identity.UserID(userID)).SetDeletedAt(deletedAt).Exec(ctx); err != nil {
+		slog.ErrorContext(ctx, "删除第三方账户绑定失败", "error", err, "user_id", userID)
+		return apperrs.ErrDatabase.With("user_id", userID).With("原始错误", err).Errorf("删除第三方账户绑定失败")
+	}
+	if err := tx.Passkey.Update().Where(passkey.UserID(userID)).SetDeletedAt(deletedAt).Exec(ctx); err != nil {
+		slog.ErrorContext(ctx, "删除通行密钥失败", "error", err, "user_id", userID)
+		return apperrs.ErrDatabase.With("user_id", userID).With("原始错误", err).Errorf("删除通行密钥失败")
+	}
+	if err := s.deleteOAuthClients(ctx, tx, userID, deletedAt); err != nil {
+		return err
+	}
+
+	s.cache.InvalidateUserOnCommit(tx, userID)
+	if err := tx.Commit(); err != nil {
+		return apperrs.ErrDatabase.With("user_id", userID).With("原始错误", err).Errorf("提交事务失败")
+	}
+
+	s.events.Record(ctx, userID, types.SecurityEventAccountDeleted, "")
+	slog.InfoContext(ctx, "用户已注销账户", "user_id", userID)
+	return nil
+}
+
+// deleteOAuthClients 删除用户注册的OAuth客户端，并撤销签发给这些客户端的令牌，包括其他用户授予的令牌
+func (s *MeService) deleteOAuthClients(ctx context.Context, tx *ent.Tx, userID string, deletedAt int64) error {
+	clientIDs, err := tx.OAuthClient.Query().Where(oauthclient.UserID(userID)).IDs(ctx)
 	if err != nil {
-		slog.ErrorContext(ctx, "生成新密码哈希失败", "error", err)
-		return errorBuilder.Wrapf(err, "密码加密失败")
+		slog.ErrorContext(ctx, "查询OAuth客户端失败", "error", err, "user_id", userID)
+		return apperrs.ErrDatabase.With("user_id", userID).With("原始错误", err).Errorf("查询OAuth客户端失败")
+	}
+	if len(clientIDs) == 0 {
+		return nil
 	}
 
-	// 更新密码
-	err = s.orm.User.UpdateOneID(userID).
-		SetPasswordHash(string(newPasswordHash)).
+	// 撤销前记录受影响的用户，提交后清除他们的认证缓存
+	grantedUserIDs, err := tx.Token.Query().
+		Where(
+			token.ClientIDIn(clientIDs...),
+			token.IsRevoked(false),
+			token.UserIDNEQ(""),
+		).
+		Unique(true).
+		Select(token.FieldUserID).
+		Strings(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "查询OAuth客户端令牌失败", "error", err, "user_id", userID)
+		return apperrs.ErrDatabase.With("user_id", userID).With("原始错误", err).Errorf("查询OAuth客户端令牌失败")
+	}
+
+	err = tx.Token.Update().
+		Where(
+			token.ClientIDIn(clientIDs...),
+			token.IsRevoked(false),
+		).
+		SetIsRevoked(true).
 		Exec(ctx)
 	if err != nil {
-		slog.ErrorContext(ctx, "更新密码失败", "error", err, "user_id", userID)
-		return errorBuilder.Wrapf(err, "更新密码失败")
+		slog.ErrorContext(ctx, "撤销OAuth客户端令牌失败", "error", err, "user_id", userID)
+		return apperrs.ErrDatabase.With("user_id", userID).With("原始错误", err).Errorf("撤销OAuth客户端令牌失败")
+	}
+	for _, id := range grantedUserIDs {
+		s.cache.InvalidateUserOnCommit(tx, id)
 	}
 
+	if err := tx.OAuthClient.Update().Where(oauthclient.IDIn(clientIDs...)).SetDeletedAt(deletedAt).Exec(ctx); err != nil {
+		slog.ErrorContext(ctx, "删除OAuth客户端失败", "error", err, "user_id", userID)
+		return apperrs.ErrDatabase.With("user_id", userID).With("原始错误", err).Errorf("删除OAuth客户端失败")
+	}
 	return nil
 }
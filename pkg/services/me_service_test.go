@@ -0,0 +1,71 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/liukeshao/echo-template/config"
+	"github.com/liukeshao/echo-template/ent/token"
+	"github.com/liukeshao/echo-template/pkg/apperrs"
+	"github.com/liukeshao/echo-template/pkg/types"
+)
+
+func TestDeleteAccount(t *testing.T) {
+	auth, client := newTestAuthService(t)
+	events := NewSecurityEventService(client, config.SecurityEventConfig{})
+	auth.SetSecurityEvents(events)
+	me := NewMeService(client, auth, auth.passwords, auth.hasher)
+	ctx := context.Background()
+
+	session := registerTestUser(t, auth)
+	owner, _, err := auth.AuthenticateUser(ctx, session.AccessToken)
+	require.NoError(t, err)
+
+	// 注销的用户注册了OAuth客户端，其他用户授权给了该客户端
+	oauth := NewOAuthService(client, auth, "test-hash-key", "http://localhost:8000", config.OAuthConfig{})
+	created, err := oauth.CreateClient(ctx, owner.ID, &types.CreateOAuthClientInput{
+		Name:         "partner",
+		RedirectURIs: []string{"https://partner.example.com/callback"},
+		GrantTypes:   []string{types.GrantTypeAuthorizationCode, types.GrantTypeClientCredentials},
+		Scopes:       []string{types.ScopeProfileRead},
+	})
+	require.NoError(t, err)
+	clientID, secret := created.Client.ID, created.ClientSecret
+
+	other, err := auth.Register(ctx, &types.RegisterInput{Username: "other", Email: "other@example.com", Password: "password123"})
+	require.NoError(t, err)
+	otherUser, _, err := auth.AuthenticateUser(ctx, other.AccessToken)
+	require.NoError(t, err)
+	granted, _ := oauthUserTokens(t, oauth, otherUser.ID, clientID, secret)
+	scopes := []string{types.ScopeProfileRead}
+	_, _, _, err = auth.Authenticate(ctx, granted.AccessToken, scopes)
+	require.NoError(t, err)
+	_, err = oauth.Token(ctx, clientID, secret, &types.OAuthTokenInput{GrantType: types.GrantTypeClientCredentials})
+	require.NoError(t, err)
+
+	require.NoError(t, me.DeleteAccount(ctx, owner.ID))
+
+	// 用户自己的会话失效
+	_, _, err = auth.AuthenticateUser(ctx, session.AccessToken)
+	assertErrorCode(t, err, apperrs.CodeUnauthorized)
+
+	// 客户端被停用，签发给它的令牌全部撤销
+	_, _, _, err = auth.Authenticate(ctx, granted.AccessToken, scopes)
+	assertErrorCode(t, err, apperrs.CodeUnauthorized)
+	assert.Zero(t, client.Token.Query().Where(token.ClientID(clientID), token.IsRevoked(false)).CountX(ctx))
+	_, err = oauth.Token(ctx, clientID, secret, &types.OAuthTokenInput{GrantType: types.GrantTypeClientCredentials})
+	assertOAuthError(t, err, OAuthErrInvalidClient)
+
+	// 其他用户的第一方会话不受影响
+	_, _, err = auth.AuthenticateUser(ctx, other.AccessToken)
+	assert.NoError(t, err)
+
+	// 记录注销事件
+	events.Flush(ctx)
+	deleted, err := events.List(ctx, owner.ID, &types.ListSecurityEventsInput{Type: types.SecurityEventAccountDeleted})
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted.Total)
+}
@@ -0,0 +1,83 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"slices"
+
+	"github.com/liukeshao/echo-template/pkg/utils"
+)
+
+// migrateLegacyTokens 一次性迁移：将旧版本明文保存在 tokens.token 中的JWT转换为 tokens.token_hash，
+// 并删除明文列及其唯一索引。需在自动迁移之前执行，已迁移或全新的数据库不会做任何改动。
+func migrateLegacyTokens(ctx context.Context, db *sql.DB, hashKey string) error {
+	columns, err := tableColumns(ctx, db, "tokens")
+	if err != nil {
+		// 表尚不存在（全新数据库），交给自动迁移创建
+		return nil
+	}
+	if !slices.Contains(columns, "token") || slices.Contains(columns, "token_hash") {
+		return nil
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "ALTER TABLE tokens ADD COLUMN token_hash VARCHAR(64) NOT NULL DEFAULT ''"); err != nil {
+		return err
+	}
+
+	rows, err := tx.QueryContext(ctx, "SELECT token FROM tokens")
+	if err != nil {
+		return err
+	}
+	var legacyTokens []string
+	for rows.Next() {
+		var tokenString string
+		if err := rows.Scan(&tokenString); err != nil {
+			rows.Close()
+			return err
+		}
+		legacyTokens = append(legacyTokens, tokenString)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, tokenString := range legacyTokens {
+		if _, err := tx.ExecContext(ctx, "UPDATE tokens SET token_hash = ? WHERE token = ?", utils.HashToken(hashKey, tokenString), tokenString); err != nil {
+			return err
+		}
+	}
+
+	// 删除明文列，唯一索引需先于列删除
+	if _, err := tx.ExecContext(ctx, "DROP INDEX IF EXISTS token_token_deleted_at"); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "ALTER TABLE tokens DROP COLUMN token"); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	slog.InfoContext(ctx, "已将明文令牌迁移为哈希存储", "count", len(legacyTokens))
+	return nil
+}
+
+// tableColumns 返回数据表的列名
+func tableColumns(ctx context.Context, db *sql.DB, table string) ([]string, error) {
+	rows, err := db.QueryContext(ctx, "SELECT * FROM "+table+" WHERE 1 = 0")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return rows.Columns()
+}
@@ -0,0 +1,65 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/liukeshao/echo-template/pkg/utils"
+)
+
+func TestMigrateLegacyTokens(t *testing.T) {
+	ctx := context.Background()
+	db, err := sql.Open("sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	// 全新数据库没有 tokens 表，不做任何改动
+	require.NoError(t, migrateLegacyTokens(ctx, db, "test-hash-key"))
+
+	// 旧版本的表结构：明文令牌及其唯一索引
+	for _, stmt := range []string{
+		"CREATE TABLE tokens (id VARCHAR(26) PRIMARY KEY, token TEXT NOT NULL, deleted_at INTEGER NOT NULL DEFAULT 0)",
+		"CREATE UNIQUE INDEX token_token_deleted_at ON tokens (token, deleted_at)",
+		"INSERT INTO tokens (id, token) VALUES ('1', 'legacy-access-token'), ('2', 'legacy-refresh-token')",
+	} {
+		_, err := db.ExecContext(ctx, stmt)
+		require.NoError(t, err)
+	}
+
+	hashes := func() map[string]string {
+		rows, err := db.QueryContext(ctx, "SELECT id, token_hash FROM tokens ORDER BY id")
+		require.NoError(t, err)
+		defer rows.Close()
+
+		out := make(map[string]string)
+		for rows.Next() {
+			var id, hash string
+			require.NoError(t, rows.Scan(&id, &hash))
+			out[id] = hash
+		}
+		require.NoError(t, rows.Err())
+		return out
+	}
+
+	require.NoError(t, migrateLegacyTokens(ctx, db, "test-hash-key"))
+	want := map[string]string{
+		"1": utils.HashToken("test-hash-key", "legacy-access-token"),
+		"2": utils.HashToken("test-hash-key", "legacy-refresh-token"),
+	}
+	assert.Equal(t, want, hashes())
+
+	// 明文列已删除
+	columns, err := tableColumns(ctx, db, "tokens")
+	require.NoError(t, err)
+	assert.NotContains(t, columns, "token")
+	assert.Contains(t, columns, "token_hash")
+
+	// 重复执行不做任何改动
+	require.NoError(t, migrateLegacyTokens(ctx, db, "test-hash-key"))
+	assert.Equal(t, want, hashes())
+}
@@ -0,0 +1,705 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/samber/oops"
+
+	"github.com/liukeshao/echo-template/config"
+	"github.com/liukeshao/echo-template/ent"
+	"github.com/liukeshao/echo-template/ent/oauthclient"
+	"github.com/liukeshao/echo-template/ent/oauthcode"
+	"github.com/liukeshao/echo-template/ent/schema"
+	"github.com/liukeshao/echo-template/ent/token"
+	"github.com/liukeshao/echo-template/pkg/apperrs"
+	"github.com/liukeshao/echo-template/pkg/types"
+	"github.com/liukeshao/echo-template/pkg/utils"
+)
+
+// OAuth 授权服务常量
+const (
+	DefaultOAuthCodeExpiry = time.Minute // 授权码默认有效期
+)
+
+// OAuth 错误码（RFC 6749 第5.2节）
+const (
+	OAuthErrInvalidRequest       = "invalid_request"
+	OAuthErrInvalidClient        = "invalid_client"
+	OAuthErrInvalidGrant         = "invalid_grant"
+	OAuthErrUnauthorizedClient   = "unauthorized_client"
+	OAuthErrUnsupportedGrantType = "unsupported_grant_type"
+	OAuthErrInvalidScope         = "invalid_scope"
+)
+
+// OAuthError 令牌、内省和撤销端点按 RFC 6749 第5.2节返回给客户端的错误
+type OAuthError struct {
+	Status      int    // HTTP状态码
+	Code        string // 错误码，如 invalid_grant
+	Description string // 错误描述
+}
+
+// Error 实现 error 接口
+func (e *OAuthError) Error() string {
+	return e.Code + ": " + e.Description
+}
+
+// newOAuthError 创建OAuth错误，客户端认证失败时返回401
+func newOAuthError(code string, description string) *OAuthError {
+	status := http.StatusBadRequest
+	if code == OAuthErrInvalidClient {
+		status = http.StatusUnauthorized
+	}
+	return &OAuthError{Status: status, Code: code, Description: description}
+}
+
+// asInvalidGrant 将令牌或用户校验失败转换为 invalid_grant，数据库等内部错误原样返回
+func asInvalidGrant(err error) error {
+	if oopsErr, ok := oops.AsOops(err); ok {
+		switch apperrs.MustFromString(oopsErr.Code()) {
+		case apperrs.CodeUnauthorized, apperrs.CodeForbidden, apperrs.CodeBadRequest:
+			return newOAuthError(OAuthErrInvalidGrant, "授权无效或已过期")
+		}
+	}
+	return err
+}
+
+// OAuthService OAuth 2.0 授权服务：管理第三方客户端，签发、内省和撤销委托令牌
+type OAuthService struct {
+	orm          *ent.Client
+	auth         *AuthService
+	hashKey      string
+	host         string
+	authorizeURL string
+	codeExpiry   time.Duration
+}
+
+// NewOAuthService 创建OAuth授权服务
+func NewOAuthService(orm *ent.Client, auth *AuthService, hashKey string, host string, cfg config.OAuthConfig) *OAuthService {
+	host = strings.TrimRight(host, "/")
+
+	authorizeURL := cfg.AuthorizeURL
+	if authorizeURL == "" {
+		authorizeURL = host + "/oauth/authorize"
+	}
+	codeExpiry := cfg.CodeExpiry
+	if codeExpiry <= 0 {
+		codeExpiry = DefaultOAuthCodeExpiry
+	}
+
+	return &OAuthService{
+		orm:          orm,
+		auth:         auth,
+		hashKey:      hashKey,
+		host:         host,
+		authorizeURL: authorizeURL,
+		codeExpiry:   codeExpiry,
+	}
+}
+
+// Metadata 返回授权服务元数据（RFC 8414）
+func (s *OAuthService) Metadata() *types.OAuthServerMetadata {
+	return &types.OAuthServerMetadata{
+		Issuer:                            s.host,
+		AuthorizationEndpoint:             s.authorizeURL,
+		TokenEndpoint:                     s.host + "/api/v1/oauth/token",
+		IntrospectionEndpoint:             s.host + "/api/v1/oauth/introspect",
+		RevocationEndpoint:                s.host + "/api/v1/oauth/revoke",
+		JWKSURI:                           s.host + "/.well-known/jwks.json",
+		ScopesSupported:                   types.Scopes(),
+		ResponseTypesSupported:            []string{"code"},
+		GrantTypesSupported:               []string{types.GrantTypeAuthorizationCode, types.GrantTypeRefreshToken, types.GrantTypeClientCredentials},
+		CodeChallengeMethodsSupported:     []string{"S256"},
+		TokenEndpointAuthMethodsSupported: []string{"client_secret_basic", "client_secret_post", "none"},
+	}
+}
+
+// CreateClient 为用户注册OAuth客户端，机密客户端的密钥只在注册时返回一次
+func (s *OAuthService) CreateClient(ctx context.Context, userID string, input *types.CreateOAuthClientInput) (*types.CreateOAuthClientOutput, error) {
+	grantTypes := slices.Compact(slices.Sorted(slices.Values(input.GrantTypes)))
+	scopes := slices.Compact(slices.Sorted(slices.Values(input.Scopes)))
+
+	if slices.Contains(grantTypes, types.GrantTypeAuthorizationCode) && len(input.RedirectURIs) == 0 {
+		return nil, apperrs.ErrBadRequest.Public("授权码模式至少需要一个回调地址").Errorf("授权码模式至少需要一个回调地址")
+	}
+	if slices.Contains(grantTypes, types.GrantTypeClientCredentials) && input.Public {
+		return nil, apperrs.ErrBadRequest.Public("公开客户端不能使用客户端凭据模式").Errorf("公开客户端不能使用客户端凭据模式")
+	}
+	for _, redirectURI := range input.RedirectURIs {
+		// 回调地址必须是不含片段的绝对地址（RFC 6749 第3.1.2节）
+		u, err := url.Parse(redirectURI)
+		if err != nil || !u.IsAbs() || u.Fragment != "" {
+			return nil, apperrs.ErrBadRequest.With("redirect_uri", redirectURI).Public("回调地址无效").Errorf("回调地址无效")
+		}
+	}
+
+	create := s.orm.OAuthClient.Create().
+		SetID(utils.GenerateULID()).
+		SetUserID(userID).
+		SetName(input.Name).
+		SetRedirectUris(input.RedirectURIs).
+		SetGrantTypes(grantTypes).
+		SetScopes(scopes)
+
+	var secret string
+	if !input.Public {
+		secret = utils.GenerateRandomToken()
+		create.SetSecretHash(utils.HashToken(s.hashKey, secret))
+	}
+
+	client, err := create.Save(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "注册OAuth客户端失败", "error", err, "user_id", userID)
+		return nil, apperrs.ErrDatabase.With("user_id", userID).With("原始错误", err).Errorf("注册OAuth客户端失败")
+	}
+
+	slog.InfoContext(ctx, "已注册OAuth客户端", "user_id", userID, "client_id", client.ID, "grant_types", grantTypes)
+	return &types.CreateOAuthClientOutput{
+		Client:       oauthClientInfo(client),
+		ClientSecret: secret,
+	}, nil
+}
+
+// ListClients 列出用户注册的OAuth客户端
+func (s *OAuthService) ListClients(ctx context.Context, userID string) (*types.ListOAuthClientsOutput, error) {
+	clients, err := s.orm.OAuthClient.Query().
+		Where(oauthclient.UserID(userID)).
+		Order(ent.Desc(oauthclient.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "查询OAuth客户端失败", "error", err, "user_id", userID)
+		return nil, apperrs.ErrDatabase.With("user_id", userID).With("原始错误", err).Errorf("查询OAuth客户端失败")
+	}
+
+	out := &types.ListOAuthClientsOutput{Clients: make([]*types.OAuthClientInfo, 0, len(clients))}
+	for _, client := range clients {
+		out.Clients = append(out.Clients, oauthClientInfo(client))
+	}
+	return out, nil
+}
+
+// DeleteClient 删除用户注册的OAuth客户端，并撤销签发给该客户端的所有令牌
+func (s *OAuthService) DeleteClient(ctx context.Context, userID string, clientID string) error {
+	tx, err := s.orm.Tx(ctx)
+	if err != nil {
+		return apperrs.ErrDatabase.With("user_id", userID).With("原始错误", err).Errorf("开启事务失败")
+	}
+	defer tx.Rollback()
+
+	// 物理删除，不保留密钥哈希
+	deleted, err := tx.OAuthClient.Delete().
+		Where(
+			oauthclient.ID(clientID),
+			oauthclient.UserID(userID),
+		).
+		Exec(schema.SkipSoftDelete(ctx))
+	if err != nil {
+		slog.ErrorContext(ctx, "删除OAuth客户端失败", "error", err, "user_id", userID, "client_id", clientID)
+		return apperrs.ErrDatabase.With("user_id", userID).With("client_id", clientID).With("原始错误", err).Errorf("删除OAuth客户端失败")
+	}
+	if deleted == 0 {
+		return apperrs.ErrNotFound.With("user_id", userID).With("client_id", clientID).Public("OAuth客户端不存在").Errorf("OAuth客户端不存在")
+	}
+
+	// 撤销前记录受影响的用户，提交后清除他们的认证缓存
+	userIDs, err := tx.Token.Query().
+		Where(
+			token.ClientID(clientID),
+			token.IsRevoked(false),
+			token.UserIDNEQ(""),
+		).
+		Unique(true).
+		Select(token.FieldUserID).
+		Strings(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "查询OAuth客户端令牌失败", "error", err, "client_id", clientID)
+		return apperrs.ErrDatabase.With("client_id", clientID).With("原始错误", err).Errorf("查询OAuth客户端令牌失败")
+	}
+
+	revoked, err := tx.Token.Update().
+		Where(
+			token.ClientID(clientID),
+			token.IsRevoked(false),
+		).
+		SetIsRevoked(true).
+		Save(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "撤销OAuth客户端令牌失败", "error", err, "client_id", clientID)
+		return apperrs.ErrDatabase.With("client_id", clientID).With("原始错误", err).Errorf("撤销OAuth客户端令牌失败")
+	}
+	for _, id := range userIDs {
+		s.auth.cache.InvalidateUserOnCommit(tx, id)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return apperrs.ErrDatabase.With("user_id", userID).With("原始错误", err).Errorf("提交事务失败")
+	}
+
+	slog.InfoContext(ctx, "已删除OAuth客户端", "user_id", userID, "client_id", clientID, "revoked_tokens", revoked)
+	return nil
+}
+
+// Authorize 校验授权请求并返回授权确认页面需要展示的信息
+func (s *OAuthService) Authorize(ctx context.Context, input *types.OAuthAuthorizeInput) (*types.OAuthAuthorizeOutput, error) {
+	client, scopes, err := s.validateAuthorizeRequest(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.OAuthAuthorizeOutput{
+		Client:      oauthClientInfo(client),
+		Scopes:      scopes,
+		RedirectURI: input.RedirectURI,
+	}, nil
+}
+
+// Consent 记录用户的授权决定：同意时签发授权码，拒绝时返回 access_denied，
+// 两种情况都返回客户端回调地址，由授权确认页面完成跳转
+func (s *OAuthService) Consent(ctx context.Context, userID string, input *types.OAuthConsentInput) (*types.OAuthConsentOutput, error) {
+	client, scopes, err := s.validateAuthorizeRequest(ctx, &input.OAuthAuthorizeInput)
+	if err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	if input.State != "" {
+		params.Set("state", input.State)
+	}
+
+	if !input.Approve {
+		slog.InfoContext(ctx, "用户拒绝授权OAuth客户端", "user_id", userID, "client_id", client.ID)
+		params.Set("error", "access_denied")
+		return &types.OAuthConsentOutput{RedirectURI: withQuery(input.RedirectURI, params)}, nil
+	}
+
+	code := utils.GenerateRandomToken()
+	err = s.orm.OAuthCode.Create().
+		SetID(utils.GenerateULID()).
+		SetClientID(client.ID).
+		SetUserID(userID).
+		SetCodeHash(utils.HashToken(s.hashKey, code)).
+		SetRedirectURI(input.RedirectURI).
+		SetScope(strings.Join(scopes, " ")).
+		SetCodeChallenge(input.CodeChallenge).
+		SetExpiresAt(time.Now().Add(s.codeExpiry)).
+		Exec(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "保存OAuth授权码失败", "error", err, "user_id", userID, "client_id", client.ID)
+		return nil, apperrs.ErrDatabase.With("user_id", userID).With("client_id", client.ID).With("原始错误", err).Errorf("保存授权码失败")
+	}
+
+	slog.InfoContext(ctx, "用户授权OAuth客户端", "user_id", userID, "client_id", client.ID, "scope", scopes)
+	params.Set("code", code)
+	return &types.OAuthConsentOutput{RedirectURI: withQuery(input.RedirectURI, params)}, nil
+}
+
+// validateAuthorizeRequest 校验授权请求的客户端、回调地址和授权范围，返回客户端及最终申请的授权范围。
+// 客户端或回调地址无效时不能跳转回客户端（RFC 6749 第4.1.2.1节），因此直接返回错误
+func (s *OAuthService) validateAuthorizeRequest(ctx context.Context, input *types.OAuthAuthorizeInput) (*ent.OAuthClient, []string, error) {
+	client, err := s.orm.OAuthClient.Get(ctx, input.ClientID)
+	switch {
+	case ent.IsNotFound(err):
+		return nil, nil, apperrs.ErrBadRequest.With("client_id", input.ClientID).Public("OAuth客户端不存在").Errorf("OAuth客户端不存在")
+	case err != nil:
+		slog.ErrorContext(ctx, "查询OAuth客户端失败", "error", err, "client_id", input.ClientID)
+		return nil, nil, apperrs.ErrDatabase.With("client_id", input.ClientID).With("原始错误", err).Errorf("查询OAuth客户端失败")
+	}
+
+	if !slices.Contains(client.GrantTypes, types.GrantTypeAuthorizationCode) {
+		return nil, nil, apperrs.ErrBadRequest.With("client_id", client.ID).Public("该客户端未开通授权码模式").Errorf("该客户端未开通授权码模式")
+	}
+	if !slices.Contains(client.RedirectUris, input.RedirectURI) {
+		return nil, nil, apperrs.ErrBadRequest.With("client_id", client.ID).With("redirect_uri", input.RedirectURI).Public("回调地址未注册").Errorf("回调地址未注册")
+	}
+
+	scopes, ok := resolveScopes(client, input.Scope)
+	if !ok {
+		return nil, nil, apperrs.ErrBadRequest.With("client_id", client.ID).With("scope", input.Scope).Public("申请的授权范围无效").Errorf("申请的授权范围无效")
+	}
+
+	return client, scopes, nil
+}
+
+// Token 令牌端点：认证客户端后按授权模式签发令牌
+func (s *OAuthService) Token(ctx context.Context, clientID string, clientSecret string, input *types.OAuthTokenInput) (*types.OAuthTokenOutput, error) {
+	client, err := s.authenticateClient(ctx, clientID, clientSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	switch input.GrantType {
+	case types.GrantTypeAuthorizationCode:
+		return s.exchangeCode(ctx, client, input)
+	case types.GrantTypeRefreshToken:
+		return s.refresh(ctx, client, input)
+	case types.GrantTypeClientCredentials:
+		return s.clientCredentials(ctx, client, input)
+	case "":
+		return nil, newOAuthError(OAuthErrInvalidRequest, "缺少 grant_type 参数")
+	default:
+		return nil, newOAuthError(OAuthErrUnsupportedGrantType, "不支持的授权模式")
+	}
+}
+
+// exchangeCode 使用授权码换取令牌（RFC 6749 第4.1.3节，RFC 7636 第4.6节）
+func (s *OAuthService) exchangeCode(ctx context.Context, client *ent.OAuthClient, input *types.OAuthTokenInput) (*types.OAuthTokenOutput, error) {
+	if !slices.Contains(client.GrantTypes, types.GrantTypeAuthorizationCode) {
+		return nil, newOAuthError(OAuthErrUnauthorizedClient, "该客户端未开通授权码模式")
+	}
+	if input.Code == "" || input.RedirectURI == "" || input.CodeVerifier == "" {
+		return nil, newOAuthError(OAuthErrInvalidRequest, "缺少 code、redirect_uri 或 code_verifier 参数")
+	}
+
+	code, err := s.orm.OAuthCode.Query().
+		Where(oauthcode.CodeHash(utils.HashToken(s.hashKey, input.Code))).
+		Only(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		return nil, newOAuthError(OAuthErrInvalidGrant, "授权码无效")
+	case err != nil:
+		slog.ErrorContext(ctx, "查询OAuth授权码失败", "error", err, "client_id", client.ID)
+		return nil, apperrs.ErrDatabase.With("client_id", client.ID).With("原始错误", err).Errorf("查询授权码失败")
+	}
+
+	if code.ClientID != client.ID {
+		return nil, newOAuthError(OAuthErrInvalidGrant, "授权码无效")
+	}
+
+	// 授权码被重放时撤销用它换取的令牌（RFC 6749 第4.1.2节）
+	if code.UsedAt != nil {
+		if code.FamilyID != "" {
+			revoked, err := revokeTokenFamily(ctx, s.orm.Token, code.FamilyID)
+			if err != nil {
+				return nil, err
+			}
+			s.auth.cache.InvalidateUser(code.UserID)
+			slog.WarnContext(ctx, "检测到OAuth授权码重用，已撤销换取的令牌",
+				"event", "oauth_code_reuse",
+				"client_id", client.ID,
+				"user_id", code.UserID,
+				"family_id", code.FamilyID,
+				"revoked_count", revoked,
+			)
+		}
+		return nil, newOAuthError(OAuthErrInvalidGrant, "授权码已被使用")
+	}
+
+	if time.Now().After(code.ExpiresAt) {
+		return nil, newOAuthError(OAuthErrInvalidGrant, "授权码已过期")
+	}
+	if code.RedirectURI != input.RedirectURI {
+		return nil, newOAuthError(OAuthErrInvalidGrant, "回调地址与授权请求不一致")
+	}
+	if subtle.ConstantTimeCompare([]byte(pkceChallenge(input.CodeVerifier)), []byte(code.CodeChallenge)) != 1 {
+		return nil, newOAuthError(OAuthErrInvalidGrant, "code_verifier 校验失败")
+	}
+
+	user, err := s.auth.findUserByID(ctx, code.UserID)
+	if err != nil {
+		return nil, asInvalidGrant(err)
+	}
+	if err := s.auth.validateUser(ctx, user); err != nil {
+		return nil, asInvalidGrant(err)
+	}
+
+	tx, err := s.orm.Tx(ctx)
+	if err != nil {
+		return nil, apperrs.ErrDatabase.With("client_id", client.ID).With("原始错误", err).Errorf("开启事务失败")
+	}
+	defer tx.Rollback()
+
+	// 带条件地标记授权码为已使用，防止并发请求重复换取令牌
+	familyID := utils.GenerateULID()
+	affected, err := tx.OAuthCode.Update().
+		Where(
+			oauthcode.ID(code.ID),
+			oauthcode.UsedAtIsNil(),
+		).
+		SetUsedAt(time.Now()).
+		SetFamilyID(familyID).
+		Save(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "使用OAuth授权码失败", "error", err, "client_id", client.ID)
+		return nil, apperrs.ErrDatabase.With("client_id", client.ID).With("原始错误", err).Errorf("使用授权码失败")
+	}
+	if affected == 0 {
+		return nil, newOAuthError(OAuthErrInvalidGrant, "授权码已被使用")
+	}
+
+	out, err := s.auth.issueGrantTokenPair(ctx, tx, user.ID, familyID, tokenGrant{ClientID: client.ID, Scope: code.Scope})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, apperrs.ErrDatabase.With("client_id", client.ID).With("原始错误", err).Errorf("提交事务失败")
+	}
+
+	slog.InfoContext(ctx, "OAuth授权码已换取令牌", "client_id", client.ID, "user_id", user.ID, "family_id", familyID)
+	return oauthTokenOutput(out, code.Scope), nil
+}
+
+// refresh 使用刷新令牌换取新的令牌对，沿用第一方登录的轮换与重放检测（RFC 6749 第6节）
+func (s *OAuthService) refresh(ctx context.Context, client *ent.OAuthClient, input *types.OAuthTokenInput) (*types.OAuthTokenOutput, error) {
+	if !slices.Contains(client.GrantTypes, types.GrantTypeAuthorizationCode) {
+		return nil, newOAuthError(OAuthErrUnauthorizedClient, "该客户端未开通授权码模式")
+	}
+	if input.RefreshToken == "" {
+		return nil, newOAuthError(OAuthErrInvalidRequest, "缺少 refresh_token 参数")
+	}
+
+	claims, err := s.auth.parseToken(input.RefreshToken)
+	if err != nil {
+		return nil, asInvalidGrant(err)
+	}
+	if err := s.auth.validateTokenComplete(ctx, claims, types.TokenTypeRefresh); err != nil {
+		return nil, asInvalidGrant(err)
+	}
+
+	tx, err := s.orm.Tx(ctx)
+	if err != nil {
+		return nil, apperrs.ErrDatabase.With("client_id", client.ID).With("原始错误", err).Errorf("开启事务失败")
+	}
+
+	out, err := s.auth.rotateRefreshToken(ctx, tx, input.RefreshToken, claims, client.ID)
+	if err != nil {
+		tx.Rollback()
+		return nil, asInvalidGrant(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, apperrs.ErrDatabase.With("client_id", client.ID).With("原始错误", err).Errorf("提交事务失败")
+	}
+
+	return oauthTokenOutput(out, claims.Scope), nil
+}
+
+// clientCredentials 机密客户端以自身身份获取访问令牌，不签发刷新令牌（RFC 6749 第4.4节）
+func (s *OAuthService) clientCredentials(ctx context.Context, client *ent.OAuthClient, input *types.OAuthTokenInput) (*types.OAuthTokenOutput, error) {
+	if client.SecretHash == "" || !slices.Contains(client.GrantTypes, types.GrantTypeClientCredentials) {
+		return nil, newOAuthError(OAuthErrUnauthorizedClient, "该客户端未开通客户端凭据模式")
+	}
+
+	scopes, ok := resolveScopes(client, input.Scope)
+	if !ok {
+		return nil, newOAuthError(OAuthErrInvalidScope, "申请的授权范围无效")
+	}
+	grant := tokenGrant{ClientID: client.ID, Scope: strings.Join(scopes, " ")}
+
+	tokenID := utils.GenerateULID()
+	accessToken, expiresAt, err := s.auth.generateToken(ctx, tokenID, "", types.TokenTypeAccess, grant)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.orm.Tx(ctx)
+	if err != nil {
+		return nil, apperrs.ErrDatabase.With("client_id", client.ID).With("原始错误", err).Errorf("开启事务失败")
+	}
+	defer tx.Rollback()
+
+	if err := s.auth.saveTokenToDatabase(ctx, tx, tokenID, "", utils.GenerateULID(), accessToken, types.TokenTypeAccess, expiresAt, grant); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, apperrs.ErrDatabase.With("client_id", client.ID).With("原始错误", err).Errorf("提交事务失败")
+	}
+
+	slog.InfoContext(ctx, "已为OAuth客户端签发访问令牌", "client_id", client.ID, "scope", grant.Scope)
+	return oauthTokenOutput(&types.AuthOutput{AccessToken: accessToken, ExpiresAt: expiresAt.Unix()}, grant.Scope), nil
+}
+
+// Introspect 令牌内省（RFC 7662）：客户端只能内省签发给自己的令牌，其余情况一律返回 active=false
+func (s *OAuthService) Introspect(ctx context.Context, clientID string, clientSecret string, input *types.OAuthTokenActionInput) (*types.OAuthIntrospectionOutput, error) {
+	client, err := s.authenticateClient(ctx, clientID, clientSecret)
+	if err != nil {
+		return nil, err
+	}
+	if input.Token == "" {
+		return nil, newOAuthError(OAuthErrInvalidRequest, "缺少 token 参数")
+	}
+
+	inactive := &types.OAuthIntrospectionOutput{Active: false}
+
+	claims, err := s.auth.parseToken(input.Token)
+	if err != nil {
+		return inactive, nil
+	}
+	dbToken, err := s.findClientToken(ctx, client.ID, input.Token)
+	if err != nil {
+		return nil, err
+	}
+	if dbToken == nil || dbToken.IsRevoked || time.Now().After(dbToken.ExpiresAt) {
+		return inactive, nil
+	}
+
+	out := &types.OAuthIntrospectionOutput{
+		Active:    true,
+		Scope:     dbToken.Scope,
+		ClientID:  dbToken.ClientID,
+		TokenType: string(dbToken.Type) + "_token",
+		Exp:       claims.ExpiresAt.Unix(),
+		Iat:       claims.IssuedAt.Unix(),
+		Sub:       claims.Subject,
+		Iss:       claims.Issuer,
+		Jti:       claims.ID,
+	}
+
+	// 用户授权的令牌在用户被停用后同样失效
+	if dbToken.UserID != "" {
+		user, err := s.auth.findUserByID(ctx, dbToken.UserID)
+		if err != nil || s.auth.validateUser(ctx, user) != nil {
+			return inactive, nil
+		}
+		out.Username = user.Username
+	}
+
+	return out, nil
+}
+
+// Revoke 令牌撤销（RFC 7009）：撤销刷新令牌时同时撤销同一授权下的访问令牌，
+// 令牌无效或不属于该客户端时同样视为成功
+func (s *OAuthService) Revoke(ctx context.Context, clientID string, clientSecret string, input *types.OAuthTokenActionInput) error {
+	client, err := s.authenticateClient(ctx, clientID, clientSecret)
+	if err != nil {
+		return err
+	}
+	if input.Token == "" {
+		return newOAuthError(OAuthErrInvalidRequest, "缺少 token 参数")
+	}
+
+	dbToken, err := s.findClientToken(ctx, client.ID, input.Token)
+	if err != nil || dbToken == nil || dbToken.IsRevoked {
+		return err
+	}
+
+	if dbToken.Type == token.TypeRefresh && dbToken.FamilyID != "" {
+		if _, err := revokeTokenFamily(ctx, s.orm.Token, dbToken.FamilyID); err != nil {
+			return err
+		}
+	} else if err := s.orm.Token.UpdateOne(dbToken).SetIsRevoked(true).Exec(ctx); err != nil {
+		slog.ErrorContext(ctx, "撤销token失败", "error", err, "client_id", client.ID, "token_id", dbToken.ID)
+		return apperrs.ErrDatabase.With("client_id", client.ID).With("原始错误", err).Errorf("撤销令牌失败")
+	}
+	if dbToken.UserID != "" {
+		s.auth.cache.InvalidateUser(dbToken.UserID)
+	}
+
+	slog.InfoContext(ctx, "OAuth客户端已撤销令牌", "client_id", client.ID, "token_id", dbToken.ID, "type", dbToken.Type)
+	return nil
+}
+
+// authenticateClient 认证客户端：机密客户端校验密钥，公开客户端只需提供 client_id
+func (s *OAuthService) authenticateClient(ctx context.Context, clientID string, clientSecret string) (*ent.OAuthClient, error) {
+	if clientID == "" {
+		return nil, newOAuthError(OAuthErrInvalidClient, "缺少客户端认证信息")
+	}
+
+	client, err := s.orm.OAuthClient.Get(ctx, clientID)
+	switch {
+	case ent.IsNotFound(err):
+		return nil, newOAuthError(OAuthErrInvalidClient, "客户端认证失败")
+	case err != nil:
+		slog.ErrorContext(ctx, "查询OAuth客户端失败", "error", err, "client_id", clientID)
+		return nil, apperrs.ErrDatabase.With("client_id", clientID).With("原始错误", err).Errorf("查询OAuth客户端失败")
+	}
+
+	if client.SecretHash == "" {
+		if clientSecret != "" {
+			return nil, newOAuthError(OAuthErrInvalidClient, "客户端认证失败")
+		}
+		return client, nil
+	}
+
+	hash := utils.HashToken(s.hashKey, clientSecret)
+	if subtle.ConstantTimeCompare([]byte(hash), []byte(client.SecretHash)) != 1 {
+		slog.WarnContext(ctx, "OAuth客户端密钥错误", "client_id", clientID)
+		return nil, newOAuthError(OAuthErrInvalidClient, "客户端认证失败")
+	}
+	return client, nil
+}
+
+// findClientToken 查找签发给指定客户端的令牌，不存在时返回 nil
+func (s *OAuthService) findClientToken(ctx context.Context, clientID string, tokenString string) (*ent.Token, error) {
+	dbToken, err := s.orm.Token.Query().
+		Where(
+			token.TokenHash(s.auth.hashToken(tokenString)),
+			token.ClientID(clientID),
+		).
+		Only(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		return nil, nil
+	case err != nil:
+		slog.ErrorContext(ctx, "查询token失败", "error", err, "client_id", clientID)
+		return nil, apperrs.ErrDatabase.With("client_id", clientID).With("原始错误", err).Errorf("查询令牌失败")
+	}
+	return dbToken, nil
+}
+
+// resolveScopes 解析申请的授权范围，未指定时授予客户端的全部范围，超出客户端范围时返回 false
+func resolveScopes(client *ent.OAuthClient, requested string) ([]string, bool) {
+	if requested == "" {
+		return client.Scopes, true
+	}
+
+	scopes := slices.Compact(slices.Sorted(slices.Values(strings.Fields(requested))))
+	for _, scope := range scopes {
+		if !slices.Contains(client.Scopes, scope) {
+			return nil, false
+		}
+	}
+	return scopes, true
+}
+
+// pkceChallenge 按 S256 方法计算 code_verifier 对应的 code_challenge
+func pkceChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// withQuery 在回调地址上追加查询参数，保留地址中已有的参数
+func withQuery(rawURL string, params url.Values) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	query := u.Query()
+	for key, values := range params {
+		query[key] = values
+	}
+	u.RawQuery = query.Encode()
+	return u.String()
+}
+
+// oauthTokenOutput 转换为 RFC 6749 格式的令牌响应
+func oauthTokenOutput(out *types.AuthOutput, scope string) *types.OAuthTokenOutput {
+	return &types.OAuthTokenOutput{
+		AccessToken:  out.AccessToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    max(out.ExpiresAt-time.Now().Unix(), 0),
+		RefreshToken: out.RefreshToken,
+		Scope:        scope,
+	}
+}
+
+// oauthClientInfo 转换为对外展示的客户端信息
+func oauthClientInfo(client *ent.OAuthClient) *types.OAuthClientInfo {
+	return &types.OAuthClientInfo{
+		ID:           client.ID,
+		Name:         client.Name,
+		Public:       client.SecretHash == "",
+		RedirectURIs: client.RedirectUris,
+		GrantTypes:   client.GrantTypes,
+		Scopes:       client.Scopes,
+		CreatedAt:    client.CreatedAt,
+	}
+}
@@ -0,0 +1,201 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/liukeshao/echo-template/config"
+	"github.com/liukeshao/echo-template/pkg/apperrs"
+	"github.com/liukeshao/echo-template/pkg/types"
+	"github.com/liukeshao/echo-template/pkg/utils"
+)
+
+func assertOAuthError(t *testing.T, err error, code string) {
+	t.Helper()
+	var oauthErr *OAuthError
+	require.True(t, errors.As(err, &oauthErr), "期望OAuth错误，实际为 %v", err)
+	assert.Equal(t, code, oauthErr.Code)
+}
+
+func TestOAuthAuthorizationServer(t *testing.T) {
+	auth, client := newTestAuthService(t)
+	ctx := context.Background()
+	registerTestUser(t, auth)
+	userID := client.User.Query().OnlyIDX(ctx)
+
+	oauth := NewOAuthService(client, auth, "test-hash-key", "http://localhost:8000", config.OAuthConfig{})
+	created, err := oauth.CreateClient(ctx, userID, &types.CreateOAuthClientInput{
+		Name:         "partner",
+		RedirectURIs: []string{"https://partner.example.com/callback"},
+		GrantTypes:   []string{types.GrantTypeAuthorizationCode, types.GrantTypeClientCredentials},
+		Scopes:       []string{types.ScopeProfileRead},
+	})
+	require.NoError(t, err)
+	clientID, secret := created.Client.ID, created.ClientSecret
+
+	// 授权码 + PKCE
+	verifier := utils.GenerateRandomToken()
+	consent, err := oauth.Consent(ctx, userID, &types.OAuthConsentInput{
+		OAuthAuthorizeInput: types.OAuthAuthorizeInput{
+			ResponseType:        "code",
+			ClientID:            clientID,
+			RedirectURI:         "https://partner.example.com/callback",
+			State:               "xyz",
+			CodeChallenge:       pkceChallenge(verifier),
+			CodeChallengeMethod: "S256",
+		},
+		Approve: true,
+	})
+	require.NoError(t, err)
+	callback, err := url.Parse(consent.RedirectURI)
+	require.NoError(t, err)
+	assert.Equal(t, "xyz", callback.Query().Get("state"))
+
+	exchange := &types.OAuthTokenInput{
+		GrantType:    types.GrantTypeAuthorizationCode,
+		Code:         callback.Query().Get("code"),
+		RedirectURI:  "https://partner.example.com/callback",
+		CodeVerifier: "wrong-verifier",
+	}
+	_, err = oauth.Token(ctx, clientID, secret, exchange)
+	assertOAuthError(t, err, OAuthErrInvalidGrant)
+
+	_, err = oauth.Token(ctx, clientID, "wrong-secret", exchange)
+	assertOAuthError(t, err, OAuthErrInvalidClient)
+
+	exchange.CodeVerifier = verifier
+	tokens, err := oauth.Token(ctx, clientID, secret, exchange)
+	require.NoError(t, err)
+	assert.Equal(t, types.ScopeProfileRead, tokens.Scope)
+
+	// 委托令牌只能访问声明了已授予授权范围的接口
+	_, _, err = auth.AuthenticateUser(ctx, tokens.AccessToken)
+	assertErrorCode(t, err, apperrs.CodeForbidden)
+	_, _, _, err = auth.Authenticate(ctx, tokens.AccessToken, []string{types.ScopeProfileWrite})
+	assertErrorCode(t, err, apperrs.CodeForbidden)
+	user, _, granted, err := auth.Authenticate(ctx, tokens.AccessToken, []string{types.ScopeProfileRead})
+	require.NoError(t, err)
+	assert.Equal(t, userID, user.ID)
+	assert.Equal(t, []string{types.ScopeProfileRead}, granted)
+
+	introspection, err := oauth.Introspect(ctx, clientID, secret, &types.OAuthTokenActionInput{Token: tokens.AccessToken})
+	require.NoError(t, err)
+	assert.True(t, introspection.Active)
+	assert.Equal(t, "tester", introspection.Username)
+	assert.Equal(t, types.ScopeProfileRead, introspection.Scope)
+
+	// 刷新令牌只能由签发时的客户端通过令牌端点使用
+	_, err = auth.RefreshToken(ctx, &types.RefreshTokenInput{RefreshToken: tokens.RefreshToken})
+	assertErrorCode(t, err, apperrs.CodeUnauthorized)
+	refreshed, err := oauth.Token(ctx, clientID, secret, &types.OAuthTokenInput{
+		GrantType:    types.GrantTypeRefreshToken,
+		RefreshToken: tokens.RefreshToken,
+	})
+	require.NoError(t, err)
+
+	// 授权码重放时撤销换取的令牌
+	_, err = oauth.Token(ctx, clientID, secret, exchange)
+	assertOAuthError(t, err, OAuthErrInvalidGrant)
+	introspection, err = oauth.Introspect(ctx, clientID, secret, &types.OAuthTokenActionInput{Token: refreshed.AccessToken})
+	require.NoError(t, err)
+	assert.False(t, introspection.Active)
+
+	// 客户端凭据模式
+	serviceToken, err := oauth.Token(ctx, clientID, secret, &types.OAuthTokenInput{GrantType: types.GrantTypeClientCredentials})
+	require.NoError(t, err)
+	assert.Empty(t, serviceToken.RefreshToken)
+	introspection, err = oauth.Introspect(ctx, clientID, secret, &types.OAuthTokenActionInput{Token: serviceToken.AccessToken})
+	require.NoError(t, err)
+	assert.True(t, introspection.Active)
+	assert.Equal(t, clientID, introspection.Sub)
+	_, _, _, err = auth.Authenticate(ctx, serviceToken.AccessToken, []string{types.ScopeProfileRead})
+	assertErrorCode(t, err, apperrs.CodeUnauthorized)
+
+	_, err = oauth.Token(ctx, clientID, secret, &types.OAuthTokenInput{GrantType: types.GrantTypeClientCredentials, Scope: types.ScopeProfileWrite})
+	assertOAuthError(t, err, OAuthErrInvalidScope)
+
+	// 撤销后内省返回无效
+	require.NoError(t, oauth.Revoke(ctx, clientID, secret, &types.OAuthTokenActionInput{Token: serviceToken.AccessToken}))
+	introspection, err = oauth.Introspect(ctx, clientID, secret, &types.OAuthTokenActionInput{Token: serviceToken.AccessToken})
+	require.NoError(t, err)
+	assert.False(t, introspection.Active)
+}
+
+// oauthUserTokens 通过授权码模式为用户签发委托令牌，同时返回换取令牌的请求
+func oauthUserTokens(t *testing.T, oauth *OAuthService, userID string, clientID string, secret string) (*types.OAuthTokenOutput, *types.OAuthTokenInput) {
+	t.Helper()
+	ctx := context.Background()
+
+	verifier := utils.GenerateRandomToken()
+	consent, err := oauth.Consent(ctx, userID, &types.OAuthConsentInput{
+		OAuthAuthorizeInput: types.OAuthAuthorizeInput{
+			ResponseType:        "code",
+			ClientID:            clientID,
+			RedirectURI:         "https://partner.example.com/callback",
+			CodeChallenge:       pkceChallenge(verifier),
+			CodeChallengeMethod: "S256",
+		},
+		Approve: true,
+	})
+	require.NoError(t, err)
+	callback, err := url.Parse(consent.RedirectURI)
+	require.NoError(t, err)
+
+	exchange := &types.OAuthTokenInput{
+		GrantType:    types.GrantTypeAuthorizationCode,
+		Code:         callback.Query().Get("code"),
+		RedirectURI:  "https://partner.example.com/callback",
+		CodeVerifier: verifier,
+	}
+	tokens, err := oauth.Token(ctx, clientID, secret, exchange)
+	require.NoError(t, err)
+	return tokens, exchange
+}
+
+func TestOAuthRevocationInvalidatesAuthCache(t *testing.T) {
+	auth, client := newTestAuthService(t)
+	ctx := context.Background()
+	registerTestUser(t, auth)
+	userID := client.User.Query().OnlyIDX(ctx)
+
+	oauth := NewOAuthService(client, auth, "test-hash-key", "http://localhost:8000", config.OAuthConfig{})
+	created, err := oauth.CreateClient(ctx, userID, &types.CreateOAuthClientInput{
+		Name:         "partner",
+		RedirectURIs: []string{"https://partner.example.com/callback"},
+		GrantTypes:   []string{types.GrantTypeAuthorizationCode},
+		Scopes:       []string{types.ScopeProfileRead},
+	})
+	require.NoError(t, err)
+	clientID, secret := created.Client.ID, created.ClientSecret
+	scopes := []string{types.ScopeProfileRead}
+
+	// 通过令牌撤销端点撤销后，已缓存的认证结果立即失效
+	tokens, _ := oauthUserTokens(t, oauth, userID, clientID, secret)
+	_, _, _, err = auth.Authenticate(ctx, tokens.AccessToken, scopes)
+	require.NoError(t, err)
+	require.NoError(t, oauth.Revoke(ctx, clientID, secret, &types.OAuthTokenActionInput{Token: tokens.AccessToken}))
+	_, _, _, err = auth.Authenticate(ctx, tokens.AccessToken, scopes)
+	assertErrorCode(t, err, apperrs.CodeUnauthorized)
+
+	// 授权码重放时撤销的令牌立即失效
+	tokens, exchange := oauthUserTokens(t, oauth, userID, clientID, secret)
+	_, _, _, err = auth.Authenticate(ctx, tokens.AccessToken, scopes)
+	require.NoError(t, err)
+	_, err = oauth.Token(ctx, clientID, secret, exchange)
+	assertOAuthError(t, err, OAuthErrInvalidGrant)
+	_, _, _, err = auth.Authenticate(ctx, tokens.AccessToken, scopes)
+	assertErrorCode(t, err, apperrs.CodeUnauthorized)
+
+	// 删除客户端后签发给它的令牌立即失效
+	tokens, _ = oauthUserTokens(t, oauth, userID, clientID, secret)
+	_, _, _, err = auth.Authenticate(ctx, tokens.AccessToken, scopes)
+	require.NoError(t, err)
+	require.NoError(t, oauth.DeleteClient(ctx, userID, clientID))
+	_, _, _, err = auth.Authenticate(ctx, tokens.AccessToken, scopes)
+	assertErrorCode(t, err, apperrs.CodeUnauthorized)
+}
@@ -0,0 +1,471 @@
+package services
+
+import (
+	"context"
+	"crypto/subtle"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"golang.org/x/oauth2"
+
+	"github.com/liukeshao/echo-template/config"
+	"github.com/liukeshao/echo-template/ent"
+	"github.com/liukeshao/echo-template/ent/identity"
+	"github.com/liukeshao/echo-template/ent/oidcstate"
+	"github.com/liukeshao/echo-template/ent/onetimetoken"
+	userEnt "github.com/liukeshao/echo-template/ent/user"
+	"github.com/liukeshao/echo-template/pkg/apperrs"
+	"github.com/liukeshao/echo-template/pkg/types"
+	"github.com/liukeshao/echo-template/pkg/utils"
+)
+
+// 外部身份登录常量
+const (
+	DefaultOIDCStateExpiry = 10 * time.Minute // 跳转到身份提供方后完成登录的默认时限
+	oidcHTTPTimeout        = 10 * time.Second // 请求身份提供方的超时时间
+	oidcLoginCodeExpiry    = time.Minute      // 前端用登录码换取令牌的时限
+)
+
+// usernameInvalidChars 生成用户名时需要替换的字符
+var usernameInvalidChars = regexp.MustCompile(`[^a-zA-Z0-9_.-]+`)
+
+// OIDCService 外部身份提供方（OpenID Connect）登录服务
+type OIDCService struct {
+	orm         *ent.Client
+	auth        *AuthService
+	hashKey     string
+	stateExpiry time.Duration
+	loginURL    string
+	client      *http.Client
+	providers   map[string]*oidcProvider
+}
+
+// oidcProvider 身份提供方，首次使用时通过发现文档获取端点和签名公钥
+type oidcProvider struct {
+	cfg         config.OIDCProviderConfig
+	redirectURL string
+
+	mu       sync.Mutex
+	provider *oidc.Provider
+}
+
+// oidcClaims ID令牌中使用到的声明
+type oidcClaims struct {
+	Email             string `json:"email"`
+	EmailVerified     bool   `json:"email_verified"`
+	Name              string `json:"name"`
+	PreferredUsername string `json:"preferred_username"`
+}
+
+// NewOIDCService 创建外部身份登录服务
+func NewOIDCService(orm *ent.Client, auth *AuthService, hashKey string, host string, cfg config.OIDCConfig) *OIDCService {
+	stateExpiry := cfg.StateExpiry
+	if stateExpiry <= 0 {
+		stateExpiry = DefaultOIDCStateExpiry
+	}
+
+	providers := make(map[string]*oidcProvider, len(cfg.Providers))
+	for _, p := range cfg.Providers {
+		redirectURL := p.RedirectURL
+		if redirectURL == "" {
+			redirectURL = strings.TrimRight(host, "/") + "/api/v1/auth/oidc/" + p.Name + "/callback"
+		}
+		providers[p.Name] = &oidcProvider{cfg: p, redirectURL: redirectURL}
+	}
+	loginURL := cfg.LoginURL
+	if loginURL == "" {
+		loginURL = strings.TrimRight(host, "/") + "/oidc-login"
+	}
+
+	return &OIDCService{
+		orm:         orm,
+		auth:        auth,
+		hashKey:     hashKey,
+		stateExpiry: stateExpiry,
+		loginURL:    loginURL,
+		client:      &http.Client{Timeout: oidcHTTPTimeout},
+		providers:   providers,
+	}
+}
+
+// Providers 返回已配置的身份提供方名称
+func (s *OIDCService) Providers() *types.OIDCProvidersOutput {
+	names := make([]string, 0, len(s.providers))
+	for name := range s.providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return &types.OIDCProvidersOutput{Providers: names}
+}
+
+// LoginURL 返回登录完成后跳转的前端页面
+func (s *OIDCService) LoginURL() string {
+	return s.loginURL
+}
+
+// clientContext 返回使用服务HTTP客户端的上下文。
+// 签名公钥会在后续请求中按需刷新，因此不继承请求上下文的取消信号
+func (s *OIDCService) clientContext(ctx context.Context) context.Context {
+	return oidc.ClientContext(context.WithoutCancel(ctx), s.client)
+}
+
+// provider 获取身份提供方并在首次使用时执行发现
+func (s *OIDCService) provider(ctx context.Context, name string) (*oidcProvider, *oidc.Provider, error) {
+	p, ok := s.providers[name]
+	if !ok {
+		return nil, nil, apperrs.ErrNotFound.With("provider", name).Public("身份提供方不存在").Errorf("身份提供方不存在")
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.provider == nil {
+		provider, err := oidc.NewProvider(s.clientContext(ctx), p.cfg.Issuer)
+		if err != nil {
+			slog.ErrorContext(ctx, "获取身份提供方发现文档失败", "error", err, "provider", name, "issuer", p.cfg.Issuer)
+			return nil, nil, apperrs.ErrExternalAPI.With("provider", name).With("原始错误", err).Errorf("获取身份提供方发现文档失败")
+		}
+		p.provider = provider
+	}
+	return p, p.provider, nil
+}
+
+// oauth2Config 返回身份提供方的授权码流程配置
+func (p *oidcProvider) oauth2Config(provider *oidc.Provider) *oauth2.Config {
+	scopes := append([]string{oidc.ScopeOpenID, "email", "profile"}, p.cfg.Scopes...)
+	return &oauth2.Config{
+		ClientID:     p.cfg.ClientID,
+		ClientSecret: p.cfg.ClientSecret,
+		Endpoint:     provider.Endpoint(),
+		RedirectURL:  p.redirectURL,
+		Scopes:       scopes,
+	}
+}
+
+// AuthURL 生成跳转到身份提供方的授权地址，同时保存 state、nonce 和 PKCE 校验值
+func (s *OIDCService) AuthURL(ctx context.Context, name string) (string, error) {
+	p, provider, err := s.provider(ctx, name)
+	if err != nil {
+		return "", err
+	}
+
+	state := utils.GenerateRandomToken()
+	nonce := utils.GenerateRandomToken()
+	verifier := oauth2.GenerateVerifier()
+
+	err = s.orm.OIDCState.Create().
+		SetID(utils.GenerateULID()).
+		SetProvider(name).
+		SetStateHash(utils.HashToken(s.hashKey, state)).
+		SetNonce(nonce).
+		SetCodeVerifier(verifier).
+		SetExpiresAt(time.Now().Add(s.stateExpiry)).
+		Exec(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "保存登录状态失败", "error", err, "provider", name)
+		return "", apperrs.ErrDatabase.With("provider", name).With("原始错误", err).Errorf("保存登录状态失败")
+	}
+
+	return p.oauth2Config(provider).AuthCodeURL(state, oidc.Nonce(nonce), oauth2.S256ChallengeOption(verifier)), nil
+}
+
+// takeState 校验并使用登录状态，每个 state 只能回调一次
+func (s *OIDCService) takeState(ctx context.Context, name string, state string) (*ent.OIDCState, error) {
+	expired := apperrs.ErrBadRequest.With("provider", name).Public("登录请求已过期，请重试")
+
+	st, err := s.orm.OIDCState.Query().
+		Where(
+			oidcstate.StateHash(utils.HashToken(s.hashKey, state)),
+			oidcstate.Provider(name),
+		).
+		Only(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		return nil, expired.Errorf("登录状态不存在")
+	case err != nil:
+		slog.ErrorContext(ctx, "查询登录状态失败", "error", err, "provider", name)
+		return nil, apperrs.ErrDatabase.With("provider", name).With("原始错误", err).Errorf("查询登录状态失败")
+	}
+	if st.UsedAt != nil || time.Now().After(st.ExpiresAt) {
+		return nil, expired.Errorf("登录状态已失效")
+	}
+
+	affected, err := s.orm.OIDCState.Update().
+		Where(
+			oidcstate.ID(st.ID),
+			oidcstate.UsedAtIsNil(),
+		).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "使用登录状态失败", "error", err, "provider", name)
+		return nil, apperrs.ErrDatabase.With("provider", name).With("原始错误", err).Errorf("使用登录状态失败")
+	}
+	if affected == 0 {
+		return nil, expired.Errorf("登录状态已使用")
+	}
+
+	return st, nil
+}
+
+// authenticate 处理身份提供方的回调：校验 state，使用授权码换取并验证ID令牌，返回关联的本地用户
+func (s *OIDCService) authenticate(ctx context.Context, input *types.OIDCCallbackInput) (*ent.User, error) {
+	name := input.Provider
+	failed := apperrs.ErrUnauthorized.With("provider", name).Public("外部账户登录失败")
+
+	if input.Error != "" {
+		slog.WarnContext(ctx, "身份提供方返回错误", "provider", name, "error", input.Error, "description", input.ErrorDescription)
+		return nil, failed.With("error", input.Error).Errorf("身份提供方返回错误")
+	}
+
+	p, provider, err := s.provider(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	st, err := s.takeState(ctx, name, input.State)
+	if err != nil {
+		return nil, err
+	}
+
+	clientCtx := s.clientContext(ctx)
+	token, err := p.oauth2Config(provider).Exchange(clientCtx, input.Code, oauth2.VerifierOption(st.CodeVerifier))
+	if err != nil {
+		slog.WarnContext(ctx, "授权码换取令牌失败", "error", err, "provider", name)
+		return nil, failed.With("原始错误", err).Errorf("授权码换取令牌失败")
+	}
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		return nil, failed.Errorf("身份提供方未返回ID令牌")
+	}
+
+	idToken, err := provider.Verifier(&oidc.Config{ClientID: p.cfg.ClientID}).Verify(clientCtx, rawIDToken)
+	if err != nil {
+		slog.WarnContext(ctx, "ID令牌验证失败", "error", err, "provider", name)
+		return nil, failed.With("原始错误", err).Errorf("ID令牌验证失败")
+	}
+	if subtle.ConstantTimeCompare([]byte(idToken.Nonce), []byte(st.Nonce)) != 1 {
+		slog.WarnContext(ctx, "ID令牌nonce不匹配", "provider", name, "subject", idToken.Subject)
+		return nil, failed.With("subject", idToken.Subject).Errorf("ID令牌nonce不匹配")
+	}
+
+	var claims oidcClaims
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, failed.With("原始错误", err).Errorf("解析ID令牌声明失败")
+	}
+
+	user, err := s.resolveUser(ctx, name, idToken.Subject, &claims)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.auth.validateUser(ctx, user); err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "外部账户验证成功", "user_id", user.ID, "provider", name)
+	return user, nil
+}
+
+// Callback 处理身份提供方回调并直接签发令牌，开启两步验证的用户返回两步验证挑战
+func (s *OIDCService) Callback(ctx context.Context, input *types.OIDCCallbackInput) (*types.AuthOutput, error) {
+	user, err := s.authenticate(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return s.login(ctx, user)
+}
+
+// CallbackCode 处理身份提供方回调并签发一次性登录码。
+// 回调是浏览器跳转，令牌不能放在跳转地址中，由前端使用登录码通过 Exchange 换取
+func (s *OIDCService) CallbackCode(ctx context.Context, input *types.OIDCCallbackInput) (string, error) {
+	user, err := s.authenticate(ctx, input)
+	if err != nil {
+		return "", err
+	}
+	return issueOneTimeToken(ctx, s.orm.OneTimeToken, s.hashKey, user.ID, onetimetoken.PurposeOidcLogin, oidcLoginCodeExpiry)
+}
+
+// Exchange 使用一次性登录码换取令牌，登录码只能使用一次
+func (s *OIDCService) Exchange(ctx context.Context, input *types.OIDCExchangeInput) (*types.AuthOutput, error) {
+	ott, err := consumeOneTimeToken(ctx, s.orm.OneTimeToken, s.hashKey, input.Code, onetimetoken.PurposeOidcLogin)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.auth.findUserByID(ctx, ott.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.auth.validateUser(ctx, user); err != nil {
+		return nil, err
+	}
+	return s.login(ctx, user)
+}
+
+// login 为通过外部身份验证的用户签发令牌，开启两步验证的用户仍需完成两步验证
+func (s *OIDCService) login(ctx context.Context, user *ent.User) (*types.AuthOutput, error) {
+	if user.TwoFactorEnabled {
+		return s.auth.issueMFAChallenge(ctx, user)
+	}
+
+	authOutput, err := s.auth.generateTokenPair(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 更新最后登录时间
+	if _, err := s.auth.updateLastLoginTime(ctx, user); err != nil {
+		slog.WarnContext(ctx, "更新最后登录时间失败", "error", err, "user_id", user.ID)
+	}
+
+	slog.InfoContext(ctx, "外部账户登录成功", "user_id", user.ID)
+	return authOutput, nil
+}
+
+// resolveUser 查找外部身份关联的用户。首次登录时关联邮箱相同的本地账户，没有则创建新账户。
+// 只有身份提供方确认过的邮箱才会用于关联或创建账户，防止通过伪造邮箱接管他人账户
+func (s *OIDCService) resolveUser(ctx context.Context, name string, subject string, claims *oidcClaims) (*ent.User, error) {
+	tx, err := s.orm.Tx(ctx)
+	if err != nil {
+		return nil, apperrs.ErrDatabase.With("provider", name).With("原始错误", err).Errorf("开启事务失败")
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+	ident, err := tx.Identity.Query().
+		Where(
+			identity.Provider(name),
+			identity.Subject(subject),
+		).
+		WithUser().
+		Only(ctx)
+	switch {
+	case err == nil:
+		if ident.Edges.User == nil {
+			return nil, apperrs.ErrUnauthorized.With("identity_id", ident.ID).Public("外部账户登录失败").Errorf("外部身份关联的用户不存在")
+		}
+		err = tx.Identity.UpdateOne(ident).
+			SetEmail(claims.Email).
+			SetLastLoginAt(now).
+			Exec(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "更新外部身份失败", "error", err, "identity_id", ident.ID)
+			return nil, apperrs.ErrDatabase.With("identity_id", ident.ID).With("原始错误", err).Errorf("更新外部身份失败")
+		}
+		if err := tx.Commit(); err != nil {
+			return nil, apperrs.ErrDatabase.With("identity_id", ident.ID).With("原始错误", err).Errorf("提交事务失败")
+		}
+		return ident.Edges.User, nil
+	case !ent.IsNotFound(err):
+		slog.ErrorContext(ctx, "查询外部身份失败", "error", err, "provider", name)
+		return nil, apperrs.ErrDatabase.With("provider", name).With("原始错误", err).Errorf("查询外部身份失败")
+	}
+
+	if claims.Email == "" || !claims.EmailVerified {
+		return nil, apperrs.ErrUnauthorized.
+			With("provider", name).
+			With("subject", subject).
+			Public("外部账户的邮箱未经验证，无法登录").
+			Errorf("外部账户的邮箱未经验证")
+	}
+
+	user, err := tx.User.Query().
+		Where(userEnt.Email(claims.Email)).
+		Only(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		user, err = s.createUser(ctx, tx, claims)
+		if err != nil {
+			return nil, err
+		}
+		slog.InfoContext(ctx, "已通过外部账户创建用户", "user_id", user.ID, "provider", name)
+	case err != nil:
+		slog.ErrorContext(ctx, "查询用户失败", "error", err, "email", claims.Email)
+		return nil, apperrs.ErrDatabase.With("email", claims.Email).With("原始错误", err).Errorf("查询用户失败")
+	case user.Status == userEnt.StatusInactive:
+		// 身份提供方已验证邮箱，待验证的账户同时完成激活
+		user, err = tx.User.UpdateOne(user).SetStatus(userEnt.StatusActive).Save(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "激活用户失败", "error", err, "email", claims.Email)
+			return nil, apperrs.ErrDatabase.With("email", claims.Email).With("原始错误", err).Errorf("激活用户失败")
+		}
+	}
+
+	err = tx.Identity.Create().
+		SetID(utils.GenerateULID()).
+		SetUserID(user.ID).
+		SetProvider(name).
+		SetSubject(subject).
+		SetEmail(claims.Email).
+		SetLastLoginAt(now).
+		Exec(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "保存外部身份失败", "error", err, "user_id", user.ID, "provider", name)
+		return nil, apperrs.ErrDatabase.With("user_id", user.ID).With("provider", name).With("原始错误", err).Errorf("保存外部身份失败")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, apperrs.ErrDatabase.With("user_id", user.ID).With("原始错误", err).Errorf("提交事务失败")
+	}
+
+	slog.InfoContext(ctx, "已关联外部身份", "user_id", user.ID, "provider", name)
+	return user, nil
+}
+
+// createUser 为首次登录的外部账户创建本地用户。密码为随机值，用户可以通过找回密码设置登录密码
+func (s *OIDCService) createUser(ctx context.Context, tx *ent.Tx, claims *oidcClaims) (*ent.User, error) {
+	username, err := s.uniqueUsername(ctx, tx, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	passwordHash, err := s.auth.hasher.Hash(utils.GenerateRandomToken())
+	if err != nil {
+		return nil, apperrs.ErrInternal.With("原始错误", err).Errorf("密码加密失败")
+	}
+
+	user, err := tx.User.Create().
+		SetID(utils.GenerateULID()).
+		SetUsername(username).
+		SetEmail(claims.Email).
+		SetPasswordHash(passwordHash).
+		SetStatus(userEnt.StatusActive).
+		Save(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "创建用户失败", "error", err, "email", claims.Email)
+		return nil, apperrs.ErrDatabase.With("email", claims.Email).With("原始错误", err).Errorf("创建用户失败")
+	}
+	return user, nil
+}
+
+// uniqueUsername 根据身份提供方返回的用户名或邮箱生成未被占用的用户名
+func (s *OIDCService) uniqueUsername(ctx context.Context, tx *ent.Tx, claims *oidcClaims) (string, error) {
+	base := claims.PreferredUsername
+	if base == "" {
+		base, _, _ = strings.Cut(claims.Email, "@")
+	}
+	base = usernameInvalidChars.ReplaceAllString(base, "_")
+	if len(base) < 3 {
+		base = "user"
+	}
+	base = base[:min(len(base), 40)]
+
+	candidate := base
+	for range 5 {
+		exists, err := tx.User.Query().Where(userEnt.Username(candidate)).Exist(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "检查用户名是否存在失败", "error", err, "username", candidate)
+			return "", apperrs.ErrDatabase.With("username", candidate).With("原始错误", err).Errorf("检查用户名失败")
+		}
+		if !exists {
+			return candidate, nil
+		}
+		id := strings.ToLower(utils.GenerateULID())
+		candidate = base + "_" + id[len(id)-6:]
+	}
+	return "", apperrs.ErrConflict.With("username", base).Errorf("无法生成可用的用户名")
+}
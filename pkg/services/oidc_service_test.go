@@ -0,0 +1,203 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/liukeshao/echo-template/config"
+	"github.com/liukeshao/echo-template/ent/identity"
+	userEnt "github.com/liukeshao/echo-template/ent/user"
+	"github.com/liukeshao/echo-template/pkg/apperrs"
+	"github.com/liukeshao/echo-template/pkg/types"
+)
+
+// fakeOIDCProvider 进程内的OIDC身份提供方，支持发现文档、JWKS和带PKCE校验的授权码换取
+type fakeOIDCProvider struct {
+	*httptest.Server
+	t        *testing.T
+	clientID string
+	key      *rsa.PrivateKey
+
+	mu     sync.Mutex
+	grants map[string]fakeOIDCGrant
+}
+
+type fakeOIDCGrant struct {
+	nonce     string
+	challenge string
+	claims    jwt.MapClaims
+}
+
+func newFakeOIDCProvider(t *testing.T, clientID string) *fakeOIDCProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	p := &fakeOIDCProvider{t: t, clientID: clientID, key: key, grants: make(map[string]fakeOIDCGrant)}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", p.discovery)
+	mux.HandleFunc("/jwks", p.jwks)
+	mux.HandleFunc("/token", p.token)
+	p.Server = httptest.NewServer(mux)
+	t.Cleanup(p.Close)
+	return p
+}
+
+func (p *fakeOIDCProvider) discovery(w http.ResponseWriter, r *http.Request) {
+	json.NewEncoder(w).Encode(map[string]any{
+		"issuer":                                p.URL,
+		"authorization_endpoint":                p.URL + "/authorize",
+		"token_endpoint":                        p.URL + "/token",
+		"jwks_uri":                              p.URL + "/jwks",
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+	})
+}
+
+func (p *fakeOIDCProvider) jwks(w http.ResponseWriter, r *http.Request) {
+	json.NewEncoder(w).Encode(map[string]any{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": "test",
+			"use": "sig",
+			"alg": "RS256",
+			"n":   base64.RawURLEncoding.EncodeToString(p.key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(p.key.E)).Bytes()),
+		}},
+	})
+}
+
+func (p *fakeOIDCProvider) token(w http.ResponseWriter, r *http.Request) {
+	require.NoError(p.t, r.ParseForm())
+
+	p.mu.Lock()
+	grant, ok := p.grants[r.PostForm.Get("code")]
+	delete(p.grants, r.PostForm.Get("code"))
+	p.mu.Unlock()
+
+	verifier := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	if !ok || base64.RawURLEncoding.EncodeToString(verifier[:]) != grant.challenge {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	claims := jwt.MapClaims{
+		"iss":   p.URL,
+		"aud":   p.clientID,
+		"iat":   time.Now().Unix(),
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"nonce": grant.nonce,
+	}
+	for k, v := range grant.claims {
+		claims[k] = v
+	}
+	idToken := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	idToken.Header["kid"] = "test"
+	signed, err := idToken.SignedString(p.key)
+	require.NoError(p.t, err)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]any{
+		"access_token": "fake-access-token",
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+		"id_token":     signed,
+	})
+}
+
+// authorize 模拟用户在身份提供方完成登录，返回回调参数
+func (p *fakeOIDCProvider) authorize(authURL string, claims jwt.MapClaims) (code string, state string) {
+	u, err := url.Parse(authURL)
+	require.NoError(p.t, err)
+	query := u.Query()
+	require.Equal(p.t, "S256", query.Get("code_challenge_method"))
+
+	code = base64.RawURLEncoding.EncodeToString([]byte(time.Now().String()))
+	p.mu.Lock()
+	p.grants[code] = fakeOIDCGrant{nonce: query.Get("nonce"), challenge: query.Get("code_challenge"), claims: claims}
+	p.mu.Unlock()
+	return code, query.Get("state")
+}
+
+func TestOIDCLogin(t *testing.T) {
+	auth, client := newTestAuthService(t)
+	ctx := context.Background()
+	registerTestUser(t, auth)
+
+	idp := newFakeOIDCProvider(t, "test-client")
+	oidcService := NewOIDCService(client, auth, "test-hash-key", "http://localhost:8000", config.OIDCConfig{
+		Providers: []config.OIDCProviderConfig{{
+			Name:         "fake",
+			Issuer:       idp.URL,
+			ClientID:     "test-client",
+			ClientSecret: "test-secret",
+		}},
+	})
+
+	login := func(claims jwt.MapClaims) (*types.AuthOutput, error) {
+		authURL, err := oidcService.AuthURL(ctx, "fake")
+		require.NoError(t, err)
+		code, state := idp.authorize(authURL, claims)
+		return oidcService.Callback(ctx, &types.OIDCCallbackInput{Provider: "fake", Code: code, State: state})
+	}
+
+	// 邮箱已验证时关联同邮箱的本地账户
+	out, err := login(jwt.MapClaims{"sub": "alice", "email": "tester@example.com", "email_verified": true})
+	require.NoError(t, err)
+	_, _, err = auth.AuthenticateUser(ctx, out.AccessToken)
+	require.NoError(t, err)
+	linked := client.Identity.Query().Where(identity.Subject("alice")).WithUser().OnlyX(ctx)
+	assert.Equal(t, "tester@example.com", linked.Edges.User.Email)
+
+	// 再次登录复用已关联的身份
+	_, err = login(jwt.MapClaims{"sub": "alice", "email": "tester@example.com", "email_verified": true})
+	require.NoError(t, err)
+	assert.Equal(t, 1, client.Identity.Query().CountX(ctx))
+
+	// 首次登录的新邮箱自动创建账户
+	_, err = login(jwt.MapClaims{"sub": "bob", "email": "bob@example.com", "email_verified": true, "preferred_username": "tester"})
+	require.NoError(t, err)
+	created := client.User.Query().Where(userEnt.Email("bob@example.com")).OnlyX(ctx)
+	assert.NotEqual(t, "tester", created.Username, "用户名被占用时应生成新的用户名")
+
+	// 未经验证的邮箱不能关联或创建账户
+	_, err = login(jwt.MapClaims{"sub": "mallory", "email": "victim@example.com", "email_verified": false})
+	assertErrorCode(t, err, apperrs.CodeUnauthorized)
+
+	// state 只能使用一次
+	authURL, err := oidcService.AuthURL(ctx, "fake")
+	require.NoError(t, err)
+	code, state := idp.authorize(authURL, jwt.MapClaims{"sub": "alice", "email": "tester@example.com", "email_verified": true})
+	_, err = oidcService.Callback(ctx, &types.OIDCCallbackInput{Provider: "fake", Code: code, State: state})
+	require.NoError(t, err)
+	_, err = oidcService.Callback(ctx, &types.OIDCCallbackInput{Provider: "fake", Code: code, State: state})
+	assertErrorCode(t, err, apperrs.CodeBadRequest)
+
+	// 未开启 Cookie 会话模式时回调签发一次性登录码，由前端换取令牌
+	authURL, err = oidcService.AuthURL(ctx, "fake")
+	require.NoError(t, err)
+	code, state = idp.authorize(authURL, jwt.MapClaims{"sub": "alice", "email": "tester@example.com", "email_verified": true})
+	loginCode, err := oidcService.CallbackCode(ctx, &types.OIDCCallbackInput{Provider: "fake", Code: code, State: state})
+	require.NoError(t, err)
+	exchanged, err := oidcService.Exchange(ctx, &types.OIDCExchangeInput{Code: loginCode})
+	require.NoError(t, err)
+	_, _, err = auth.AuthenticateUser(ctx, exchanged.AccessToken)
+	require.NoError(t, err)
+	_, err = oidcService.Exchange(ctx, &types.OIDCExchangeInput{Code: loginCode})
+	assertErrorCode(t, err, apperrs.CodeBadRequest)
+}
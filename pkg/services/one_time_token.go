@@ -0,0 +1,132 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/liukeshao/echo-template/ent"
+	"github.com/liukeshao/echo-template/ent/onetimetoken"
+	"github.com/liukeshao/echo-template/pkg/apperrs"
+	"github.com/liukeshao/echo-template/pkg/utils"
+)
+
+// issueOneTimeToken 为用户签发指定用途的一次性令牌并返回原始令牌，同一用途之前签发的令牌全部作废
+func issueOneTimeToken(ctx context.Context, tc *ent.OneTimeTokenClient, hashKey string, userID string, purpose onetimetoken.Purpose, ttl time.Duration) (string, error) {
+	return issueBoundOneTimeToken(ctx, tc, hashKey, userID, purpose, ttl, "")
+}
+
+// issueBoundOneTimeToken 签发绑定到客户端随机值的一次性令牌，使用时必须提供相同的随机值，nonce 为空时不绑定
+func issueBoundOneTimeToken(ctx context.Context, tc *ent.OneTimeTokenClient, hashKey string, userID string, purpose onetimetoken.Purpose, ttl time.Duration, nonce string) (string, error) {
+	if err := voidOneTimeTokens(ctx, tc, userID, purpose); err != nil {
+		return "", err
+	}
+
+	raw, create := newOneTimeToken(tc, hashKey, userID, purpose, ttl)
+	if nonce != "" {
+		create.SetNonceHash(utils.HashToken(hashKey, nonce))
+	}
+	if err := create.Exec(ctx); err != nil {
+		slog.ErrorContext(ctx, "保存一次性令牌失败", "error", err, "user_id", userID, "purpose", purpose)
+		return "", apperrs.ErrDatabase.With("user_id", userID).With("purpose", purpose).With("原始错误", err).Errorf("保存一次性令牌失败")
+	}
+
+	return raw, nil
+}
+
+// voidOneTimeTokens 作废用户指定用途的所有未使用令牌
+func voidOneTimeTokens(ctx context.Context, tc *ent.OneTimeTokenClient, userID string, purpose onetimetoken.Purpose) error {
+	_, err := tc.Update().
+		Where(
+			onetimetoken.UserID(userID),
+			onetimetoken.PurposeEQ(purpose),
+			onetimetoken.UsedAtIsNil(),
+		).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "作废一次性令牌失败", "error", err, "user_id", userID, "purpose", purpose)
+		return apperrs.ErrDatabase.With("user_id", userID).With("purpose", purpose).With("原始错误", err).Errorf("作废一次性令牌失败")
+	}
+	return nil
+}
+
+// newOneTimeToken 生成原始令牌并返回保存其哈希的创建器，调用方可以补充字段后保存
+func newOneTimeToken(tc *ent.OneTimeTokenClient, hashKey string, userID string, purpose onetimetoken.Purpose, ttl time.Duration) (string, *ent.OneTimeTokenCreate) {
+	raw := utils.GenerateRandomToken()
+	create := tc.Create().
+		SetID(utils.GenerateULID()).
+		SetUserID(userID).
+		SetPurpose(purpose).
+		SetTokenHash(utils.HashToken(hashKey, raw)).
+		SetExpiresAt(time.Now().Add(ttl))
+	return raw, create
+}
+
+// findOneTimeToken 查找仍然有效的一次性令牌，令牌不存在、已使用或已过期时返回 nil
+func findOneTimeToken(ctx context.Context, tc *ent.OneTimeTokenClient, hashKey string, raw string, purpose onetimetoken.Purpose) (*ent.OneTimeToken, error) {
+	ott, err := tc.Query().
+		Where(
+			onetimetoken.TokenHash(utils.HashToken(hashKey, raw)),
+			onetimetoken.PurposeEQ(purpose),
+		).
+		Only(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		return nil, nil
+	case err != nil:
+		slog.ErrorContext(ctx, "查询一次性令牌失败", "error", err, "purpose", purpose)
+		return nil, apperrs.ErrDatabase.With("purpose", purpose).With("原始错误", err).Errorf("查询一次性令牌失败")
+	}
+
+	if ott.UsedAt != nil || time.Now().After(ott.ExpiresAt) {
+		return nil, nil
+	}
+	return ott, nil
+}
+
+// useOneTimeToken 带条件地将令牌标记为已使用，防止并发请求重复使用同一令牌。令牌已被使用时返回 false
+func useOneTimeToken(ctx context.Context, tc *ent.OneTimeTokenClient, ott *ent.OneTimeToken) (bool, error) {
+	affected, err := tc.Update().
+		Where(
+			onetimetoken.ID(ott.ID),
+			onetimetoken.UsedAtIsNil(),
+		).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "使用一次性令牌失败", "error", err, "purpose", ott.Purpose)
+		return false, apperrs.ErrDatabase.With("purpose", ott.Purpose).With("原始错误", err).Errorf("使用一次性令牌失败")
+	}
+	return affected > 0, nil
+}
+
+// consumeOneTimeToken 校验并使用一次性令牌，令牌不存在、已使用或已过期时返回错误
+func consumeOneTimeToken(ctx context.Context, tc *ent.OneTimeTokenClient, hashKey string, raw string, purpose onetimetoken.Purpose) (*ent.OneTimeToken, error) {
+	ott, err := findOneTimeToken(ctx, tc, hashKey, raw, purpose)
+	if err != nil {
+		return nil, err
+	}
+	if ott == nil {
+		return nil, apperrs.ErrBadRequest.With("purpose", purpose).Public("链接无效或已过期").Errorf("链接无效或已过期")
+	}
+
+	ok, err := useOneTimeToken(ctx, tc, ott)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, apperrs.ErrBadRequest.With("purpose", purpose).With("user_id", ott.UserID).Public("链接无效或已过期").Errorf("链接无效或已过期")
+	}
+
+	return ott, nil
+}
+
+// formatExpiry 将有效期格式化为邮件中展示的文字
+func formatExpiry(d time.Duration) string {
+	if d >= time.Hour && d%time.Hour == 0 {
+		return fmt.Sprintf("%d小时", d/time.Hour)
+	}
+	return fmt.Sprintf("%d分钟", max(d/time.Minute, 1))
+}
@@ -0,0 +1,486 @@
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"time"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+
+	"github.com/liukeshao/echo-template/config"
+	"github.com/liukeshao/echo-template/ent"
+	"github.com/liukeshao/echo-template/ent/passkey"
+	"github.com/liukeshao/echo-template/ent/passkeysession"
+	"github.com/liukeshao/echo-template/ent/schema"
+	userEnt "github.com/liukeshao/echo-template/ent/user"
+	"github.com/liukeshao/echo-template/pkg/apperrs"
+	"github.com/liukeshao/echo-template/pkg/types"
+	"github.com/liukeshao/echo-template/pkg/utils"
+)
+
+// 通行密钥常量
+const (
+	DefaultPasskeyTimeout = 5 * time.Minute // 注册与登录流程默认有效期
+	DefaultPasskeyName    = "通行密钥"          // 未指定名称时使用的凭据名称
+)
+
+// PasskeyService 通行密钥（WebAuthn）服务
+type PasskeyService struct {
+	orm      *ent.Client
+	auth     *AuthService
+	webAuthn *webauthn.WebAuthn
+	hashKey  string
+	timeout  time.Duration
+}
+
+// NewPasskeyService 创建通行密钥服务，未配置的依赖方信息从应用配置中推导
+func NewPasskeyService(orm *ent.Client, auth *AuthService, hashKey string, app config.AppConfig, cfg config.WebAuthnConfig) (*PasskeyService, error) {
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = DefaultPasskeyTimeout
+	}
+	rpID := cfg.RPID
+	if rpID == "" {
+		u, err := url.Parse(app.Host)
+		if err != nil {
+			return nil, fmt.Errorf("webauthn: 无法从 app.host 推导 rpID: %w", err)
+		}
+		rpID = u.Hostname()
+	}
+	displayName := cfg.RPDisplayName
+	if displayName == "" {
+		displayName = app.Name
+	}
+	origins := cfg.Origins
+	if len(origins) == 0 {
+		origins = []string{app.Host}
+	}
+
+	timeouts := webauthn.TimeoutConfig{Enforce: true, Timeout: timeout, TimeoutUVD: timeout}
+	w, err := webauthn.New(&webauthn.Config{
+		RPID:          rpID,
+		RPDisplayName: displayName,
+		RPOrigins:     origins,
+		Timeouts: webauthn.TimeoutsConfig{
+			Login:        timeouts,
+			Registration: timeouts,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("webauthn: 配置无效: %w", err)
+	}
+
+	return &PasskeyService{
+		orm:      orm,
+		auth:     auth,
+		webAuthn: w,
+		hashKey:  hashKey,
+		timeout:  timeout,
+	}, nil
+}
+
+// passkeyUser 将用户及其凭据适配为 webauthn.User
+type passkeyUser struct {
+	user     *ent.User
+	passkeys []*ent.Passkey
+}
+
+func (u *passkeyUser) WebAuthnID() []byte {
+	return []byte(u.user.ID)
+}
+
+func (u *passkeyUser) WebAuthnName() string {
+	return u.user.Email
+}
+
+func (u *passkeyUser) WebAuthnDisplayName() string {
+	return u.user.Username
+}
+
+func (u *passkeyUser) WebAuthnCredentials() []webauthn.Credential {
+	credentials := make([]webauthn.Credential, 0, len(u.passkeys))
+	for _, pk := range u.passkeys {
+		id, err := base64.RawURLEncoding.DecodeString(pk.CredentialID)
+		if err != nil {
+			continue
+		}
+		transports := make([]protocol.AuthenticatorTransport, len(pk.Transports))
+		for i, t := range pk.Transports {
+			transports[i] = protocol.AuthenticatorTransport(t)
+		}
+		credentials = append(credentials, webauthn.Credential{
+			ID:              id,
+			PublicKey:       pk.PublicKey,
+			AttestationType: pk.AttestationType,
+			Transport:       transports,
+			Flags: webauthn.CredentialFlags{
+				BackupEligible: pk.BackupEligible,
+				BackupState:    pk.BackupState,
+			},
+			Authenticator: webauthn.Authenticator{
+				AAGUID:    pk.Aaguid,
+				SignCount: pk.SignCount,
+			},
+		})
+	}
+	return credentials
+}
+
+// passkeyByCredentialID 查找凭据ID对应的通行密钥记录
+func (u *passkeyUser) passkeyByCredentialID(id []byte) *ent.Passkey {
+	credentialID := base64.RawURLEncoding.EncodeToString(id)
+	for _, pk := range u.passkeys {
+		if pk.CredentialID == credentialID {
+			return pk
+		}
+	}
+	return nil
+}
+
+// loadUser 查询用户及其所有通行密钥
+func (s *PasskeyService) loadUser(ctx context.Context, userID string) (*passkeyUser, error) {
+	u, err := s.orm.User.Query().
+		Where(userEnt.ID(userID)).
+		WithPasskeys().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperrs.ErrNotFound.With("user_id", userID).Errorf("用户不存在")
+		}
+		slog.ErrorContext(ctx, "查询用户失败", "error", err, "user_id", userID)
+		return nil, apperrs.ErrDatabase.With("user_id", userID).With("原始错误", err).Errorf("查询用户失败")
+	}
+	return &passkeyUser{user: u, passkeys: u.Edges.Passkeys}, nil
+}
+
+// saveSession 保存流程数据并返回原始会话令牌
+func (s *PasskeyService) saveSession(ctx context.Context, ceremony passkeysession.Ceremony, userID string, data *webauthn.SessionData) (string, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return "", apperrs.ErrInternal.With("原始错误", err).Errorf("编码通行密钥会话失败")
+	}
+
+	sessionToken := utils.GenerateRandomToken()
+	err = s.orm.PasskeySession.Create().
+		SetID(utils.GenerateULID()).
+		SetCeremony(ceremony).
+		SetUserID(userID).
+		SetTokenHash(utils.HashToken(s.hashKey, sessionToken)).
+		SetData(raw).
+		SetExpiresAt(time.Now().Add(s.timeout)).
+		Exec(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "保存通行密钥会话失败", "error", err, "ceremony", ceremony)
+		return "", apperrs.ErrDatabase.With("ceremony", ceremony).With("原始错误", err).Errorf("保存通行密钥会话失败")
+	}
+
+	return sessionToken, nil
+}
+
+// takeSession 校验并使用流程会话，每个会话只能完成一次
+func (s *PasskeyService) takeSession(ctx context.Context, sessionToken string, ceremony passkeysession.Ceremony) (*ent.PasskeySession, *webauthn.SessionData, error) {
+	expired := apperrs.ErrBadRequest.With("ceremony", ceremony).Public("通行密钥请求已过期，请重试")
+
+	ps, err := s.orm.PasskeySession.Query().
+		Where(
+			passkeysession.TokenHash(utils.HashToken(s.hashKey, sessionToken)),
+			passkeysession.CeremonyEQ(ceremony),
+		).
+		Only(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		return nil, nil, expired.Errorf("通行密钥会话不存在")
+	case err != nil:
+		slog.ErrorContext(ctx, "查询通行密钥会话失败", "error", err, "ceremony", ceremony)
+		return nil, nil, apperrs.ErrDatabase.With("ceremony", ceremony).With("原始错误", err).Errorf("查询通行密钥会话失败")
+	}
+	if ps.UsedAt != nil || time.Now().After(ps.ExpiresAt) {
+		return nil, nil, expired.Errorf("通行密钥会话已失效")
+	}
+
+	// 带条件更新，防止同一挑战被并发使用
+	affected, err := s.orm.PasskeySession.Update().
+		Where(
+			passkeysession.ID(ps.ID),
+			passkeysession.UsedAtIsNil(),
+		).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "使用通行密钥会话失败", "error", err, "ceremony", ceremony)
+		return nil, nil, apperrs.ErrDatabase.With("ceremony", ceremony).With("原始错误", err).Errorf("使用通行密钥会话失败")
+	}
+	if affected == 0 {
+		return nil, nil, expired.Errorf("通行密钥会话已使用")
+	}
+
+	var data webauthn.SessionData
+	if err := json.Unmarshal(ps.Data, &data); err != nil {
+		return nil, nil, apperrs.ErrInternal.With("ceremony", ceremony).With("原始错误", err).Errorf("解码通行密钥会话失败")
+	}
+
+	return ps, &data, nil
+}
+
+// BeginRegistration 开始为当前用户注册通行密钥
+func (s *PasskeyService) BeginRegistration(ctx context.Context, userID string) (*types.PasskeyBeginOutput, error) {
+	u, err := s.loadUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	creation, data, err := s.webAuthn.BeginRegistration(u,
+		webauthn.WithExclusions(webauthn.Credentials(u.WebAuthnCredentials()).CredentialDescriptors()),
+		webauthn.WithAuthenticatorSelection(protocol.AuthenticatorSelection{
+			ResidentKey:        protocol.ResidentKeyRequirementRequired,
+			RequireResidentKey: protocol.ResidentKeyRequired(),
+			UserVerification:   protocol.VerificationRequired,
+		}),
+	)
+	if err != nil {
+		slog.ErrorContext(ctx, "生成通行密钥注册参数失败", "error", err, "user_id", userID)
+		return nil, apperrs.ErrInternal.With("user_id", userID).With("原始错误", err).Errorf("生成通行密钥注册参数失败")
+	}
+
+	sessionToken, err := s.saveSession(ctx, passkeysession.CeremonyRegistration, userID, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.PasskeyBeginOutput{SessionToken: sessionToken, Options: creation}, nil
+}
+
+// FinishRegistration 校验认证器返回的凭据并保存
+func (s *PasskeyService) FinishRegistration(ctx context.Context, userID string, input *types.PasskeyRegisterFinishInput) (*types.PasskeyInfo, error) {
+	ps, data, err := s.takeSession(ctx, input.SessionToken, passkeysession.CeremonyRegistration)
+	if err != nil {
+		return nil, err
+	}
+	if ps.UserID != userID {
+		return nil, apperrs.ErrBadRequest.With("user_id", userID).Public("通行密钥请求已过期，请重试").Errorf("通行密钥会话不属于当前用户")
+	}
+
+	u, err := s.loadUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := protocol.ParseCredentialCreationResponseBytes(input.Credential)
+	if err != nil {
+		slog.WarnContext(ctx, "解析通行密钥注册响应失败", "error", err, "user_id", userID)
+		return nil, apperrs.ErrBadRequest.With("user_id", userID).With("原始错误", err).Public("通行密钥验证失败").Errorf("解析通行密钥注册响应失败")
+	}
+	credential, err := s.webAuthn.CreateCredential(u, *data, parsed)
+	if err != nil {
+		slog.WarnContext(ctx, "通行密钥注册验证失败", "error", err, "user_id", userID)
+		return nil, apperrs.ErrBadRequest.With("user_id", userID).With("原始错误", err).Public("通行密钥验证失败").Errorf("通行密钥注册验证失败")
+	}
+
+	name := input.Name
+	if name == "" {
+		name = DefaultPasskeyName
+	}
+	transports := make([]string, len(credential.Transport))
+	for i, t := range credential.Transport {
+		transports[i] = string(t)
+	}
+
+	pk, err := s.orm.Passkey.Create().
+		SetID(utils.GenerateULID()).
+		SetUserID(userID).
+		SetName(name).
+		SetCredentialID(base64.RawURLEncoding.EncodeToString(credential.ID)).
+		SetPublicKey(credential.PublicKey).
+		SetAttestationType(credential.AttestationType).
+		SetAaguid(credential.Authenticator.AAGUID).
+		SetTransports(transports).
+		SetSignCount(credential.Authenticator.SignCount).
+		SetBackupEligible(credential.Flags.BackupEligible).
+		SetBackupState(credential.Flags.BackupState).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, apperrs.ErrConflict.With("user_id", userID).Public("该通行密钥已注册").Errorf("通行密钥已注册")
+		}
+		slog.ErrorContext(ctx, "保存通行密钥失败", "error", err, "user_id", userID)
+		return nil, apperrs.ErrDatabase.With("user_id", userID).With("原始错误", err).Errorf("保存通行密钥失败")
+	}
+
+	slog.InfoContext(ctx, "已注册通行密钥", "user_id", userID, "passkey_id", pk.ID)
+	return passkeyInfo(pk), nil
+}
+
+// BeginLogin 开始无密码登录，由认证器选择可用的通行密钥
+func (s *PasskeyService) BeginLogin(ctx context.Context) (*types.PasskeyBeginOutput, error) {
+	assertion, data, err := s.webAuthn.BeginDiscoverableLogin(
+		webauthn.WithUserVerification(protocol.VerificationRequired),
+	)
+	if err != nil {
+		slog.ErrorContext(ctx, "生成通行密钥登录参数失败", "error", err)
+		return nil, apperrs.ErrInternal.With("原始错误", err).Errorf("生成通行密钥登录参数失败")
+	}
+
+	sessionToken, err := s.saveSession(ctx, passkeysession.CeremonyLogin, "", data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.PasskeyBeginOutput{SessionToken: sessionToken, Options: assertion}, nil
+}
+
+// FinishLogin 校验认证器返回的断言并签发令牌
+func (s *PasskeyService) FinishLogin(ctx context.Context, input *types.PasskeyLoginFinishInput) (*types.AuthOutput, error) {
+	_, data, err := s.takeSession(ctx, input.SessionToken, passkeysession.CeremonyLogin)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := protocol.ParseCredentialRequestResponseBytes(input.Credential)
+	if err != nil {
+		slog.WarnContext(ctx, "解析通行密钥登录响应失败", "error", err)
+		return nil, apperrs.ErrUnauthorized.With("原始错误", err).Public("通行密钥验证失败").Errorf("解析通行密钥登录响应失败")
+	}
+
+	var owner *passkeyUser
+	handler := func(rawID, userHandle []byte) (webauthn.User, error) {
+		u, err := s.loadUser(ctx, string(userHandle))
+		if err != nil {
+			return nil, err
+		}
+		owner = u
+		return u, nil
+	}
+	_, credential, err := s.webAuthn.ValidatePasskeyLogin(handler, *data, parsed)
+	if err != nil {
+		slog.WarnContext(ctx, "通行密钥登录验证失败", "error", err)
+		return nil, apperrs.ErrUnauthorized.With("原始错误", err).Public("通行密钥验证失败").Errorf("通行密钥登录验证失败")
+	}
+
+	user := owner.user
+	pk := owner.passkeyByCredentialID(credential.ID)
+	if pk == nil {
+		return nil, apperrs.ErrUnauthorized.With("user_id", user.ID).Public("通行密钥验证失败").Errorf("通行密钥不存在")
+	}
+
+	// 签名计数没有增长说明可能存在被克隆的认证器
+	if credential.Authenticator.CloneWarning {
+		slog.WarnContext(ctx, "通行密钥签名计数异常，可能存在克隆的认证器", "user_id", user.ID, "passkey_id", pk.ID,
+			"stored_count", pk.SignCount, "received_count", parsed.Response.AuthenticatorData.Counter)
+		return nil, apperrs.ErrUnauthorized.With("user_id", user.ID).With("passkey_id", pk.ID).Public("通行密钥验证失败").Errorf("通行密钥签名计数异常")
+	}
+
+	if err := s.auth.validateUser(ctx, user); err != nil {
+		return nil, err
+	}
+
+	// 以读取到的签名计数为条件更新，并发使用同一断言时只有一个能成功
+	affected, err := s.orm.Passkey.Update().
+		Where(
+			passkey.ID(pk.ID),
+			passkey.SignCount(pk.SignCount),
+		).
+		SetSignCount(credential.Authenticator.SignCount).
+		SetBackupState(credential.Flags.BackupState).
+		SetLastUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "更新通行密钥签名计数失败", "error", err, "passkey_id", pk.ID)
+		return nil, apperrs.ErrDatabase.With("passkey_id", pk.ID).With("原始错误", err).Errorf("更新通行密钥签名计数失败")
+	}
+	if affected == 0 {
+		return nil, apperrs.ErrUnauthorized.With("user_id", user.ID).With("passkey_id", pk.ID).Public("通行密钥验证失败").Errorf("通行密钥签名计数已变化")
+	}
+
+	authOutput, err := s.auth.generateTokenPair(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 更新最后登录时间
+	if _, err := s.auth.updateLastLoginTime(ctx, user); err != nil {
+		slog.WarnContext(ctx, "更新最后登录时间失败", "error", err, "user_id", user.ID)
+	}
+
+	slog.InfoContext(ctx, "通行密钥登录成功", "user_id", user.ID, "passkey_id", pk.ID)
+	return authOutput, nil
+}
+
+// ListPasskeys 获取当前用户注册的通行密钥
+func (s *PasskeyService) ListPasskeys(ctx context.Context, userID string) (*types.ListPasskeysOutput, error) {
+	passkeys, err := s.orm.Passkey.Query().
+		Where(passkey.UserID(userID)).
+		Order(ent.Asc(passkey.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "查询通行密钥失败", "error", err, "user_id", userID)
+		return nil, apperrs.ErrDatabase.With("user_id", userID).With("原始错误", err).Errorf("查询通行密钥失败")
+	}
+
+	out := &types.ListPasskeysOutput{Passkeys: make([]*types.PasskeyInfo, 0, len(passkeys))}
+	for _, pk := range passkeys {
+		out.Passkeys = append(out.Passkeys, passkeyInfo(pk))
+	}
+	return out, nil
+}
+
+// RenamePasskey 重命名当前用户的通行密钥
+func (s *PasskeyService) RenamePasskey(ctx context.Context, userID string, input *types.RenamePasskeyInput) (*types.PasskeyInfo, error) {
+	affected, err := s.orm.Passkey.Update().
+		Where(
+			passkey.ID(input.ID),
+			passkey.UserID(userID),
+		).
+		SetName(input.Name).
+		Save(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "重命名通行密钥失败", "error", err, "user_id", userID, "passkey_id", input.ID)
+		return nil, apperrs.ErrDatabase.With("user_id", userID).With("passkey_id", input.ID).With("原始错误", err).Errorf("重命名通行密钥失败")
+	}
+	if affected == 0 {
+		return nil, apperrs.ErrNotFound.With("user_id", userID).With("passkey_id", input.ID).Public("通行密钥不存在").Errorf("通行密钥不存在")
+	}
+
+	pk, err := s.orm.Passkey.Get(ctx, input.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "查询通行密钥失败", "error", err, "passkey_id", input.ID)
+		return nil, apperrs.ErrDatabase.With("passkey_id", input.ID).With("原始错误", err).Errorf("查询通行密钥失败")
+	}
+	return passkeyInfo(pk), nil
+}
+
+// DeletePasskey 删除当前用户的通行密钥，删除后该凭据无法再用于登录
+func (s *PasskeyService) DeletePasskey(ctx context.Context, userID string, passkeyID string) error {
+	// 物理删除，不保留公钥等凭据数据
+	deleted, err := s.orm.Passkey.Delete().
+		Where(
+			passkey.ID(passkeyID),
+			passkey.UserID(userID),
+		).
+		Exec(schema.SkipSoftDelete(ctx))
+	if err != nil {
+		slog.ErrorContext(ctx, "删除通行密钥失败", "error", err, "user_id", userID, "passkey_id", passkeyID)
+		return apperrs.ErrDatabase.With("user_id", userID).With("passkey_id", passkeyID).With("原始错误", err).Errorf("删除通行密钥失败")
+	}
+	if deleted == 0 {
+		return apperrs.ErrNotFound.With("user_id", userID).With("passkey_id", passkeyID).Public("通行密钥不存在").Errorf("通行密钥不存在")
+	}
+
+	slog.InfoContext(ctx, "已删除通行密钥", "user_id", userID, "passkey_id", passkeyID)
+	return nil
+}
+
+// passkeyInfo 转换为对外展示的通行密钥信息
+func passkeyInfo(pk *ent.Passkey) *types.PasskeyInfo {
+	return &types.PasskeyInfo{
+		ID:         pk.ID,
+		Name:       pk.Name,
+		Synced:     pk.BackupState,
+		CreatedAt:  pk.CreatedAt,
+		LastUsedAt: pk.LastUsedAt,
+	}
+}
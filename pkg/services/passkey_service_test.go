@@ -0,0 +1,183 @@
+package services
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+	"github.com/go-webauthn/webauthn/protocol/webauthncose"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/liukeshao/echo-template/config"
+	"github.com/liukeshao/echo-template/pkg/apperrs"
+	"github.com/liukeshao/echo-template/pkg/types"
+)
+
+// softAuthenticator 用于测试的软件认证器，使用ES256密钥和 none 证明格式
+type softAuthenticator struct {
+	t          *testing.T
+	origin     string
+	key        *ecdsa.PrivateKey
+	id         []byte
+	userHandle []byte
+	signCount  uint32
+}
+
+func newSoftAuthenticator(t *testing.T, origin string) *softAuthenticator {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	id := make([]byte, 16)
+	rand.Read(id)
+	return &softAuthenticator{t: t, origin: origin, key: key, id: id}
+}
+
+// clientData 生成客户端数据JSON
+func (a *softAuthenticator) clientData(ceremony protocol.CeremonyType, challenge protocol.URLEncodedBase64) []byte {
+	data, err := json.Marshal(protocol.CollectedClientData{
+		Type:      ceremony,
+		Challenge: base64.RawURLEncoding.EncodeToString(challenge),
+		Origin:    a.origin,
+	})
+	require.NoError(a.t, err)
+	return data
+}
+
+// authData 生成认证器数据：rpIdHash | flags | signCount [| attestedCredentialData]
+func (a *softAuthenticator) authData(rpID string, flags protocol.AuthenticatorFlags, attested []byte) []byte {
+	rpIDHash := sha256.Sum256([]byte(rpID))
+	data := append(rpIDHash[:], byte(flags))
+	data = binary.BigEndian.AppendUint32(data, a.signCount)
+	return append(data, attested...)
+}
+
+// create 模拟 navigator.credentials.create
+func (a *softAuthenticator) create(creation *protocol.CredentialCreation) json.RawMessage {
+	options := creation.Response
+	a.userHandle = options.User.ID.(protocol.URLEncodedBase64)
+
+	publicKey, err := webauthncbor.Marshal(webauthncose.EC2PublicKeyData{
+		PublicKeyData: webauthncose.PublicKeyData{
+			KeyType:   int64(webauthncose.EllipticKey),
+			Algorithm: int64(webauthncose.AlgES256),
+		},
+		Curve:  int64(webauthncose.P256),
+		XCoord: a.key.X.FillBytes(make([]byte, 32)),
+		YCoord: a.key.Y.FillBytes(make([]byte, 32)),
+	})
+	require.NoError(a.t, err)
+
+	attested := make([]byte, 16) // AAGUID
+	attested = binary.BigEndian.AppendUint16(attested, uint16(len(a.id)))
+	attested = append(attested, a.id...)
+	attested = append(attested, publicKey...)
+
+	flags := protocol.FlagUserPresent | protocol.FlagUserVerified | protocol.FlagAttestedCredentialData
+	attestation, err := webauthncbor.Marshal(map[string]any{
+		"fmt":      "none",
+		"attStmt":  map[string]any{},
+		"authData": a.authData(options.RelyingParty.ID, flags, attested),
+	})
+	require.NoError(a.t, err)
+
+	return a.marshal(map[string]any{
+		"attestationObject": protocol.URLEncodedBase64(attestation),
+		"clientDataJSON":    protocol.URLEncodedBase64(a.clientData(protocol.CreateCeremony, options.Challenge)),
+	})
+}
+
+// get 模拟 navigator.credentials.get
+func (a *softAuthenticator) get(assertion *protocol.CredentialAssertion) json.RawMessage {
+	options := assertion.Response
+	a.signCount++
+
+	authData := a.authData(options.RelyingPartyID, protocol.FlagUserPresent|protocol.FlagUserVerified, nil)
+	clientData := a.clientData(protocol.AssertCeremony, options.Challenge)
+	clientDataHash := sha256.Sum256(clientData)
+	digest := sha256.Sum256(append(authData, clientDataHash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	require.NoError(a.t, err)
+
+	return a.marshal(map[string]any{
+		"authenticatorData": protocol.URLEncodedBase64(authData),
+		"clientDataJSON":    protocol.URLEncodedBase64(clientData),
+		"signature":         protocol.URLEncodedBase64(signature),
+		"userHandle":        protocol.URLEncodedBase64(a.userHandle),
+	})
+}
+
+func (a *softAuthenticator) marshal(response map[string]any) json.RawMessage {
+	data, err := json.Marshal(map[string]any{
+		"id":       base64.RawURLEncoding.EncodeToString(a.id),
+		"rawId":    protocol.URLEncodedBase64(a.id),
+		"type":     "public-key",
+		"response": response,
+	})
+	require.NoError(a.t, err)
+	return data
+}
+
+func TestPasskeyRegistrationAndLogin(t *testing.T) {
+	auth, client := newTestAuthService(t)
+	ctx := context.Background()
+	registerTestUser(t, auth)
+	userID := client.User.Query().OnlyIDX(ctx)
+
+	app := config.AppConfig{Name: "test", Host: "http://localhost:8000"}
+	passkeys, err := NewPasskeyService(client, auth, "test-hash-key", app, config.WebAuthnConfig{})
+	require.NoError(t, err)
+	authenticator := newSoftAuthenticator(t, app.Host)
+
+	// 注册
+	begin, err := passkeys.BeginRegistration(ctx, userID)
+	require.NoError(t, err)
+	finish := &types.PasskeyRegisterFinishInput{
+		SessionToken: begin.SessionToken,
+		Name:         "测试设备",
+		Credential:   authenticator.create(begin.Options.(*protocol.CredentialCreation)),
+	}
+	info, err := passkeys.FinishRegistration(ctx, userID, finish)
+	require.NoError(t, err)
+	assert.Equal(t, "测试设备", info.Name)
+
+	_, err = passkeys.FinishRegistration(ctx, userID, finish)
+	assertErrorCode(t, err, apperrs.CodeBadRequest)
+
+	login := func() (*types.AuthOutput, error) {
+		begin, err := passkeys.BeginLogin(ctx)
+		require.NoError(t, err)
+		return passkeys.FinishLogin(ctx, &types.PasskeyLoginFinishInput{
+			SessionToken: begin.SessionToken,
+			Credential:   authenticator.get(begin.Options.(*protocol.CredentialAssertion)),
+		})
+	}
+
+	// 无密码登录并记录签名计数
+	out, err := login()
+	require.NoError(t, err)
+	_, _, err = auth.AuthenticateUser(ctx, out.AccessToken)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, client.Passkey.GetX(ctx, info.ID).SignCount)
+
+	// 签名计数回退视为克隆的认证器
+	authenticator.signCount = 0
+	_, err = login()
+	assertErrorCode(t, err, apperrs.CodeUnauthorized)
+	authenticator.signCount = 1
+
+	// 删除后无法再登录
+	list, err := passkeys.ListPasskeys(ctx, userID)
+	require.NoError(t, err)
+	require.Len(t, list.Passkeys, 1)
+	require.NoError(t, passkeys.DeletePasskey(ctx, userID, info.ID))
+	_, err = login()
+	assert.Error(t, err)
+}
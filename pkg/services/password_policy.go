@@ -0,0 +1,234 @@
+package services
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/liukeshao/echo-template/config"
+	"github.com/liukeshao/echo-template/ent"
+	"github.com/liukeshao/echo-template/ent/passwordhistory"
+	"github.com/liukeshao/echo-template/ent/schema"
+	"github.com/liukeshao/echo-template/pkg/apperrs"
+	"github.com/liukeshao/echo-template/pkg/utils"
+)
+
+// 密码策略默认参数
+const (
+	DefaultPasswordMinLength = 8  // 默认最小长度
+	DefaultPasswordMaxLength = 64 // 默认最大长度
+	minUserInfoLength        = 3  // 用户名或邮箱前缀短于该长度时不检查是否包含，避免误判
+)
+
+// PasswordPolicy 密码策略，统一校验注册、修改密码和重置密码时的新密码
+type PasswordPolicy struct {
+	cfg    config.PasswordPolicyConfig
+	common map[string]struct{}
+	hasher utils.PasswordHasher
+}
+
+// NewPasswordPolicy 创建密码策略，common 为常见或已泄露的密码列表，hasher 用于比对最近使用过的密码
+func NewPasswordPolicy(cfg config.PasswordPolicyConfig, common []string, hasher utils.PasswordHasher) *PasswordPolicy {
+	if cfg.MinLength <= 0 {
+		cfg.MinLength = DefaultPasswordMinLength
+	}
+	if cfg.MaxLength <= 0 {
+		cfg.MaxLength = DefaultPasswordMaxLength
+	}
+	cfg.MaxLength = max(cfg.MaxLength, cfg.MinLength)
+
+	p := &PasswordPolicy{
+		cfg:    cfg,
+		common: make(map[string]struct{}, len(common)),
+		hasher: hasher,
+	}
+	for _, password := range common {
+		p.common[strings.ToLower(password)] = struct{}{}
+	}
+	return p
+}
+
+// LoadCommonPasswords 读取常见密码列表文件，每行一个密码，忽略空行和 # 开头的注释
+func LoadCommonPasswords(file string) ([]string, error) {
+	if file == "" {
+		return nil, nil
+	}
+
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, fmt.Errorf("打开常见密码列表失败: %w", err)
+	}
+	defer f.Close()
+
+	var passwords []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		passwords = append(passwords, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("读取常见密码列表失败: %w", err)
+	}
+	return passwords, nil
+}
+
+// Check 校验新密码是否满足策略，field 为错误详情中的字段位置，返回列出每条未通过规则的错误
+func (p *PasswordPolicy) Check(field string, password string, username string, email string) error {
+	var details []*apperrs.ErrorDetail
+	fail := func(format string, args ...any) {
+		details = append(details, &apperrs.ErrorDetail{
+			Location: field,
+			Message:  fmt.Sprintf(format, args...),
+		})
+	}
+
+	length := utf8.RuneCountInString(password)
+	if length < p.cfg.MinLength {
+		fail("密码长度至少为%d个字符", p.cfg.MinLength)
+	}
+	if length > p.cfg.MaxLength {
+		fail("密码长度不能超过%d个字符", p.cfg.MaxLength)
+	}
+
+	var hasUpper, hasLower, hasDigit, hasSymbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r) || unicode.IsSpace(r):
+			hasSymbol = true
+		}
+	}
+	if p.cfg.RequireUpper && !hasUpper {
+		fail("密码必须包含大写字母")
+	}
+	if p.cfg.RequireLower && !hasLower {
+		fail("密码必须包含小写字母")
+	}
+	if p.cfg.RequireDigit && !hasDigit {
+		fail("密码必须包含数字")
+	}
+	if p.cfg.RequireSymbol && !hasSymbol {
+		fail("密码必须包含特殊字符")
+	}
+
+	lower := strings.ToLower(password)
+	if p.cfg.DisallowUserInfo {
+		if containsUserInfo(lower, username) {
+			fail("密码不能包含用户名")
+		}
+		localPart, _, _ := strings.Cut(email, "@")
+		if containsUserInfo(lower, localPart) {
+			fail("密码不能包含邮箱地址")
+		}
+	}
+
+	if _, ok := p.common[lower]; ok {
+		fail("密码过于常见，容易被猜到")
+	}
+
+	if len(details) == 0 {
+		return nil
+	}
+	return passwordPolicyError(details)
+}
+
+// CheckReuse 校验新密码是否与当前密码或最近使用过的密码相同
+func (p *PasswordPolicy) CheckReuse(ctx context.Context, histories *ent.PasswordHistoryClient, field string, u *ent.User, password string) error {
+	if p.cfg.HistorySize <= 0 {
+		return nil
+	}
+
+	hashes := []string{u.PasswordHash}
+	if p.cfg.HistorySize > 1 {
+		previous, err := histories.Query().
+			Where(passwordhistory.UserID(u.ID)).
+			Order(ent.Desc(passwordhistory.FieldCreatedAt), ent.Desc(passwordhistory.FieldID)).
+			Limit(p.cfg.HistorySize - 1).
+			Select(passwordhistory.FieldPasswordHash).
+			Strings(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "查询密码历史失败", "error", err, "user_id", u.ID)
+			return apperrs.ErrDatabase.With("user_id", u.ID).With("原始错误", err).Errorf("查询密码历史失败")
+		}
+		hashes = append(hashes, previous...)
+	}
+
+	for _, hash := range hashes {
+		if ok, _, _ := p.hasher.Verify(hash, password); ok {
+			return passwordPolicyError([]*apperrs.ErrorDetail{{
+				Location: field,
+				Message:  fmt.Sprintf("不能使用最近%d次使用过的密码", p.cfg.HistorySize),
+			}})
+		}
+	}
+	return nil
+}
+
+// Remember 在修改密码前保存用户的当前密码哈希，并清理超出保留数量的历史记录
+func (p *PasswordPolicy) Remember(ctx context.Context, histories *ent.PasswordHistoryClient, u *ent.User) error {
+	// 当前密码保存在用户表中，历史表只需保留之前的 HistorySize-1 个
+	keep := p.cfg.HistorySize - 1
+	if keep <= 0 {
+		return nil
+	}
+
+	err := histories.Create().
+		SetID(utils.GenerateULID()).
+		SetUserID(u.ID).
+		SetPasswordHash(u.PasswordHash).
+		Exec(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "保存密码历史失败", "error", err, "user_id", u.ID)
+		return apperrs.ErrDatabase.With("user_id", u.ID).With("原始错误", err).Errorf("保存密码历史失败")
+	}
+
+	stale, err := histories.Query().
+		Where(passwordhistory.UserID(u.ID)).
+		Order(ent.Desc(passwordhistory.FieldCreatedAt), ent.Desc(passwordhistory.FieldID)).
+		Offset(keep).
+		IDs(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "查询过期的密码历史失败", "error", err, "user_id", u.ID)
+		return apperrs.ErrDatabase.With("user_id", u.ID).With("原始错误", err).Errorf("查询密码历史失败")
+	}
+	if len(stale) == 0 {
+		return nil
+	}
+
+	// 跳过逻辑删除，密码哈希不应长期保留
+	if _, err := histories.Delete().Where(passwordhistory.IDIn(stale...)).Exec(schema.SkipSoftDelete(ctx)); err != nil {
+		slog.ErrorContext(ctx, "清理密码历史失败", "error", err, "user_id", u.ID)
+		return apperrs.ErrDatabase.With("user_id", u.ID).With("原始错误", err).Errorf("清理密码历史失败")
+	}
+	return nil
+}
+
+// containsUserInfo 判断小写的密码是否包含用户名或邮箱前缀
+func containsUserInfo(lowerPassword string, info string) bool {
+	if utf8.RuneCountInString(info) < minUserInfoLength {
+		return false
+	}
+	return strings.Contains(lowerPassword, strings.ToLower(info))
+}
+
+// passwordPolicyError 返回带有字段级错误详情的响应
+func passwordPolicyError(details []*apperrs.ErrorDetail) error {
+	return &apperrs.Response{
+		Code:    apperrs.CodeBadRequest.ToInt(),
+		Message: "密码不符合安全要求",
+		Errors:  details,
+	}
+}
@@ -0,0 +1,79 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/liukeshao/echo-template/config"
+	"github.com/liukeshao/echo-template/pkg/apperrs"
+	"github.com/liukeshao/echo-template/pkg/types"
+)
+
+// assertPolicyViolation 断言错误为密码策略错误，并且包含指定规则的错误详情
+func assertPolicyViolation(t *testing.T, err error, messages ...string) {
+	t.Helper()
+	var resp *apperrs.Response
+	require.True(t, errors.As(err, &resp), "期望密码策略错误，实际为 %v", err)
+	assert.Equal(t, apperrs.CodeBadRequest.ToInt(), resp.Code)
+
+	var got []string
+	for _, detail := range resp.Errors {
+		got = append(got, detail.Message)
+	}
+	assert.ElementsMatch(t, messages, got)
+}
+
+func TestPasswordPolicyCheck(t *testing.T) {
+	policy := NewPasswordPolicy(config.PasswordPolicyConfig{
+		MinLength:        10,
+		MaxLength:        20,
+		RequireUpper:     true,
+		RequireLower:     true,
+		RequireDigit:     true,
+		RequireSymbol:    true,
+		DisallowUserInfo: true,
+	}, []string{"Correct-Horse-1"}, nil)
+
+	assert.NoError(t, policy.Check("Password", "Tr0ub4dor&3x", "tester", "tester@example.com"))
+
+	assertPolicyViolation(t, policy.Check("Password", "abc", "tester", "tester@example.com"),
+		"密码长度至少为10个字符", "密码必须包含大写字母", "密码必须包含数字", "密码必须包含特殊字符")
+	assertPolicyViolation(t, policy.Check("Password", "Tr0ub4dor&3x-Tr0ub4dor&3x", "tester", "tester@example.com"),
+		"密码长度不能超过20个字符")
+	assertPolicyViolation(t, policy.Check("Password", "My-Tester-Pw1", "tester", "someone@example.com"),
+		"密码不能包含用户名")
+	assertPolicyViolation(t, policy.Check("Password", "Hello-Alice-99", "bob", "alice@example.com"),
+		"密码不能包含邮箱地址")
+	assertPolicyViolation(t, policy.Check("Password", "correct-horse-1", "tester", "tester@example.com"),
+		"密码过于常见，容易被猜到", "密码必须包含大写字母")
+}
+
+func TestChangePasswordHistory(t *testing.T) {
+	auth, client := newTestAuthService(t)
+	ctx := context.Background()
+	registerTestUser(t, auth)
+	userID := client.User.Query().OnlyIDX(ctx)
+
+	me := NewMeService(client, auth, NewPasswordPolicy(config.PasswordPolicyConfig{HistorySize: 3}, nil, auth.hasher), auth.hasher)
+	change := func(old, new string) error {
+		_, err := me.ChangePassword(ctx, userID, &types.ChangePasswordInput{OldPassword: old, NewPassword: new})
+		return err
+	}
+
+	// 不能重复使用当前密码
+	assertPolicyViolation(t, change("password123", "password123"), "不能使用最近3次使用过的密码")
+	assertPolicyViolation(t, change("password123", "short"), "密码长度至少为8个字符")
+
+	require.NoError(t, change("password123", "second-pass"))
+	require.NoError(t, change("second-pass", "third-pass"))
+	assertPolicyViolation(t, change("third-pass", "password123"), "不能使用最近3次使用过的密码")
+
+	// 超出保留数量的密码可以再次使用，历史表只保留之前的两个密码
+	require.NoError(t, change("third-pass", "fourth-pass"))
+	assert.Equal(t, 2, client.PasswordHistory.Query().CountX(ctx))
+	require.NoError(t, change("fourth-pass", "password123"))
+}
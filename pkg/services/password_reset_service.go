@@ -0,0 +1,211 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/liukeshao/echo-template/config"
+	"github.com/liukeshao/echo-template/ent"
+	"github.com/liukeshao/echo-template/ent/onetimetoken"
+	userEnt "github.com/liukeshao/echo-template/ent/user"
+	"github.com/liukeshao/echo-template/pkg/appctx"
+	"github.com/liukeshao/echo-template/pkg/apperrs"
+	"github.com/liukeshao/echo-template/pkg/mail"
+	"github.com/liukeshao/echo-template/pkg/types"
+	"github.com/liukeshao/echo-template/pkg/utils"
+)
+
+// DefaultPasswordResetExpiry 重置密码链接默认有效期
+const DefaultPasswordResetExpiry = 30 * time.Minute
+
+// PasswordResetService 忘记密码与重置密码服务
+type PasswordResetService struct {
+	orm       *ent.Client
+	mailer    mail.Mailer
+	cache     *AuthCache
+	events    *SecurityEventService
+	passwords *PasswordPolicy
+	hasher    utils.PasswordHasher
+	hashKey   string
+	linkURL   string
+	expiry    time.Duration
+	throttle  *EmailThrottle
+
+	pending sync.WaitGroup // 正在后台发送的重置邮件
+}
+
+// NewPasswordResetService 创建重置密码服务，未配置重置页面地址时使用 host + /reset-password
+func NewPasswordResetService(orm *ent.Client, mailer mail.Mailer, cache *AuthCache, passwords *PasswordPolicy, hasher utils.PasswordHasher, hashKey string, host string, cfg config.AccountConfig) *PasswordResetService {
+	expiry := cfg.PasswordResetExpiry
+	if expiry <= 0 {
+		expiry = DefaultPasswordResetExpiry
+	}
+	linkURL := cfg.PasswordResetURL
+	if linkURL == "" {
+		linkURL = strings.TrimRight(host, "/") + "/reset-password"
+	}
+
+	return &PasswordResetService{
+		orm:       orm,
+		mailer:    mailer,
+		cache:     cache,
+		passwords: passwords,
+		hasher:    hasher,
+		hashKey:   hashKey,
+		linkURL:   linkURL,
+		expiry:    expiry,
+		throttle:  NewEmailThrottle(cfg),
+	}
+}
+
+// SetSecurityEvents 设置安全事件日志
+func (s *PasswordResetService) SetSecurityEvents(events *SecurityEventService) {
+	s.events = events
+}
+
+// ForgotPassword 发送重置密码邮件。无论邮箱是否注册都返回成功，不暴露账户是否存在；
+// 签发令牌和发送邮件在后台完成，两种情况下请求的耗时相同
+func (s *PasswordResetService) ForgotPassword(ctx context.Context, input *types.ForgotPasswordInput) error {
+	// 限制申请频率，防止反复申请作废用户刚收到的链接
+	if err := s.throttle.Allow(input.Email, appctx.MustGetClientIPFromContext(ctx)); err != nil {
+		slog.WarnContext(ctx, "忘记密码：申请过于频繁", "email", input.Email)
+		return err
+	}
+
+	user, err := s.orm.User.Query().
+		Where(userEnt.Email(input.Email)).
+		Only(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		slog.InfoContext(ctx, "忘记密码：邮箱未注册", "email", input.Email)
+		return nil
+	case err != nil:
+		slog.ErrorContext(ctx, "查询用户失败", "error", err, "email", input.Email)
+		return apperrs.ErrDatabase.With("email", input.Email).With("原始错误", err).Errorf("查询用户失败")
+	}
+
+	// 被停用的账户不能通过重置密码恢复
+	if user.Status == userEnt.StatusSuspended {
+		slog.InfoContext(ctx, "忘记密码：账户已被停用", "user_id", user.ID)
+		return nil
+	}
+
+	// 请求结束后仍需完成发送，后台任务不随请求取消
+	s.pending.Add(1)
+	go func() {
+		defer s.pending.Done()
+		s.sendResetMail(context.WithoutCancel(ctx), user)
+	}()
+	return nil
+}
+
+// Wait 等待后台发送中的重置邮件完成
+func (s *PasswordResetService) Wait(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		s.pending.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// sendResetMail 签发重置令牌并发送重置邮件，失败时只记录日志
+func (s *PasswordResetService) sendResetMail(ctx context.Context, user *ent.User) {
+	raw, err := issueOneTimeToken(ctx, s.orm.OneTimeToken, s.hashKey, user.ID, onetimetoken.PurposeResetPassword, s.expiry)
+	if err != nil {
+		return
+	}
+
+	link := s.linkURL + "?token=" + url.QueryEscape(raw)
+	msg := &mail.Message{
+		To:      user.Email,
+		Subject: "重置您的密码",
+		Body: fmt.Sprintf("%s，您好：\n\n我们收到了重置您账户密码的请求，请点击以下链接设置新密码，链接%s内有效且只能使用一次：\n\n%s\n\n如果这不是您本人的操作，请忽略此邮件，您的密码不会被修改。",
+			user.Username, formatExpiry(s.expiry), link),
+	}
+	if err := s.mailer.Send(ctx, msg); err != nil {
+		slog.ErrorContext(ctx, "发送重置密码邮件失败", "error", err, "user_id", user.ID)
+		return
+	}
+
+	slog.InfoContext(ctx, "已发送重置密码邮件", "user_id", user.ID)
+}
+
+// ResetPassword 使用重置令牌设置新密码，并撤销用户的所有会话
+func (s *PasswordResetService) ResetPassword(ctx context.Context, input *types.ResetPasswordInput) error {
+	tx, err := s.orm.Tx(ctx)
+	if err != nil {
+		return apperrs.ErrDatabase.With("原始错误", err).Errorf("开启事务失败")
+	}
+	defer tx.Rollback()
+
+	ott, err := consumeOneTimeToken(ctx, tx.OneTimeToken, s.hashKey, input.Token, onetimetoken.PurposeResetPassword)
+	if err != nil {
+		return err
+	}
+
+	u, err := tx.User.Get(ctx, ott.UserID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return apperrs.ErrBadRequest.Public("链接无效或已过期").With("user_id", ott.UserID).Errorf("用户不存在")
+		}
+		slog.ErrorContext(ctx, "查询用户失败", "error", err, "user_id", ott.UserID)
+		return apperrs.ErrDatabase.With("user_id", ott.UserID).With("原始错误", err).Errorf("查询用户失败")
+	}
+	if u.Status == userEnt.StatusSuspended {
+		return apperrs.ErrForbidden.With("user_id", u.ID).Errorf("账户已被停用")
+	}
+
+	// 新密码不满足策略时回滚事务，重置令牌仍可再次使用
+	if err := s.passwords.Check("NewPassword", input.NewPassword, u.Username, u.Email); err != nil {
+		return err
+	}
+	if err := s.passwords.CheckReuse(ctx, tx.PasswordHistory, "NewPassword", u, input.NewPassword); err != nil {
+		return err
+	}
+
+	passwordHash, err := s.hasher.Hash(input.NewPassword)
+	if err != nil {
+		slog.ErrorContext(ctx, "密码加密失败", "error", err)
+		return apperrs.ErrInternal.With("原始错误", err).Errorf("密码加密失败")
+	}
+	if err := s.passwords.Remember(ctx, tx.PasswordHistory, u); err != nil {
+		return err
+	}
+
+	// 能收到重置邮件说明邮箱有效，待验证的账户同时完成激活
+	update := tx.User.UpdateOne(u).SetPasswordHash(passwordHash)
+	if u.Status == userEnt.StatusInactive {
+		update.SetStatus(userEnt.StatusActive)
+	}
+	if err := update.Exec(ctx); err != nil {
+		slog.ErrorContext(ctx, "更新密码失败", "error", err, "user_id", u.ID)
+		return apperrs.ErrDatabase.With("user_id", u.ID).With("原始错误", err).Errorf("更新密码失败")
+	}
+
+	// 撤销用户所有的会话
+	revoked, err := revokeUserTokens(ctx, tx.Token, u.ID)
+	if err != nil {
+		return err
+	}
+
+	s.cache.InvalidateUserOnCommit(tx, u.ID)
+	if err := tx.Commit(); err != nil {
+		return apperrs.ErrDatabase.With("user_id", u.ID).With("原始错误", err).Errorf("提交事务失败")
+	}
+
+	s.events.Record(ctx, u.ID, types.SecurityEventPasswordChanged, "通过邮件重置密码")
+	slog.InfoContext(ctx, "密码已重置", "user_id", u.ID, "revoked_tokens", revoked)
+	return nil
+}
@@ -0,0 +1,67 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/liukeshao/echo-template/config"
+	"github.com/liukeshao/echo-template/pkg/apperrs"
+	"github.com/liukeshao/echo-template/pkg/mail"
+	"github.com/liukeshao/echo-template/pkg/types"
+)
+
+func TestPasswordReset(t *testing.T) {
+	auth, client := newTestAuthService(t)
+	ctx := context.Background()
+	session := registerTestUser(t, auth)
+
+	mailer := mail.NewMemoryMailer()
+	policy := NewPasswordPolicy(config.PasswordPolicyConfig{HistorySize: 3}, nil, auth.hasher)
+	resets := NewPasswordResetService(client, mailer, auth.cache, policy, auth.hasher, "test-hash-key", "http://localhost", config.AccountConfig{})
+
+	// 未注册的邮箱同样返回成功且不发送邮件
+	require.NoError(t, resets.ForgotPassword(ctx, &types.ForgotPasswordInput{Email: "nobody@example.com"}))
+	require.NoError(t, resets.Wait(ctx))
+	assert.Empty(t, mailer.Messages())
+
+	// 邮件在后台发送
+	require.NoError(t, resets.ForgotPassword(ctx, &types.ForgotPasswordInput{Email: "tester@example.com"}))
+	require.NoError(t, resets.Wait(ctx))
+
+	// 冷却时间内再次申请被拒绝，已发送的链接仍然有效
+	err := resets.ForgotPassword(ctx, &types.ForgotPasswordInput{Email: "tester@example.com"})
+	assertErrorCode(t, err, apperrs.CodeTooManyRequests)
+	raw := tokenFromMail(t, mailer, "tester@example.com")
+
+	// 认证结果已缓存时重置密码也应立即生效
+	_, _, err = auth.AuthenticateUser(ctx, session.AccessToken)
+	require.NoError(t, err)
+
+	// 新密码不满足策略时不消耗重置令牌
+	err = resets.ResetPassword(ctx, &types.ResetPasswordInput{Token: raw, NewPassword: "password123"})
+	assertPolicyViolation(t, err, "不能使用最近3次使用过的密码")
+
+	input := &types.ResetPasswordInput{Token: raw, NewPassword: "new-password123"}
+	require.NoError(t, resets.ResetPassword(ctx, input))
+	assert.Error(t, resets.ResetPassword(ctx, input), "重置链接只能使用一次")
+
+	// 所有已有会话被撤销
+	_, _, err = auth.AuthenticateUser(ctx, session.AccessToken)
+	assert.Error(t, err)
+	_, err = auth.RefreshToken(ctx, &types.RefreshTokenInput{RefreshToken: session.RefreshToken})
+	assert.Error(t, err)
+
+	_, err = auth.Login(ctx, &types.LoginInput{Email: "tester@example.com", Password: "new-password123"})
+	assert.NoError(t, err)
+	_, err = auth.Login(ctx, &types.LoginInput{Email: "tester@example.com", Password: "password123"})
+	assert.Error(t, err, "旧密码应失效")
+
+	// 管理员重置密码同样不能使用最近使用过的密码
+	admin := NewAdminService(client, auth, policy, auth.hasher)
+	userID := client.User.Query().OnlyIDX(ctx)
+	err = admin.ResetPassword(ctx, "admin", &types.AdminResetPasswordInput{UserID: userID, NewPassword: "password123"})
+	assertPolicyViolation(t, err, "不能使用最近3次使用过的密码")
+}
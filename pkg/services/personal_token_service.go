@@ -0,0 +1,157 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/liukeshao/echo-template/ent"
+	"github.com/liukeshao/echo-template/ent/personalaccesstoken"
+	"github.com/liukeshao/echo-template/pkg/apperrs"
+	"github.com/liukeshao/echo-template/pkg/types"
+	"github.com/liukeshao/echo-template/pkg/utils"
+)
+
+// 个人访问令牌常量
+const (
+	PersonalAccessTokenPrefix  = "pat_"      // 个人访问令牌前缀，用于与JWT区分
+	personalTokenDisplayLength = 12          // 保存用于识别令牌的开头字符数
+	personalTokenUsageInterval = time.Minute // 最后使用时间的最小更新间隔，避免每个请求都写数据库
+)
+
+// IsPersonalAccessToken 判断令牌是否为个人访问令牌
+func IsPersonalAccessToken(tokenString string) bool {
+	return strings.HasPrefix(tokenString, PersonalAccessTokenPrefix)
+}
+
+// PersonalTokenService 个人访问令牌服务
+type PersonalTokenService struct {
+	orm     *ent.Client
+	hashKey string
+}
+
+// NewPersonalTokenService 创建个人访问令牌服务
+func NewPersonalTokenService(orm *ent.Client, hashKey string) *PersonalTokenService {
+	return &PersonalTokenService{
+		orm:     orm,
+		hashKey: hashKey,
+	}
+}
+
+// Create 为用户创建个人访问令牌，原始令牌只在创建时返回一次
+func (s *PersonalTokenService) Create(ctx context.Context, userID string, input *types.CreatePersonalAccessTokenInput) (*types.CreatePersonalAccessTokenOutput, error) {
+	raw := PersonalAccessTokenPrefix + utils.GenerateRandomToken()
+
+	create := s.orm.PersonalAccessToken.Create().
+		SetID(utils.GenerateULID()).
+		SetUserID(userID).
+		SetName(input.Name).
+		SetTokenHash(utils.HashToken(s.hashKey, raw)).
+		SetTokenPrefix(raw[:personalTokenDisplayLength]).
+		SetScopes(slices.Compact(slices.Sorted(slices.Values(input.Scopes))))
+	if input.ExpiresInDays > 0 {
+		create.SetExpiresAt(time.Now().AddDate(0, 0, input.ExpiresInDays))
+	}
+
+	pat, err := create.Save(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "创建个人访问令牌失败", "error", err, "user_id", userID)
+		return nil, apperrs.ErrDatabase.With("user_id", userID).With("原始错误", err).Errorf("创建个人访问令牌失败")
+	}
+
+	slog.InfoContext(ctx, "已创建个人访问令牌", "user_id", userID, "token_id", pat.ID, "scopes", pat.Scopes)
+	return &types.CreatePersonalAccessTokenOutput{
+		Token: raw,
+		Info:  personalTokenInfo(pat),
+	}, nil
+}
+
+// List 列出用户未撤销的个人访问令牌
+func (s *PersonalTokenService) List(ctx context.Context, userID string) (*types.ListPersonalAccessTokensOutput, error) {
+	pats, err := s.orm.PersonalAccessToken.Query().
+		Where(
+			personalaccesstoken.UserID(userID),
+			personalaccesstoken.RevokedAtIsNil(),
+		).
+		Order(ent.Desc(personalaccesstoken.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "查询个人访问令牌失败", "error", err, "user_id", userID)
+		return nil, apperrs.ErrDatabase.With("user_id", userID).With("原始错误", err).Errorf("查询个人访问令牌失败")
+	}
+
+	out := &types.ListPersonalAccessTokensOutput{Tokens: make([]*types.PersonalAccessTokenInfo, 0, len(pats))}
+	for _, pat := range pats {
+		out.Tokens = append(out.Tokens, personalTokenInfo(pat))
+	}
+	return out, nil
+}
+
+// Revoke 撤销用户的个人访问令牌
+func (s *PersonalTokenService) Revoke(ctx context.Context, userID string, tokenID string) error {
+	revoked, err := s.orm.PersonalAccessToken.Update().
+		Where(
+			personalaccesstoken.ID(tokenID),
+			personalaccesstoken.UserID(userID),
+			personalaccesstoken.RevokedAtIsNil(),
+		).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "撤销个人访问令牌失败", "error", err, "user_id", userID, "token_id", tokenID)
+		return apperrs.ErrDatabase.With("user_id", userID).With("token_id", tokenID).With("原始错误", err).Errorf("撤销个人访问令牌失败")
+	}
+	if revoked == 0 {
+		return apperrs.ErrNotFound.With("user_id", userID).With("token_id", tokenID).Public("个人访问令牌不存在").Errorf("个人访问令牌不存在")
+	}
+
+	slog.InfoContext(ctx, "已撤销个人访问令牌", "user_id", userID, "token_id", tokenID)
+	return nil
+}
+
+// Authenticate 验证个人访问令牌并记录使用时间，返回的令牌已加载所属用户
+func (s *PersonalTokenService) Authenticate(ctx context.Context, raw string) (*ent.PersonalAccessToken, error) {
+	pat, err := s.orm.PersonalAccessToken.Query().
+		Where(personalaccesstoken.TokenHash(utils.HashToken(s.hashKey, raw))).
+		WithUser().
+		Only(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		return nil, apperrs.ErrUnauthorized.Errorf("个人访问令牌不存在或已撤销")
+	case err != nil:
+		slog.ErrorContext(ctx, "查询个人访问令牌失败", "error", err)
+		return nil, apperrs.ErrDatabase.With("原始错误", err).Errorf("查询个人访问令牌失败")
+	}
+
+	if pat.RevokedAt != nil {
+		return nil, apperrs.ErrUnauthorized.With("token_id", pat.ID).Errorf("个人访问令牌不存在或已撤销")
+	}
+	now := time.Now()
+	if pat.ExpiresAt != nil && now.After(*pat.ExpiresAt) {
+		return nil, apperrs.ErrUnauthorized.With("token_id", pat.ID).Errorf("个人访问令牌已过期")
+	}
+
+	// 非致命错误，记录失败不影响本次认证
+	if pat.LastUsedAt == nil || now.Sub(*pat.LastUsedAt) >= personalTokenUsageInterval {
+		if err := s.orm.PersonalAccessToken.UpdateOneID(pat.ID).SetLastUsedAt(now).Exec(ctx); err != nil {
+			slog.WarnContext(ctx, "更新个人访问令牌使用时间失败", "error", err, "token_id", pat.ID)
+		}
+	}
+
+	return pat, nil
+}
+
+// personalTokenInfo 转换为对外展示的个人访问令牌信息
+func personalTokenInfo(pat *ent.PersonalAccessToken) *types.PersonalAccessTokenInfo {
+	return &types.PersonalAccessTokenInfo{
+		ID:         pat.ID,
+		Name:       pat.Name,
+		Prefix:     pat.TokenPrefix,
+		Scopes:     pat.Scopes,
+		ExpiresAt:  pat.ExpiresAt,
+		LastUsedAt: pat.LastUsedAt,
+		CreatedAt:  pat.CreatedAt,
+	}
+}
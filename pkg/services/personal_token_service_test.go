@@ -0,0 +1,65 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/liukeshao/echo-template/pkg/apperrs"
+	"github.com/liukeshao/echo-template/pkg/types"
+)
+
+func TestPersonalAccessToken(t *testing.T) {
+	auth, client := newTestAuthService(t)
+	ctx := context.Background()
+	registerTestUser(t, auth)
+	userID := client.User.Query().OnlyIDX(ctx)
+
+	tokens := NewPersonalTokenService(client, "test-hash-key")
+	auth.SetPersonalTokenService(tokens)
+
+	created, err := tokens.Create(ctx, userID, &types.CreatePersonalAccessTokenInput{
+		Name:   "ci",
+		Scopes: []string{types.ScopeProfileRead},
+	})
+	require.NoError(t, err)
+	assert.True(t, IsPersonalAccessToken(created.Token))
+	assert.Equal(t, created.Token[:personalTokenDisplayLength], created.Info.Prefix)
+
+	// 具有所需授权范围时认证成功，并记录使用时间
+	user, dbToken, granted, err := auth.Authenticate(ctx, created.Token, []string{types.ScopeProfileRead})
+	require.NoError(t, err)
+	assert.Equal(t, userID, user.ID)
+	assert.Nil(t, dbToken)
+	assert.Equal(t, []string{types.ScopeProfileRead}, granted)
+	listed, err := tokens.List(ctx, userID)
+	require.NoError(t, err)
+	require.Len(t, listed.Tokens, 1)
+	assert.NotNil(t, listed.Tokens[0].LastUsedAt)
+
+	// 缺少授权范围或接口未声明授权范围时拒绝访问
+	_, _, _, err = auth.Authenticate(ctx, created.Token, []string{types.ScopeProfileWrite})
+	assertErrorCode(t, err, apperrs.CodeForbidden)
+	_, _, err = auth.AuthenticateUser(ctx, created.Token)
+	assertErrorCode(t, err, apperrs.CodeForbidden)
+
+	// 撤销后无法继续使用
+	require.NoError(t, tokens.Revoke(ctx, userID, created.Info.ID))
+	_, _, _, err = auth.Authenticate(ctx, created.Token, []string{types.ScopeProfileRead})
+	assertErrorCode(t, err, apperrs.CodeUnauthorized)
+	assertErrorCode(t, tokens.Revoke(ctx, userID, created.Info.ID), apperrs.CodeNotFound)
+
+	// 过期后无法继续使用
+	expiring, err := tokens.Create(ctx, userID, &types.CreatePersonalAccessTokenInput{
+		Name:          "expiring",
+		Scopes:        []string{types.ScopeProfileRead},
+		ExpiresInDays: 1,
+	})
+	require.NoError(t, err)
+	client.PersonalAccessToken.UpdateOneID(expiring.Info.ID).SetExpiresAt(time.Now().Add(-time.Minute)).ExecX(ctx)
+	_, _, _, err = auth.Authenticate(ctx, expiring.Token, []string{types.ScopeProfileRead})
+	assertErrorCode(t, err, apperrs.CodeUnauthorized)
+}
@@ -0,0 +1,128 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"time"
+
+	"github.com/liukeshao/echo-template/ent"
+	"github.com/liukeshao/echo-template/ent/token"
+	"github.com/liukeshao/echo-template/pkg/appctx"
+	"github.com/liukeshao/echo-template/pkg/apperrs"
+	"github.com/liukeshao/echo-template/pkg/types"
+)
+
+// DefaultRecentAuthWindow 敏感操作默认要求的最近认证时间窗口
+const DefaultRecentAuthWindow = 10 * time.Minute
+
+// recentAuthWindow 返回敏感操作要求的最近认证时间窗口
+func (s *AuthService) recentAuthWindow() time.Duration {
+	if s.jwtConfig.RecentAuthWindow > 0 {
+		return s.jwtConfig.RecentAuthWindow
+	}
+	return DefaultRecentAuthWindow
+}
+
+// CheckRecentAuth 校验当前会话是否在时间窗口内完成过认证 - 用于中间件。
+// 没有认证时间的令牌（旧版本签发、OAuth授权和代为登录）一律需要重新验证身份
+func (s *AuthService) CheckRecentAuth(ctx context.Context, current *ent.Token) error {
+	if current != nil && current.AuthTime != nil && time.Since(*current.AuthTime) <= s.recentAuthWindow() {
+		return nil
+	}
+
+	var tokenID string
+	if current != nil {
+		tokenID = current.ID
+	}
+	slog.InfoContext(ctx, "敏感操作需要重新验证身份", "token_id", tokenID)
+	return apperrs.ErrReauthRequired.With("token_id", tokenID).Errorf("会话认证时间超出窗口，需要重新验证身份")
+}
+
+// Reauthenticate 使用当前密码或两步验证码重新验证身份，并更新当前会话的认证时间
+func (s *AuthService) Reauthenticate(ctx context.Context, user *ent.User, current *ent.Token, input *types.ReauthenticateInput) (*types.ReauthenticateOutput, error) {
+	if current == nil || current.FamilyID == "" || current.ClientID != "" {
+		return nil, apperrs.ErrForbidden.With("user_id", user.ID).Public("当前会话不支持重新验证身份").Errorf("只有第一方登录会话可以重新验证身份")
+	}
+
+	// 重新验证与登录共用失败计数，防止借助已登录的会话暴力破解密码
+	ip := appctx.MustGetClientIPFromContext(ctx)
+	if wait := s.loginGuard.Check(user.Email, ip); wait > 0 {
+		retryAfter := int(math.Ceil(wait.Seconds()))
+		slog.WarnContext(ctx, "重新验证身份尝试过于频繁", "user_id", user.ID, "ip", ip, "retry_after", retryAfter)
+		return nil, apperrs.ErrTooManyRequests.
+			With("user_id", user.ID).
+			With("ip", ip).
+			With("retry_after", retryAfter).
+			Public(fmt.Sprintf("尝试过于频繁，请%d秒后重试", retryAfter)).
+			Errorf("重新验证身份尝试过于频繁")
+	}
+
+	tx, err := s.orm.Tx(ctx)
+	if err != nil {
+		return nil, apperrs.ErrDatabase.With("user_id", user.ID).With("原始错误", err).Errorf("开启事务失败")
+	}
+	defer tx.Rollback()
+
+	ok, err := s.verifyReauthentication(ctx, tx, user, input)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		tx.Rollback()
+		s.loginGuard.RecordFailure(user.Email, ip)
+		slog.WarnContext(ctx, "重新验证身份失败", "user_id", user.ID, "ip", ip)
+		s.events.Record(ctx, user.ID, types.SecurityEventLoginFailed, "重新验证身份失败")
+		return nil, apperrs.ErrUnauthorized.With("user_id", user.ID).Public("密码或验证码错误").Errorf("重新验证身份失败")
+	}
+
+	// 更新整个会话的认证时间，之后刷新得到的令牌同样有效
+	now := time.Now()
+	err = tx.Token.Update().
+		Where(
+			token.FamilyID(current.FamilyID),
+			token.IsRevoked(false),
+		).
+		SetAuthTime(now).
+		Exec(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "更新会话认证时间失败", "error", err, "user_id", user.ID)
+		return nil, apperrs.ErrDatabase.With("user_id", user.ID).With("原始错误", err).Errorf("更新会话认证时间失败")
+	}
+
+	// 已缓存的令牌快照中的认证时间已过期
+	s.cache.InvalidateUserOnCommit(tx, user.ID)
+	if err := tx.Commit(); err != nil {
+		return nil, apperrs.ErrDatabase.With("user_id", user.ID).With("原始错误", err).Errorf("提交事务失败")
+	}
+	s.loginGuard.RecordSuccess(user.Email)
+
+	slog.InfoContext(ctx, "已重新验证身份", "user_id", user.ID, "session_id", current.FamilyID)
+	return &types.ReauthenticateOutput{
+		AuthTime:   now.Unix(),
+		ValidUntil: now.Add(s.recentAuthWindow()).Unix(),
+	}, nil
+}
+
+// verifyReauthentication 校验密码或两步验证码，两者都提供时都必须正确
+func (s *AuthService) verifyReauthentication(ctx context.Context, tx *ent.Tx, user *ent.User, input *types.ReauthenticateInput) (bool, error) {
+	if input.Password != "" {
+		ok, _, err := s.hasher.Verify(user.PasswordHash, input.Password)
+		if err != nil {
+			slog.ErrorContext(ctx, "校验密码哈希失败", "error", err, "user_id", user.ID)
+		}
+		if !ok {
+			return false, nil
+		}
+	}
+
+	if input.Code != "" {
+		if s.twoFactor == nil {
+			return false, nil
+		}
+		return s.twoFactor.VerifyCode(ctx, tx, user, input.Code)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,59 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/liukeshao/echo-template/ent/token"
+	"github.com/liukeshao/echo-template/pkg/apperrs"
+	"github.com/liukeshao/echo-template/pkg/types"
+)
+
+func TestRecentAuth(t *testing.T) {
+	auth, client := newTestAuthService(t)
+	ctx := context.Background()
+	out := registerTestUser(t, auth)
+
+	// 刚登录的会话可以直接执行敏感操作
+	user, current, err := auth.AuthenticateUser(ctx, out.AccessToken)
+	require.NoError(t, err)
+	require.NotNil(t, current.AuthTime)
+	assert.NoError(t, auth.CheckRecentAuth(ctx, current))
+
+	// 刷新令牌保留原会话的认证时间，不能通过刷新绕过重新验证
+	stale := time.Now().Add(-time.Hour)
+	client.Token.Update().Where(token.FamilyID(current.FamilyID)).SetAuthTime(stale).ExecX(ctx)
+	refreshed, err := auth.RefreshToken(ctx, &types.RefreshTokenInput{RefreshToken: out.RefreshToken})
+	require.NoError(t, err)
+	_, current, err = auth.AuthenticateUser(ctx, refreshed.AccessToken)
+	require.NoError(t, err)
+	assertErrorCode(t, auth.CheckRecentAuth(ctx, current), apperrs.CodeReauthRequired)
+
+	// 控制失败退避的时钟，密码错误后的退避不影响后续步骤
+	now := time.Now()
+	auth.loginGuard.now = func() time.Time { return now }
+
+	// 密码错误时不更新认证时间
+	_, err = auth.Reauthenticate(ctx, user, current, &types.ReauthenticateInput{Password: "wrong-password"})
+	assertErrorCode(t, err, apperrs.CodeUnauthorized)
+	// 未开启两步验证时不能使用验证码
+	now = now.Add(time.Minute)
+	_, err = auth.Reauthenticate(ctx, user, current, &types.ReauthenticateInput{Code: "123456"})
+	assertErrorCode(t, err, apperrs.CodeUnauthorized)
+
+	now = now.Add(time.Minute)
+	reauthed, err := auth.Reauthenticate(ctx, user, current, &types.ReauthenticateInput{Password: "password123"})
+	require.NoError(t, err)
+	assert.Greater(t, reauthed.ValidUntil, time.Now().Unix())
+
+	_, current, err = auth.AuthenticateUser(ctx, refreshed.AccessToken)
+	require.NoError(t, err)
+	assert.NoError(t, auth.CheckRecentAuth(ctx, current))
+
+	// 没有认证时间的令牌需要重新验证身份
+	assertErrorCode(t, auth.CheckRecentAuth(ctx, nil), apperrs.CodeReauthRequired)
+}
@@ -0,0 +1,176 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/liukeshao/echo-template/config"
+	"github.com/liukeshao/echo-template/ent"
+	"github.com/liukeshao/echo-template/ent/securityevent"
+	"github.com/liukeshao/echo-template/pkg/appctx"
+	"github.com/liukeshao/echo-template/pkg/apperrs"
+	"github.com/liukeshao/echo-template/pkg/types"
+	"github.com/liukeshao/echo-template/pkg/utils"
+)
+
+// DefaultSecurityEventRetention 安全事件默认保留时间
+const DefaultSecurityEventRetention = 90 * 24 * time.Hour
+
+// securityEventQueueSize 等待写入的安全事件数量上限，超过时丢弃新事件
+const securityEventQueueSize = 1024
+
+// SecurityEventService 用户安全事件日志，记录登录、修改密码、撤销会话等操作发生的时间和来源。
+// 事件由后台写入器异步保存，记录事件不会增加请求的耗时，避免通过响应时间推断账户是否存在
+type SecurityEventService struct {
+	orm       *ent.Client
+	retention time.Duration
+
+	queue chan *ent.SecurityEventCreate
+
+	stop chan struct{}
+	done chan struct{}
+	once sync.Once
+}
+
+// NewSecurityEventService 创建安全事件日志服务
+func NewSecurityEventService(orm *ent.Client, cfg config.SecurityEventConfig) *SecurityEventService {
+	retention := cfg.EventRetention
+	if retention <= 0 {
+		retention = DefaultSecurityEventRetention
+	}
+
+	return &SecurityEventService{
+		orm:       orm,
+		retention: retention,
+		queue:     make(chan *ent.SecurityEventCreate, securityEventQueueSize),
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+}
+
+// Start 启动后台写入
+func (s *SecurityEventService) Start() {
+	go func() {
+		defer close(s.done)
+
+		for {
+			select {
+			case create := <-s.queue:
+				s.write(context.Background(), create)
+			case <-s.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop 停止后台写入并保存剩余的事件
+func (s *SecurityEventService) Stop(ctx context.Context) error {
+	s.once.Do(func() { close(s.stop) })
+
+	select {
+	case <-s.done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	s.Flush(ctx)
+	return nil
+}
+
+// Flush 保存所有等待写入的事件，返回保存的数量
+func (s *SecurityEventService) Flush(ctx context.Context) int {
+	n := 0
+	for {
+		select {
+		case create := <-s.queue:
+			s.write(ctx, create)
+			n++
+		default:
+			return n
+		}
+	}
+}
+
+// write 保存一条事件，失败只写日志
+func (s *SecurityEventService) write(ctx context.Context, create *ent.SecurityEventCreate) {
+	if err := create.Exec(ctx); err != nil {
+		slog.ErrorContext(ctx, "记录安全事件失败", "error", err)
+	}
+}
+
+// Retention 安全事件保留时间
+func (s *SecurityEventService) Retention() time.Duration {
+	return s.retention
+}
+
+// Record 记录安全事件，客户端IP、User-Agent和请求ID从 context 中获取。
+// 事件进入队列后立即返回，不影响触发事件的操作；队列已满时丢弃并写日志，未配置安全事件日志时不记录
+func (s *SecurityEventService) Record(ctx context.Context, userID string, eventType string, detail string) {
+	if s == nil || userID == "" {
+		return
+	}
+
+	// 发生时间在入队时确定，保证写入延迟不影响事件顺序
+	create := s.orm.SecurityEvent.Create().
+		SetID(utils.GenerateULID()).
+		SetUserID(userID).
+		SetType(securityevent.Type(eventType)).
+		SetDetail(utils.Truncate(detail, 255)).
+		SetIP(utils.Truncate(appctx.MustGetClientIPFromContext(ctx), 64)).
+		SetUserAgent(utils.Truncate(appctx.MustGetUserAgentFromContext(ctx), 512)).
+		SetRequestID(utils.Truncate(appctx.MustGetRequestIDFromContext(ctx), 64)).
+		SetCreatedAt(time.Now())
+
+	select {
+	case s.queue <- create:
+	default:
+		slog.WarnContext(ctx, "安全事件队列已满，丢弃事件", "user_id", userID, "type", eventType)
+	}
+}
+
+// List 分页查询用户的安全事件，按发生时间倒序
+func (s *SecurityEventService) List(ctx context.Context, userID string, input *types.ListSecurityEventsInput) (*types.ListSecurityEventsOutput, error) {
+	types.NormalizePage(&input.PageInput)
+
+	query := s.orm.SecurityEvent.Query().
+		Where(securityevent.UserID(userID))
+	if input.Type != "" {
+		query = query.Where(securityevent.TypeEQ(securityevent.Type(input.Type)))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "统计安全事件失败", "error", err, "user_id", userID)
+		return nil, apperrs.ErrDatabase.With("user_id", userID).With("原始错误", err).Errorf("查询安全事件失败")
+	}
+
+	events, err := query.
+		Order(ent.Desc(securityevent.FieldCreatedAt), ent.Desc(securityevent.FieldID)).
+		Offset(input.Offset()).
+		Limit(input.Limit()).
+		All(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "查询安全事件失败", "error", err, "user_id", userID)
+		return nil, apperrs.ErrDatabase.With("user_id", userID).With("原始错误", err).Errorf("查询安全事件失败")
+	}
+
+	out := &types.ListSecurityEventsOutput{
+		Events:     make([]*types.SecurityEventInfo, 0, len(events)),
+		PageOutput: types.NewPageOutput(input.PageInput, total),
+	}
+	for _, event := range events {
+		out.Events = append(out.Events, &types.SecurityEventInfo{
+			ID:        event.ID,
+			Type:      string(event.Type),
+			Detail:    event.Detail,
+			IP:        event.IP,
+			UserAgent: event.UserAgent,
+			RequestID: event.RequestID,
+			CreatedAt: event.CreatedAt,
+		})
+	}
+	return out, nil
+}
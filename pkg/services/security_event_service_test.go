@@ -0,0 +1,82 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/liukeshao/echo-template/config"
+	"github.com/liukeshao/echo-template/pkg/appctx"
+	"github.com/liukeshao/echo-template/pkg/types"
+)
+
+func TestSecurityEvents(t *testing.T) {
+	auth, client := newTestAuthService(t)
+	events := NewSecurityEventService(client, config.SecurityEventConfig{EventRetention: 24 * time.Hour})
+	auth.SetSecurityEvents(events)
+
+	ctx := appctx.WithClientIP(context.Background(), "203.0.113.7")
+	ctx = appctx.WithUserAgent(ctx, "test-agent")
+	ctx = appctx.WithRequestID(ctx, "req-1")
+
+	registerTestUser(t, auth)
+	userID := client.User.Query().OnlyIDX(ctx)
+
+	// 未注册邮箱的失败不记录，已注册账户的失败记录在该用户下
+	_, err := auth.Login(ctx, &types.LoginInput{Email: "nobody@example.com", Password: "password123"})
+	require.Error(t, err)
+	auth.loginGuard = NewLoginGuard(config.LoginProtectionConfig{})
+	_, err = auth.Login(ctx, &types.LoginInput{Email: "tester@example.com", Password: "wrong-password"})
+	require.Error(t, err)
+	auth.loginGuard = NewLoginGuard(config.LoginProtectionConfig{})
+
+	out, err := auth.Login(ctx, &types.LoginInput{Email: "tester@example.com", Password: "password123"})
+	require.NoError(t, err)
+	refreshed, err := auth.RefreshToken(ctx, &types.RefreshTokenInput{RefreshToken: out.RefreshToken})
+	require.NoError(t, err)
+	require.NoError(t, auth.Logout(ctx, refreshed.AccessToken))
+
+	// 事件在后台写入，写入前不在列表中
+	empty, err := events.List(ctx, userID, &types.ListSecurityEventsInput{})
+	require.NoError(t, err)
+	assert.Zero(t, empty.Total)
+	assert.Equal(t, 5, events.Flush(ctx))
+
+	listed, err := events.List(ctx, userID, &types.ListSecurityEventsInput{})
+	require.NoError(t, err)
+	require.Equal(t, 5, listed.Total)
+	got := make([]string, 0, len(listed.Events))
+	for _, event := range listed.Events {
+		got = append(got, event.Type)
+	}
+	// 注册时的登录、失败的登录、登录、刷新、退出，按时间倒序
+	assert.Equal(t, []string{
+		types.SecurityEventLogout,
+		types.SecurityEventTokenRefreshed,
+		types.SecurityEventLoginSucceeded,
+		types.SecurityEventLoginFailed,
+		types.SecurityEventLoginSucceeded,
+	}, got)
+	assert.Equal(t, "203.0.113.7", listed.Events[0].IP)
+	assert.Equal(t, "test-agent", listed.Events[0].UserAgent)
+	assert.Equal(t, "req-1", listed.Events[0].RequestID)
+
+	// 分页和按类型筛选
+	page, err := events.List(ctx, userID, &types.ListSecurityEventsInput{PageInput: types.PageInput{Page: 2, PageSize: 2}})
+	require.NoError(t, err)
+	assert.Len(t, page.Events, 2)
+	assert.True(t, page.HasNext)
+	failed, err := events.List(ctx, userID, &types.ListSecurityEventsInput{Type: types.SecurityEventLoginFailed})
+	require.NoError(t, err)
+	assert.Equal(t, 1, failed.Total)
+
+	// 超过保留时间的事件由清理任务删除
+	cleaner := NewTokenCleaner(client, config.TokenCleanupConfig{})
+	cleaner.SetSecurityEventRetention(events.Retention())
+	_, err = cleaner.Purge(ctx, time.Now().Add(25*time.Hour))
+	require.NoError(t, err)
+	assert.Zero(t, client.SecurityEvent.Query().CountX(ctx))
+}
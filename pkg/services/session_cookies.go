@@ -0,0 +1,129 @@
+package services
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/liukeshao/echo-template/config"
+	"github.com/liukeshao/echo-template/pkg/apperrs"
+	"github.com/liukeshao/echo-template/pkg/types"
+	"github.com/liukeshao/echo-template/pkg/utils"
+)
+
+// Cookie 会话模式常量
+const (
+	SessionModeHeader  = "X-Session-Mode" // 登录时通过该请求头选择会话模式
+	SessionModeCookie  = "cookie"         // 使用 Cookie 保存令牌
+	AccessTokenCookie  = "access_token"   // 访问令牌 Cookie，HttpOnly
+	RefreshTokenCookie = "refresh_token"  // 刷新令牌 Cookie，HttpOnly，只发送给 Cookie 刷新接口
+	CSRFTokenCookie    = "csrf_token"     // CSRF 令牌 Cookie，前端读取后通过请求头提交
+	CSRFTokenHeader    = "X-CSRF-Token"   // 提交 CSRF 令牌的请求头
+
+	// SessionCookiePath 刷新令牌 Cookie 的路径，浏览器只在请求 Cookie 刷新接口时发送。
+	// 退出登录接口不在该路径下，通过访问令牌撤销会话，并以相同路径的 Set-Cookie 删除刷新令牌 Cookie
+	SessionCookiePath = "/api/v1/auth/cookie"
+)
+
+// SessionCookies 浏览器 Cookie 会话模式，令牌保存在 HttpOnly Cookie 中，
+// 通过 Cookie 认证的写请求需要使用双重提交的 CSRF 令牌
+type SessionCookies struct {
+	enabled       bool
+	domain        string
+	secure        bool
+	sameSite      http.SameSite
+	refreshExpiry time.Duration
+}
+
+// NewSessionCookies 创建 Cookie 会话模式，refreshExpiry 为刷新令牌有效期
+func NewSessionCookies(cfg config.SessionCookieConfig, refreshExpiry time.Duration) *SessionCookies {
+	sameSite := http.SameSiteLaxMode
+	switch strings.ToLower(cfg.SameSite) {
+	case "strict":
+		sameSite = http.SameSiteStrictMode
+	case "none":
+		sameSite = http.SameSiteNoneMode
+	}
+
+	return &SessionCookies{
+		enabled:       cfg.Enabled,
+		domain:        cfg.Domain,
+		secure:        cfg.Secure,
+		sameSite:      sameSite,
+		refreshExpiry: refreshExpiry,
+	}
+}
+
+// Enabled 是否允许使用 Cookie 会话模式
+func (s *SessionCookies) Enabled() bool {
+	return s != nil && s.enabled
+}
+
+// Requested 请求是否选择了 Cookie 会话模式
+func (s *SessionCookies) Requested(r *http.Request) bool {
+	return s.Enabled() && strings.EqualFold(r.Header.Get(SessionModeHeader), SessionModeCookie)
+}
+
+// Issue 将登录结果中的令牌写入 Cookie，并签发新的 CSRF 令牌
+func (s *SessionCookies) Issue(w http.ResponseWriter, out *types.AuthOutput) {
+	accessMaxAge := int(time.Until(time.Unix(out.ExpiresAt, 0)).Seconds())
+	refreshMaxAge := int(s.refreshExpiry.Seconds())
+
+	http.SetCookie(w, s.cookie(AccessTokenCookie, out.AccessToken, "/", accessMaxAge, true))
+	http.SetCookie(w, s.cookie(RefreshTokenCookie, out.RefreshToken, SessionCookiePath, refreshMaxAge, true))
+	http.SetCookie(w, s.cookie(CSRFTokenCookie, utils.GenerateRandomToken(), "/", refreshMaxAge, false))
+}
+
+// Clear 删除会话 Cookie
+func (s *SessionCookies) Clear(w http.ResponseWriter) {
+	http.SetCookie(w, s.cookie(AccessTokenCookie, "", "/", -1, true))
+	http.SetCookie(w, s.cookie(RefreshTokenCookie, "", SessionCookiePath, -1, true))
+	http.SetCookie(w, s.cookie(CSRFTokenCookie, "", "/", -1, false))
+}
+
+// AccessToken 读取访问令牌 Cookie，未开启 Cookie 会话模式或不存在时返回空字符串
+func (s *SessionCookies) AccessToken(r *http.Request) string {
+	return s.value(r, AccessTokenCookie)
+}
+
+// RefreshToken 读取刷新令牌 Cookie，未开启 Cookie 会话模式或不存在时返回空字符串
+func (s *SessionCookies) RefreshToken(r *http.Request) string {
+	return s.value(r, RefreshTokenCookie)
+}
+
+// CheckCSRF 校验请求头中的 CSRF 令牌与 Cookie 一致，跨站页面无法读取 Cookie，因此无法伪造请求头
+func (s *SessionCookies) CheckCSRF(r *http.Request) error {
+	cookie := s.value(r, CSRFTokenCookie)
+	header := r.Header.Get(CSRFTokenHeader)
+	if cookie == "" || subtle.ConstantTimeCompare([]byte(cookie), []byte(header)) != 1 {
+		return apperrs.ErrForbidden.Public("CSRF令牌无效").Errorf("CSRF令牌缺失或不匹配")
+	}
+	return nil
+}
+
+// value 读取 Cookie 的值
+func (s *SessionCookies) value(r *http.Request, name string) string {
+	if !s.Enabled() {
+		return ""
+	}
+	cookie, err := r.Cookie(name)
+	if err != nil {
+		return ""
+	}
+	return cookie.Value
+}
+
+// cookie 创建会话 Cookie
+func (s *SessionCookies) cookie(name string, value string, path string, maxAge int, httpOnly bool) *http.Cookie {
+	return &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     path,
+		Domain:   s.domain,
+		MaxAge:   maxAge,
+		Secure:   s.secure,
+		HttpOnly: httpOnly,
+		SameSite: s.sameSite,
+	}
+}
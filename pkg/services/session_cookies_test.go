@@ -0,0 +1,60 @@
+package services
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/liukeshao/echo-template/config"
+	"github.com/liukeshao/echo-template/pkg/apperrs"
+	"github.com/liukeshao/echo-template/pkg/types"
+)
+
+func TestSessionCookies(t *testing.T) {
+	cookies := NewSessionCookies(config.SessionCookieConfig{Enabled: true, Secure: true, SameSite: "strict"}, time.Hour)
+
+	rec := httptest.NewRecorder()
+	cookies.Issue(rec, &types.AuthOutput{
+		AccessToken:  "access",
+		RefreshToken: "refresh",
+		ExpiresAt:    time.Now().Add(10 * time.Minute).Unix(),
+	})
+
+	issued := map[string]*http.Cookie{}
+	for _, cookie := range rec.Result().Cookies() {
+		issued[cookie.Name] = cookie
+		assert.True(t, cookie.Secure)
+		assert.Equal(t, http.SameSiteStrictMode, cookie.SameSite)
+	}
+	require.Len(t, issued, 3)
+	assert.True(t, issued[AccessTokenCookie].HttpOnly)
+	assert.True(t, issued[RefreshTokenCookie].HttpOnly)
+	assert.Equal(t, SessionCookiePath, issued[RefreshTokenCookie].Path)
+	// 前端需要读取 CSRF 令牌
+	assert.False(t, issued[CSRFTokenCookie].HttpOnly)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/me/password", nil)
+	for _, cookie := range issued {
+		req.AddCookie(cookie)
+	}
+	assert.Equal(t, "access", cookies.AccessToken(req))
+	assert.Equal(t, "refresh", cookies.RefreshToken(req))
+
+	// 缺少或不匹配的 CSRF 请求头被拒绝
+	assertErrorCode(t, cookies.CheckCSRF(req), apperrs.CodeForbidden)
+	req.Header.Set(CSRFTokenHeader, "forged")
+	assertErrorCode(t, cookies.CheckCSRF(req), apperrs.CodeForbidden)
+	req.Header.Set(CSRFTokenHeader, issued[CSRFTokenCookie].Value)
+	assert.NoError(t, cookies.CheckCSRF(req))
+
+	// 未开启时忽略 Cookie
+	disabled := NewSessionCookies(config.SessionCookieConfig{}, time.Hour)
+	req.Header.Set(SessionModeHeader, SessionModeCookie)
+	assert.False(t, disabled.Requested(req))
+	assert.Empty(t, disabled.AccessToken(req))
+	assert.True(t, cookies.Requested(req))
+}
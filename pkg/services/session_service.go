@@ -0,0 +1,195 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/samber/oops"
+
+	"github.com/liukeshao/echo-template/ent"
+	"github.com/liukeshao/echo-template/ent/token"
+	"github.com/liukeshao/echo-template/pkg/apperrs"
+	"github.com/liukeshao/echo-template/pkg/types"
+	"github.com/liukeshao/echo-template/pkg/utils"
+)
+
+// SessionService 会话服务
+// 一次登录签发的access/refresh令牌及其后续轮换属于同一个令牌族，令牌族即一个会话
+type SessionService struct {
+	orm    *ent.Client
+	cache  *AuthCache
+	events *SecurityEventService
+}
+
+// NewSessionService 创建会话服务实例
+func NewSessionService(orm *ent.Client, cache *AuthCache) *SessionService {
+	return &SessionService{
+		orm:   orm,
+		cache: cache,
+	}
+}
+
+// SetSecurityEvents 设置安全事件日志
+func (s *SessionService) SetSecurityEvents(events *SecurityEventService) {
+	s.events = events
+}
+
+// activeSessions 汇总用户当前有效的会话（不含OAuth授权签发的令牌），按最后活跃时间倒序排列
+func activeSessions(ctx context.Context, tc *ent.TokenClient, userID string) ([]*types.SessionInfo, error) {
+	tokens, err := tc.Query().
+		Where(
+			token.UserID(userID),
+			token.IsRevoked(false),
+			token.ExpiresAtGT(time.Now()),
+			token.FamilyIDNEQ(""),
+			token.ClientIDIsNil(),
+		).
+		Order(ent.Asc(token.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make(map[string]*types.SessionInfo)
+	hasRefresh := make(map[string]bool)
+	for _, t := range tokens {
+		session, ok := sessions[t.FamilyID]
+		if !ok {
+			// 族ID为登录时生成的ULID，其时间戳即会话创建时间
+			createdAt, ok := utils.ULIDTime(t.FamilyID)
+			if !ok {
+				createdAt = t.CreatedAt
+			}
+			session = &types.SessionInfo{ID: t.FamilyID, CreatedAt: createdAt}
+			sessions[t.FamilyID] = session
+		}
+
+		// 令牌按创建时间升序遍历，客户端信息以最新签发的令牌为准
+		session.UserAgent = t.UserAgent
+		session.IP = t.IP
+
+		lastUsed := t.CreatedAt
+		if t.LastUsedAt != nil && t.LastUsedAt.After(lastUsed) {
+			lastUsed = *t.LastUsedAt
+		}
+		if lastUsed.After(session.LastUsedAt) {
+			session.LastUsedAt = lastUsed
+		}
+
+		if t.Type == token.TypeRefresh {
+			hasRefresh[t.FamilyID] = true
+		}
+	}
+
+	// 只剩access令牌的族无法续期，不再视为会话
+	list := make([]*types.SessionInfo, 0, len(sessions))
+	for id, session := range sessions {
+		if hasRefresh[id] {
+			list = append(list, session)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].LastUsedAt.After(list[j].LastUsedAt) })
+
+	return list, nil
+}
+
+// ListSessions 获取用户的有效会话列表
+func (s *SessionService) ListSessions(ctx context.Context, userID string, currentSessionID string) (*types.ListSessionsOutput, error) {
+	// 创建带有服务上下文的错误构建器
+	errorBuilder := oops.FromContext(ctx).In("session").With("user_id", userID)
+
+	sessions, err := activeSessions(ctx, s.orm.Token, userID)
+	if err != nil {
+		slog.ErrorContext(ctx, "查询会话失败", "error", err, "user_id", userID)
+		return nil, errorBuilder.Wrapf(err, "查询会话失败")
+	}
+
+	for _, session := range sessions {
+		session.Current = session.ID == currentSessionID
+	}
+
+	return &types.ListSessionsOutput{Sessions: sessions}, nil
+}
+
+// RevokeSession 撤销用户的指定会话
+func (s *SessionService) RevokeSession(ctx context.Context, userID string, sessionID string) error {
+	// 创建带有服务上下文的错误构建器
+	errorBuilder := oops.FromContext(ctx).
+		In("session").
+		With("user_id", userID).
+		With("session_id", sessionID)
+
+	// 确认会话属于当前用户且仍然有效
+	exists, err := s.orm.Token.Query().
+		Where(
+			token.UserID(userID),
+			token.FamilyID(sessionID),
+			token.IsRevoked(false),
+		).
+		Exist(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "查询会话失败", "error", err, "user_id", userID)
+		return errorBuilder.Wrapf(err, "查询会话失败")
+	}
+	if !exists {
+		return apperrs.ErrNotFound.
+			Wrapf(errorBuilder.Errorf("会话不存在"), "会话查询失败")
+	}
+
+	if _, err := revokeTokenFamily(ctx, s.orm.Token, sessionID); err != nil {
+		return err
+	}
+	s.cache.InvalidateUser(userID)
+
+	s.events.Record(ctx, userID, types.SecurityEventSessionRevoked, sessionID)
+	slog.InfoContext(ctx, "会话已撤销", "user_id", userID, "session_id", sessionID)
+	return nil
+}
+
+// RevokeOtherSessions 撤销除当前会话外的所有会话
+func (s *SessionService) RevokeOtherSessions(ctx context.Context, userID string, currentSessionID string) (*types.RevokeSessionsOutput, error) {
+	// 创建带有服务上下文的错误构建器
+	errorBuilder := oops.FromContext(ctx).
+		In("session").
+		With("user_id", userID).
+		With("session_id", currentSessionID)
+
+	sessions, err := activeSessions(ctx, s.orm.Token, userID)
+	if err != nil {
+		slog.ErrorContext(ctx, "查询会话失败", "error", err, "user_id", userID)
+		return nil, errorBuilder.Wrapf(err, "查询会话失败")
+	}
+
+	// 撤销其他令牌族以及旧版本签发的无族令牌，OAuth客户端获得的授权不属于登录会话，保持不变
+	_, err = s.orm.Token.Update().
+		Where(
+			token.UserID(userID),
+			token.IsRevoked(false),
+			token.ClientIDIsNil(),
+			token.Or(
+				token.FamilyIDNEQ(currentSessionID),
+				token.FamilyIDIsNil(),
+			),
+		).
+		SetIsRevoked(true).
+		Save(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "撤销其他会话失败", "error", err, "user_id", userID)
+		return nil, errorBuilder.Wrapf(err, "撤销其他会话失败")
+	}
+	s.cache.InvalidateUser(userID)
+
+	revoked := 0
+	for _, session := range sessions {
+		if session.ID != currentSessionID {
+			revoked++
+		}
+	}
+
+	s.events.Record(ctx, userID, types.SecurityEventSessionRevoked, fmt.Sprintf("退出其他%d个会话", revoked))
+	slog.InfoContext(ctx, "已撤销其他会话", "user_id", userID, "revoked", revoked)
+	return &types.RevokeSessionsOutput{Revoked: revoked}, nil
+}
@@ -0,0 +1,156 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/liukeshao/echo-template/config"
+	"github.com/liukeshao/echo-template/pkg/appctx"
+	"github.com/liukeshao/echo-template/pkg/apperrs"
+	"github.com/liukeshao/echo-template/pkg/types"
+)
+
+func TestSessionManagement(t *testing.T) {
+	auth, client := newTestAuthService(t)
+	sessions := NewSessionService(client, auth.cache)
+	registerTestUser(t, auth)
+
+	// 模拟两台设备登录
+	login := func(userAgent string) *types.AuthOutput {
+		ctx := appctx.WithUserAgent(context.Background(), userAgent)
+		ctx = appctx.WithClientIP(ctx, "127.0.0.1")
+		out, err := auth.Login(ctx, &types.LoginInput{Email: "tester@example.com", Password: "password123"})
+		require.NoError(t, err)
+		return out
+	}
+	phone := login("phone")
+	laptop := login("laptop")
+
+	ctx := context.Background()
+	_, laptopToken, err := auth.AuthenticateUser(ctx, laptop.AccessToken)
+	require.NoError(t, err)
+	user, _, err := auth.AuthenticateUser(ctx, phone.AccessToken)
+	require.NoError(t, err)
+
+	list, err := sessions.ListSessions(ctx, user.ID, laptopToken.FamilyID)
+	require.NoError(t, err)
+	require.Len(t, list.Sessions, 3, "注册和两次登录应产生三个会话")
+
+	userAgents := make([]string, 0, len(list.Sessions))
+	for _, s := range list.Sessions {
+		userAgents = append(userAgents, s.UserAgent)
+		assert.Equal(t, s.ID == laptopToken.FamilyID, s.Current)
+	}
+	assert.Contains(t, userAgents, "phone")
+	assert.Contains(t, userAgents, "laptop")
+
+	// 第三方应用获得的授权不是登录会话
+	oauth := NewOAuthService(client, auth, "test-hash-key", "http://localhost:8000", config.OAuthConfig{})
+	created, err := oauth.CreateClient(ctx, user.ID, &types.CreateOAuthClientInput{
+		Name:         "partner",
+		RedirectURIs: []string{"https://partner.example.com/callback"},
+		GrantTypes:   []string{types.GrantTypeAuthorizationCode},
+		Scopes:       []string{types.ScopeProfileRead},
+	})
+	require.NoError(t, err)
+	granted, _ := oauthUserTokens(t, oauth, user.ID, created.Client.ID, created.ClientSecret)
+
+	// 退出其他设备后只保留当前会话，不影响第三方应用的授权
+	out, err := sessions.RevokeOtherSessions(ctx, user.ID, laptopToken.FamilyID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, out.Revoked)
+	_, _, _, err = auth.Authenticate(ctx, granted.AccessToken, []string{types.ScopeProfileRead})
+	assert.NoError(t, err, "第三方应用的授权应保持有效")
+
+	_, _, err = auth.AuthenticateUser(ctx, phone.AccessToken)
+	assert.Error(t, err, "其他设备的令牌应失效")
+	_, _, err = auth.AuthenticateUser(ctx, laptop.AccessToken)
+	assert.NoError(t, err, "当前设备的令牌应保持有效")
+
+	// 撤销当前会话
+	require.NoError(t, sessions.RevokeSession(ctx, user.ID, laptopToken.FamilyID))
+	_, err = auth.RefreshToken(ctx, &types.RefreshTokenInput{RefreshToken: laptop.RefreshToken})
+	assert.Error(t, err)
+}
+
+func TestSessionLimitEvictsLeastRecentlyUsed(t *testing.T) {
+	auth, client := newTestAuthService(t)
+	auth.jwtConfig.MaxSessionsPerUser = 2
+	sessions := NewSessionService(client, auth.cache)
+	first := registerTestUser(t, auth)
+
+	ctx := context.Background()
+	input := &types.LoginInput{Email: "tester@example.com", Password: "password123"}
+	second, err := auth.Login(ctx, input)
+	require.NoError(t, err)
+	third, err := auth.Login(ctx, input)
+	require.NoError(t, err)
+
+	// 最早的会话被撤销，最近的两个会话保留
+	_, err = auth.RefreshToken(ctx, &types.RefreshTokenInput{RefreshToken: first.RefreshToken})
+	assert.Error(t, err, "超出上限时最久未使用的会话应被撤销")
+
+	user, current, err := auth.AuthenticateUser(ctx, third.AccessToken)
+	require.NoError(t, err)
+	_, _, err = auth.AuthenticateUser(ctx, second.AccessToken)
+	assert.NoError(t, err)
+
+	list, err := sessions.ListSessions(ctx, user.ID, current.FamilyID)
+	require.NoError(t, err)
+	assert.Len(t, list.Sessions, 2)
+}
+
+func TestChangePasswordRevokesSessions(t *testing.T) {
+	auth, client := newTestAuthService(t)
+	ctx := context.Background()
+	other := registerTestUser(t, auth)
+	current, err := auth.Login(ctx, &types.LoginInput{Email: "tester@example.com", Password: "password123"})
+	require.NoError(t, err)
+
+	user, currentToken, err := auth.AuthenticateUser(ctx, current.AccessToken)
+	require.NoError(t, err)
+	me := NewMeService(client, auth, auth.passwords, auth.hasher)
+
+	// 修改密码后其他会话和当前会话的旧令牌都失效，当前会话获得同一令牌族的新令牌
+	out, err := me.ChangePassword(appctx.WithToken(ctx, currentToken), user.ID, &types.ChangePasswordInput{
+		OldPassword: "password123",
+		NewPassword: "new-password-456",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, out)
+
+	for _, stale := range []string{other.AccessToken, current.AccessToken} {
+		_, _, err = auth.AuthenticateUser(ctx, stale)
+		assertErrorCode(t, err, apperrs.CodeUnauthorized)
+	}
+	_, err = auth.RefreshToken(ctx, &types.RefreshTokenInput{RefreshToken: other.RefreshToken})
+	assertErrorCode(t, err, apperrs.CodeUnauthorized)
+
+	_, fresh, err := auth.AuthenticateUser(ctx, out.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, currentToken.FamilyID, fresh.FamilyID)
+
+	// 管理员重置密码撤销用户的所有会话
+	admin := NewAdminService(client, auth, auth.passwords, auth.hasher)
+	require.NoError(t, admin.ResetPassword(ctx, "admin", &types.AdminResetPasswordInput{UserID: user.ID, NewPassword: "admin-set-789"}))
+	_, _, err = auth.AuthenticateUser(ctx, out.AccessToken)
+	assertErrorCode(t, err, apperrs.CodeUnauthorized)
+
+	// 配置保留会话时只修改密码
+	auth.SetKeepSessionsOnPasswordChange(true)
+	kept, err := auth.Login(ctx, &types.LoginInput{Email: "tester@example.com", Password: "admin-set-789"})
+	require.NoError(t, err)
+	out, err = me.ChangePassword(ctx, user.ID, &types.ChangePasswordInput{OldPassword: "admin-set-789", NewPassword: "kept-password-0"})
+	require.NoError(t, err)
+	assert.Nil(t, out)
+	_, _, err = auth.AuthenticateUser(ctx, kept.AccessToken)
+	assert.NoError(t, err)
+
+	// 管理员重置密码不受保留会话配置影响
+	require.NoError(t, admin.ResetPassword(ctx, "admin", &types.AdminResetPasswordInput{UserID: user.ID, NewPassword: "admin-set-790"}))
+	_, _, err = auth.AuthenticateUser(ctx, kept.AccessToken)
+	assertErrorCode(t, err, apperrs.CodeUnauthorized)
+}
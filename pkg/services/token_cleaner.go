@@ -0,0 +1,284 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/liukeshao/echo-template/config"
+	"github.com/liukeshao/echo-template/ent"
+	"github.com/liukeshao/echo-template/ent/oauthcode"
+	"github.com/liukeshao/echo-template/ent/oidcstate"
+	"github.com/liukeshao/echo-template/ent/onetimetoken"
+	"github.com/liukeshao/echo-template/ent/passkeysession"
+	"github.com/liukeshao/echo-template/ent/personalaccesstoken"
+	"github.com/liukeshao/echo-template/ent/schema"
+	"github.com/liukeshao/echo-template/ent/securityevent"
+	"github.com/liukeshao/echo-template/ent/token"
+)
+
+// 令牌清理默认参数
+const (
+	DefaultTokenCleanupInterval  = time.Hour
+	DefaultTokenCleanupBatchSize = 500
+)
+
+// purgeTarget 一类待清理的数据：查询一批待删除记录的ID并删除
+type purgeTarget struct {
+	name   string
+	ids    func(ctx context.Context) ([]string, error)
+	delete func(ctx context.Context, ids []string) (int, error)
+}
+
+// TokenCleaner 定期物理删除过期令牌的后台任务。
+// 已撤销但未过期的令牌仍需保留，用于检测refresh令牌重放，过期后才会被清理。
+type TokenCleaner struct {
+	orm       *ent.Client
+	interval  time.Duration
+	grace     time.Duration
+	batchSize int
+
+	// eventRetention 安全事件保留时间，0表示不清理
+	eventRetention time.Duration
+
+	stop chan struct{}
+	done chan struct{}
+	once sync.Once
+}
+
+// NewTokenCleaner 创建令牌清理任务
+func NewTokenCleaner(orm *ent.Client, cfg config.TokenCleanupConfig) *TokenCleaner {
+	interval := cfg.Interval
+	if interval <= 0 {
+		interval = DefaultTokenCleanupInterval
+	}
+	batchSize := cfg.BatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultTokenCleanupBatchSize
+	}
+
+	return &TokenCleaner{
+		orm:       orm,
+		interval:  interval,
+		grace:     cfg.Grace,
+		batchSize: batchSize,
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+}
+
+// SetSecurityEventRetention 设置安全事件保留时间，超过保留时间的事件随过期令牌一起清理
+func (tc *TokenCleaner) SetSecurityEventRetention(retention time.Duration) {
+	tc.eventRetention = retention
+}
+
+// Start 启动后台清理，启动时立即执行一次
+func (tc *TokenCleaner) Start() {
+	go func() {
+		defer close(tc.done)
+
+		ticker := time.NewTicker(tc.interval)
+		defer ticker.Stop()
+
+		for {
+			tc.run()
+
+			select {
+			case <-ticker.C:
+			case <-tc.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop 停止后台清理并等待正在执行的批次结束
+func (tc *TokenCleaner) Stop(ctx context.Context) error {
+	tc.once.Do(func() { close(tc.stop) })
+
+	select {
+	case <-tc.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// run 执行一轮清理并记录结果
+func (tc *TokenCleaner) run() {
+	ctx := context.Background()
+	start := time.Now()
+
+	deleted, err := tc.Purge(ctx, start)
+	if err != nil {
+		slog.ErrorContext(ctx, "清理过期令牌失败", "error", err, "deleted", deleted)
+		return
+	}
+	if deleted > 0 {
+		slog.InfoContext(ctx, "已清理过期令牌", "deleted", deleted, "duration", time.Since(start))
+	}
+}
+
+// Purge 分批物理删除在 now 之前超过宽限期的过期或已删除令牌（包括一次性令牌和登录流程的临时数据）
+// 以及超过保留时间的安全事件，返回删除的数量
+func (tc *TokenCleaner) Purge(ctx context.Context, now time.Time) (int, error) {
+	// 跳过逻辑删除，直接从数据表中移除
+	ctx = schema.SkipSoftDelete(ctx)
+	cutoff := now.Add(-tc.grace)
+	deletedBefore := cutoff.UnixMilli()
+
+	purges := []purgeTarget{
+		{
+			name: "令牌",
+			ids: func(ctx context.Context) ([]string, error) {
+				return tc.orm.Token.Query().
+					Where(token.Or(
+						token.ExpiresAtLT(cutoff),
+						token.And(token.DeletedAtNEQ(0), token.DeletedAtLT(deletedBefore)),
+					)).
+					Limit(tc.batchSize).
+					IDs(ctx)
+			},
+			delete: func(ctx context.Context, ids []string) (int, error) {
+				return tc.orm.Token.Delete().Where(token.IDIn(ids...)).Exec(ctx)
+			},
+		},
+		{
+			name: "一次性令牌",
+			ids: func(ctx context.Context) ([]string, error) {
+				return tc.orm.OneTimeToken.Query().
+					Where(onetimetoken.Or(
+						onetimetoken.ExpiresAtLT(cutoff),
+						onetimetoken.And(onetimetoken.DeletedAtNEQ(0), onetimetoken.DeletedAtLT(deletedBefore)),
+					)).
+					Limit(tc.batchSize).
+					IDs(ctx)
+			},
+			delete: func(ctx context.Context, ids []string) (int, error) {
+				return tc.orm.OneTimeToken.Delete().Where(onetimetoken.IDIn(ids...)).Exec(ctx)
+			},
+		},
+		{
+			name: "通行密钥会话",
+			ids: func(ctx context.Context) ([]string, error) {
+				return tc.orm.PasskeySession.Query().
+					Where(passkeysession.Or(
+						passkeysession.ExpiresAtLT(cutoff),
+						passkeysession.And(passkeysession.DeletedAtNEQ(0), passkeysession.DeletedAtLT(deletedBefore)),
+					)).
+					Limit(tc.batchSize).
+					IDs(ctx)
+			},
+			delete: func(ctx context.Context, ids []string) (int, error) {
+				return tc.orm.PasskeySession.Delete().Where(passkeysession.IDIn(ids...)).Exec(ctx)
+			},
+		},
+		{
+			name: "外部登录状态",
+			ids: func(ctx context.Context) ([]string, error) {
+				return tc.orm.OIDCState.Query().
+					Where(oidcstate.Or(
+						oidcstate.ExpiresAtLT(cutoff),
+						oidcstate.And(oidcstate.DeletedAtNEQ(0), oidcstate.DeletedAtLT(deletedBefore)),
+					)).
+					Limit(tc.batchSize).
+					IDs(ctx)
+			},
+			delete: func(ctx context.Context, ids []string) (int, error) {
+				return tc.orm.OIDCState.Delete().Where(oidcstate.IDIn(ids...)).Exec(ctx)
+			},
+		},
+		{
+			name: "OAuth授权码",
+			ids: func(ctx context.Context) ([]string, error) {
+				return tc.orm.OAuthCode.Query().
+					Where(oauthcode.Or(
+						oauthcode.ExpiresAtLT(cutoff),
+						oauthcode.And(oauthcode.DeletedAtNEQ(0), oauthcode.DeletedAtLT(deletedBefore)),
+					)).
+					Limit(tc.batchSize).
+					IDs(ctx)
+			},
+			delete: func(ctx context.Context, ids []string) (int, error) {
+				return tc.orm.OAuthCode.Delete().Where(oauthcode.IDIn(ids...)).Exec(ctx)
+			},
+		},
+		{
+			name: "个人访问令牌",
+			ids: func(ctx context.Context) ([]string, error) {
+				return tc.orm.PersonalAccessToken.Query().
+					Where(personalaccesstoken.Or(
+						personalaccesstoken.ExpiresAtLT(cutoff),
+						personalaccesstoken.RevokedAtLT(cutoff),
+						personalaccesstoken.And(personalaccesstoken.DeletedAtNEQ(0), personalaccesstoken.DeletedAtLT(deletedBefore)),
+					)).
+					Limit(tc.batchSize).
+					IDs(ctx)
+			},
+			delete: func(ctx context.Context, ids []string) (int, error) {
+				return tc.orm.PersonalAccessToken.Delete().Where(personalaccesstoken.IDIn(ids...)).Exec(ctx)
+			},
+		},
+	}
+
+	if tc.eventRetention > 0 {
+		eventsBefore := now.Add(-tc.eventRetention)
+		purges = append(purges, purgeTarget{
+			name: "安全事件",
+			ids: func(ctx context.Context) ([]string, error) {
+				return tc.orm.SecurityEvent.Query().
+					Where(securityevent.Or(
+						securityevent.CreatedAtLT(eventsBefore),
+						securityevent.And(securityevent.DeletedAtNEQ(0), securityevent.DeletedAtLT(deletedBefore)),
+					)).
+					Limit(tc.batchSize).
+					IDs(ctx)
+			},
+			delete: func(ctx context.Context, ids []string) (int, error) {
+				return tc.orm.SecurityEvent.Delete().Where(securityevent.IDIn(ids...)).Exec(ctx)
+			},
+		})
+	}
+
+	total := 0
+	for _, p := range purges {
+		n, err := tc.purgeBatches(ctx, p.name, p.ids, p.delete)
+		total += n
+		if err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
+
+// purgeBatches 反复查询一批待删除记录的ID并删除，直到没有剩余记录或任务停止
+func (tc *TokenCleaner) purgeBatches(ctx context.Context, name string, ids func(context.Context) ([]string, error), del func(context.Context, []string) (int, error)) (int, error) {
+	total := 0
+	for {
+		select {
+		case <-tc.stop:
+			return total, nil
+		default:
+		}
+
+		batch, err := ids(ctx)
+		if err != nil {
+			return total, err
+		}
+		if len(batch) == 0 {
+			return total, nil
+		}
+
+		n, err := del(ctx, batch)
+		if err != nil {
+			return total, err
+		}
+		total += n
+		slog.DebugContext(ctx, "已删除一批过期数据", "type", name, "count", n)
+
+		if len(batch) < tc.batchSize {
+			return total, nil
+		}
+	}
+}
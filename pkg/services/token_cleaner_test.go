@@ -0,0 +1,60 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/liukeshao/echo-template/config"
+	"github.com/liukeshao/echo-template/ent/schema"
+	"github.com/liukeshao/echo-template/ent/token"
+	"github.com/liukeshao/echo-template/pkg/utils"
+)
+
+func TestTokenCleanerPurge(t *testing.T) {
+	auth, client := newTestAuthService(t)
+	ctx := context.Background()
+	registerTestUser(t, auth)
+	userID := client.User.Query().OnlyIDX(ctx)
+
+	now := time.Now()
+	createToken := func(expiresAt time.Time, revoked bool) string {
+		id := utils.GenerateULID()
+		client.Token.Create().
+			SetID(id).
+			SetUserID(userID).
+			SetTokenHash(id).
+			SetType(token.TypeRefresh).
+			SetExpiresAt(expiresAt).
+			SetIsRevoked(revoked).
+			SaveX(ctx)
+		return id
+	}
+
+	expired := []string{
+		createToken(now.Add(-48*time.Hour), false),
+		createToken(now.Add(-72*time.Hour), true),
+		createToken(now.Add(-96*time.Hour), false),
+	}
+	withinGrace := createToken(now.Add(-time.Hour), false)
+	revoked := createToken(now.Add(time.Hour), true)
+
+	// 逻辑删除超过宽限期的令牌也应被物理删除
+	deleted := createToken(now.Add(time.Hour), false)
+	client.Token.UpdateOneID(deleted).SetDeletedAt(now.Add(-48 * time.Hour).UnixMilli()).ExecX(ctx)
+
+	cleaner := NewTokenCleaner(client, config.TokenCleanupConfig{Grace: 24 * time.Hour, BatchSize: 2})
+	n, err := cleaner.Purge(ctx, now)
+	require.NoError(t, err)
+	assert.Equal(t, len(expired)+1, n)
+
+	remaining := client.Token.Query().IDsX(schema.SkipSoftDelete(ctx))
+	assert.Contains(t, remaining, withinGrace, "宽限期内的令牌应保留")
+	assert.Contains(t, remaining, revoked, "未过期的已撤销令牌应保留用于重放检测")
+	for _, id := range append(expired, deleted) {
+		assert.NotContains(t, remaining, id)
+	}
+}
@@ -0,0 +1,166 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/liukeshao/echo-template/ent"
+	"github.com/liukeshao/echo-template/ent/token"
+)
+
+// DefaultTokenUsageFlushInterval 令牌使用时间默认刷新间隔
+const DefaultTokenUsageFlushInterval = time.Minute
+
+// TokenUsageRecorder 异步记录令牌最后使用时间的后台写入器。
+// 请求只更新内存中的待写入表，同一令牌在一个刷新周期内的多次使用合并为一次写入。
+type TokenUsageRecorder struct {
+	orm      *ent.Client
+	interval time.Duration
+
+	mu      sync.Mutex
+	pending map[string]time.Time // 令牌ID -> 最后使用时间
+
+	stop chan struct{}
+	done chan struct{}
+	once sync.Once
+}
+
+// NewTokenUsageRecorder 创建令牌使用时间写入器
+func NewTokenUsageRecorder(orm *ent.Client, interval time.Duration) *TokenUsageRecorder {
+	if interval <= 0 {
+		interval = DefaultTokenUsageFlushInterval
+	}
+
+	return &TokenUsageRecorder{
+		orm:      orm,
+		interval: interval,
+		pending:  make(map[string]time.Time),
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+// Start 启动后台定期刷新
+func (r *TokenUsageRecorder) Start() {
+	go func() {
+		defer close(r.done)
+
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				r.flushAndLog()
+			case <-r.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop 停止后台刷新并写入剩余的使用记录
+func (r *TokenUsageRecorder) Stop(ctx context.Context) error {
+	r.once.Do(func() { close(r.stop) })
+
+	select {
+	case <-r.done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	_, err := r.Flush(ctx)
+	return err
+}
+
+// Record 记录令牌在 at 时刻被使用，不会阻塞请求
+func (r *TokenUsageRecorder) Record(t *ent.Token, at time.Time) {
+	// 数据库中的记录在一个刷新周期内已更新过，无需再次写入
+	if t.LastUsedAt != nil && at.Sub(*t.LastUsedAt) < r.interval {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if prev, ok := r.pending[t.ID]; !ok || at.After(prev) {
+		r.pending[t.ID] = at
+	}
+}
+
+// LastUsedAt 返回令牌尚未写入数据库的最后使用时间
+func (r *TokenUsageRecorder) LastUsedAt(tokenID string) (time.Time, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	at, ok := r.pending[tokenID]
+	return at, ok
+}
+
+// Flush 将待写入的使用记录批量写入数据库，返回写入的令牌数量
+func (r *TokenUsageRecorder) Flush(ctx context.Context) (int, error) {
+	r.mu.Lock()
+	batch := r.pending
+	r.pending = make(map[string]time.Time, len(batch))
+	r.mu.Unlock()
+
+	if len(batch) == 0 {
+		return 0, nil
+	}
+
+	tx, err := r.orm.Tx(ctx)
+	if err != nil {
+		r.requeue(batch)
+		return 0, err
+	}
+
+	for tokenID, at := range batch {
+		err := tx.Token.Update().
+			Where(
+				token.ID(tokenID),
+				token.Or(token.LastUsedAtIsNil(), token.LastUsedAtLT(at)),
+			).
+			SetLastUsedAt(at).
+			Exec(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			r.requeue(batch)
+			return 0, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.requeue(batch)
+		return 0, err
+	}
+
+	return len(batch), nil
+}
+
+// requeue 写入失败时将记录放回待写入表，等待下一次刷新
+func (r *TokenUsageRecorder) requeue(batch map[string]time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for tokenID, at := range batch {
+		if prev, ok := r.pending[tokenID]; !ok || at.After(prev) {
+			r.pending[tokenID] = at
+		}
+	}
+}
+
+// flushAndLog 执行一次刷新并记录结果
+func (r *TokenUsageRecorder) flushAndLog() {
+	ctx := context.Background()
+
+	n, err := r.Flush(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "写入令牌使用时间失败", "error", err)
+		return
+	}
+	if n > 0 {
+		slog.DebugContext(ctx, "已写入令牌使用时间", "count", n)
+	}
+}
@@ -0,0 +1,56 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/liukeshao/echo-template/pkg/types"
+)
+
+func TestTokenUsageRecorder(t *testing.T) {
+	auth, client := newTestAuthService(t)
+	ctx := context.Background()
+	out := registerTestUser(t, auth)
+
+	usage := NewTokenUsageRecorder(client, time.Minute)
+	auth.SetTokenUsageRecorder(usage)
+
+	// 多次使用合并为一次写入
+	for range 3 {
+		_, dbToken, err := auth.AuthenticateUser(ctx, out.AccessToken)
+		require.NoError(t, err)
+		auth.UpdateTokenUsage(dbToken)
+	}
+	n, err := usage.Flush(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	dbToken, err := auth.findValidToken(ctx, out.AccessToken, types.TokenTypeAccess)
+	require.NoError(t, err)
+	require.NotNil(t, dbToken.LastUsedAt, "应写入最后使用时间")
+
+	// 刷新周期内已写入过的令牌不再重复写入
+	auth.UpdateTokenUsage(dbToken)
+	n, err = usage.Flush(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+}
+
+func TestIdleTimeout(t *testing.T) {
+	auth, _ := newTestAuthService(t)
+	ctx := context.Background()
+	out := registerTestUser(t, auth)
+
+	auth.jwtConfig.IdleTimeout = time.Hour
+	_, _, err := auth.AuthenticateUser(ctx, out.AccessToken)
+	require.NoError(t, err)
+
+	auth.jwtConfig.IdleTimeout = time.Millisecond
+	time.Sleep(10 * time.Millisecond)
+	_, _, err = auth.AuthenticateUser(ctx, out.AccessToken)
+	assert.Error(t, err, "空闲超时的access token应被拒绝")
+}
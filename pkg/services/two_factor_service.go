@@ -0,0 +1,321 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/liukeshao/echo-template/ent"
+	"github.com/liukeshao/echo-template/ent/recoverycode"
+	userEnt "github.com/liukeshao/echo-template/ent/user"
+	"github.com/liukeshao/echo-template/pkg/apperrs"
+	"github.com/liukeshao/echo-template/pkg/types"
+	"github.com/liukeshao/echo-template/pkg/utils"
+)
+
+// 两步验证常量
+const (
+	RecoveryCodeCount  = 10              // 每次生成的恢复码数量
+	MFAChallengeExpiry = 5 * time.Minute // 密码验证通过后完成两步验证的时限
+)
+
+var recoveryCodeEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// TwoFactorService TOTP两步验证服务
+type TwoFactorService struct {
+	orm     *ent.Client
+	cache   *AuthCache
+	events  *SecurityEventService
+	hasher  utils.PasswordHasher
+	hashKey string
+	issuer  string
+}
+
+// NewTwoFactorService 创建两步验证服务，issuer 显示在身份验证器应用中
+func NewTwoFactorService(orm *ent.Client, cache *AuthCache, hasher utils.PasswordHasher, hashKey string, issuer string) *TwoFactorService {
+	return &TwoFactorService{
+		orm:     orm,
+		cache:   cache,
+		hasher:  hasher,
+		hashKey: hashKey,
+		issuer:  issuer,
+	}
+}
+
+// SetSecurityEvents 设置安全事件日志
+func (s *TwoFactorService) SetSecurityEvents(events *SecurityEventService) {
+	s.events = events
+}
+
+// getUser 查询用户
+func (s *TwoFactorService) getUser(ctx context.Context, userID string) (*ent.User, error) {
+	u, err := s.orm.User.Get(ctx, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperrs.ErrNotFound.With("user_id", userID).Errorf("用户不存在")
+		}
+		slog.ErrorContext(ctx, "查询用户失败", "error", err, "user_id", userID)
+		return nil, apperrs.ErrDatabase.With("user_id", userID).With("原始错误", err).Errorf("查询用户失败")
+	}
+	return u, nil
+}
+
+// Status 获取两步验证状态
+func (s *TwoFactorService) Status(ctx context.Context, userID string) (*types.TwoFactorStatusOutput, error) {
+	u, err := s.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	remaining := 0
+	if u.TwoFactorEnabled {
+		remaining, err = s.orm.RecoveryCode.Query().
+			Where(
+				recoverycode.UserID(userID),
+				recoverycode.UsedAtIsNil(),
+			).
+			Count(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "统计恢复码失败", "error", err, "user_id", userID)
+			return nil, apperrs.ErrDatabase.With("user_id", userID).With("原始错误", err).Errorf("统计恢复码失败")
+		}
+	}
+
+	return &types.TwoFactorStatusOutput{
+		Enabled:                u.TwoFactorEnabled,
+		RecoveryCodesRemaining: remaining,
+	}, nil
+}
+
+// Setup 生成新的TOTP密钥，确认前不会生效。重复调用会替换尚未确认的密钥
+func (s *TwoFactorService) Setup(ctx context.Context, userID string) (*types.TwoFactorSetupOutput, error) {
+	u, err := s.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if u.TwoFactorEnabled {
+		return nil, apperrs.ErrConflict.With("user_id", userID).Public("两步验证已开启").Errorf("两步验证已开启")
+	}
+
+	secret := utils.GenerateTOTPSecret()
+	err = s.orm.User.UpdateOne(u).
+		SetTotpSecret(secret).
+		SetTotpLastStep(0).
+		Exec(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "保存两步验证密钥失败", "error", err, "user_id", userID)
+		return nil, apperrs.ErrDatabase.With("user_id", userID).With("原始错误", err).Errorf("保存两步验证密钥失败")
+	}
+
+	return &types.TwoFactorSetupOutput{
+		Secret:          secret,
+		ProvisioningURI: utils.TOTPURI(s.issuer, u.Email, secret),
+	}, nil
+}
+
+// Confirm 使用身份验证器中的验证码确认开启两步验证，返回只展示一次的恢复码
+func (s *TwoFactorService) Confirm(ctx context.Context, userID string, input *types.TwoFactorConfirmInput) (*types.TwoFactorConfirmOutput, error) {
+	u, err := s.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if u.TwoFactorEnabled {
+		return nil, apperrs.ErrConflict.With("user_id", userID).Public("两步验证已开启").Errorf("两步验证已开启")
+	}
+	if u.TotpSecret == "" {
+		return nil, apperrs.ErrBadRequest.With("user_id", userID).Public("请先获取两步验证密钥").Errorf("未生成两步验证密钥")
+	}
+
+	step, ok := utils.ValidateTOTP(u.TotpSecret, input.Code, time.Now(), u.TotpLastStep)
+	if !ok {
+		return nil, apperrs.ErrBadRequest.With("user_id", userID).Public("验证码错误").Errorf("验证码错误")
+	}
+
+	tx, err := s.orm.Tx(ctx)
+	if err != nil {
+		return nil, apperrs.ErrDatabase.With("user_id", userID).With("原始错误", err).Errorf("开启事务失败")
+	}
+	defer tx.Rollback()
+
+	affected, err := tx.User.Update().
+		Where(
+			userEnt.ID(userID),
+			userEnt.TwoFactorEnabled(false),
+		).
+		SetTwoFactorEnabled(true).
+		SetTotpLastStep(step).
+		Save(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "开启两步验证失败", "error", err, "user_id", userID)
+		return nil, apperrs.ErrDatabase.With("user_id", userID).With("原始错误", err).Errorf("开启两步验证失败")
+	}
+	if affected == 0 {
+		return nil, apperrs.ErrConflict.With("user_id", userID).Public("两步验证已开启").Errorf("两步验证已开启")
+	}
+
+	codes, err := s.replaceRecoveryCodes(ctx, tx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.cache.InvalidateUserOnCommit(tx, userID)
+	if err := tx.Commit(); err != nil {
+		return nil, apperrs.ErrDatabase.With("user_id", userID).With("原始错误", err).Errorf("提交事务失败")
+	}
+
+	s.events.Record(ctx, userID, types.SecurityEventTwoFactorEnabled, "")
+	slog.InfoContext(ctx, "已开启两步验证", "user_id", userID)
+	return &types.TwoFactorConfirmOutput{RecoveryCodes: codes}, nil
+}
+
+// Disable 校验密码和验证码后关闭两步验证，同时作废所有恢复码
+func (s *TwoFactorService) Disable(ctx context.Context, userID string, input *types.TwoFactorDisableInput) error {
+	u, err := s.getUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !u.TwoFactorEnabled {
+		return apperrs.ErrBadRequest.With("user_id", userID).Public("两步验证未开启").Errorf("两步验证未开启")
+	}
+	if ok, _, _ := s.hasher.Verify(u.PasswordHash, input.Password); !ok {
+		return apperrs.ErrBadRequest.With("user_id", userID).Public("密码错误").Errorf("密码错误")
+	}
+
+	tx, err := s.orm.Tx(ctx)
+	if err != nil {
+		return apperrs.ErrDatabase.With("user_id", userID).With("原始错误", err).Errorf("开启事务失败")
+	}
+	defer tx.Rollback()
+
+	ok, err := s.VerifyCode(ctx, tx, u, input.Code)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return apperrs.ErrBadRequest.With("user_id", userID).Public("验证码错误").Errorf("验证码错误")
+	}
+
+	err = tx.User.UpdateOne(u).
+		ClearTotpSecret().
+		SetTwoFactorEnabled(false).
+		SetTotpLastStep(0).
+		Exec(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "关闭两步验证失败", "error", err, "user_id", userID)
+		return apperrs.ErrDatabase.With("user_id", userID).With("原始错误", err).Errorf("关闭两步验证失败")
+	}
+
+	if err := revokeRecoveryCodes(ctx, tx, userID); err != nil {
+		return err
+	}
+
+	s.cache.InvalidateUserOnCommit(tx, userID)
+	if err := tx.Commit(); err != nil {
+		return apperrs.ErrDatabase.With("user_id", userID).With("原始错误", err).Errorf("提交事务失败")
+	}
+
+	s.events.Record(ctx, userID, types.SecurityEventTwoFactorDisabled, "")
+	slog.InfoContext(ctx, "已关闭两步验证", "user_id", userID)
+	return nil
+}
+
+// VerifyCode 校验TOTP验证码或恢复码。验证码使用后记录时间步防止重放，恢复码使用后立即作废
+func (s *TwoFactorService) VerifyCode(ctx context.Context, tx *ent.Tx, u *ent.User, code string) (bool, error) {
+	if !u.TwoFactorEnabled || u.TotpSecret == "" {
+		return false, nil
+	}
+
+	if step, ok := utils.ValidateTOTP(u.TotpSecret, code, time.Now(), u.TotpLastStep); ok {
+		// 带条件更新，并发请求使用同一验证码时只有一个能成功
+		affected, err := tx.User.Update().
+			Where(
+				userEnt.ID(u.ID),
+				userEnt.TotpLastStepLT(step),
+			).
+			SetTotpLastStep(step).
+			Save(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "记录验证码使用失败", "error", err, "user_id", u.ID)
+			return false, apperrs.ErrDatabase.With("user_id", u.ID).With("原始错误", err).Errorf("记录验证码使用失败")
+		}
+		return affected > 0, nil
+	}
+
+	normalized := normalizeRecoveryCode(code)
+	if normalized == "" {
+		return false, nil
+	}
+	affected, err := tx.RecoveryCode.Update().
+		Where(
+			recoverycode.UserID(u.ID),
+			recoverycode.CodeHash(utils.HashToken(s.hashKey, normalized)),
+			recoverycode.UsedAtIsNil(),
+		).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "使用恢复码失败", "error", err, "user_id", u.ID)
+		return false, apperrs.ErrDatabase.With("user_id", u.ID).With("原始错误", err).Errorf("使用恢复码失败")
+	}
+	if affected > 0 {
+		slog.InfoContext(ctx, "已使用恢复码", "user_id", u.ID)
+	}
+	return affected > 0, nil
+}
+
+// replaceRecoveryCodes 作废用户已有的恢复码并生成一组新的恢复码
+func (s *TwoFactorService) replaceRecoveryCodes(ctx context.Context, tx *ent.Tx, userID string) ([]string, error) {
+	if err := revokeRecoveryCodes(ctx, tx, userID); err != nil {
+		return nil, err
+	}
+
+	codes := make([]string, RecoveryCodeCount)
+	builders := make([]*ent.RecoveryCodeCreate, RecoveryCodeCount)
+	for i := range codes {
+		codes[i] = generateRecoveryCode()
+		builders[i] = tx.RecoveryCode.Create().
+			SetID(utils.GenerateULID()).
+			SetUserID(userID).
+			SetCodeHash(utils.HashToken(s.hashKey, normalizeRecoveryCode(codes[i])))
+	}
+	if err := tx.RecoveryCode.CreateBulk(builders...).Exec(ctx); err != nil {
+		slog.ErrorContext(ctx, "保存恢复码失败", "error", err, "user_id", userID)
+		return nil, apperrs.ErrDatabase.With("user_id", userID).With("原始错误", err).Errorf("保存恢复码失败")
+	}
+
+	return codes, nil
+}
+
+// revokeRecoveryCodes 将用户所有未使用的恢复码标记为已使用
+func revokeRecoveryCodes(ctx context.Context, tx *ent.Tx, userID string) error {
+	_, err := tx.RecoveryCode.Update().
+		Where(
+			recoverycode.UserID(userID),
+			recoverycode.UsedAtIsNil(),
+		).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "作废恢复码失败", "error", err, "user_id", userID)
+		return apperrs.ErrDatabase.With("user_id", userID).With("原始错误", err).Errorf("作废恢复码失败")
+	}
+	return nil
+}
+
+// generateRecoveryCode 生成形如 xxxx-xxxx 的恢复码
+func generateRecoveryCode() string {
+	b := make([]byte, 5)
+	rand.Read(b)
+	code := strings.ToLower(recoveryCodeEncoding.EncodeToString(b))
+	return code[:4] + "-" + code[4:]
+}
+
+// normalizeRecoveryCode 忽略大小写、空格和连字符，方便用户手动输入
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(code)
+	code = strings.ReplaceAll(code, "-", "")
+	return strings.ReplaceAll(code, " ", "")
+}
@@ -0,0 +1,86 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/liukeshao/echo-template/pkg/apperrs"
+	"github.com/liukeshao/echo-template/pkg/types"
+	"github.com/liukeshao/echo-template/pkg/utils"
+)
+
+func TestTwoFactorLogin(t *testing.T) {
+	auth, client := newTestAuthService(t)
+	ctx := context.Background()
+	registerTestUser(t, auth)
+	userID := client.User.Query().OnlyIDX(ctx)
+
+	twoFactor := NewTwoFactorService(client, auth.cache, auth.hasher, "test-hash-key", "test")
+	auth.SetTwoFactorService(twoFactor)
+
+	// 控制失败退避的时钟，避免验证码错误后的退避影响后续步骤
+	now := time.Now()
+	auth.loginGuard.now = func() time.Time { return now }
+
+	setup, err := twoFactor.Setup(ctx, userID)
+	require.NoError(t, err)
+	assert.Contains(t, setup.ProvisioningURI, "otpauth://totp/")
+
+	// 使用上一个时间步的验证码确认，留出当前时间步供登录使用
+	code := func(offset int64) string {
+		c, err := utils.TOTPCode(setup.Secret, utils.TOTPStep(time.Now())+offset)
+		require.NoError(t, err)
+		return c
+	}
+	confirmed, err := twoFactor.Confirm(ctx, userID, &types.TwoFactorConfirmInput{Code: code(-1)})
+	require.NoError(t, err)
+	require.Len(t, confirmed.RecoveryCodes, RecoveryCodeCount)
+
+	login := func() *types.AuthOutput {
+		out, err := auth.Login(ctx, &types.LoginInput{Email: "tester@example.com", Password: "password123"})
+		require.NoError(t, err)
+		require.True(t, out.MFARequired)
+		assert.Empty(t, out.AccessToken, "完成两步验证前不签发令牌")
+		return out
+	}
+
+	// 验证码正确时完成登录，挑战令牌只能使用一次
+	// 固定验证码，避免跨越时间步时重放断言拿到新的验证码
+	current := code(0)
+	challenge := login()
+	out, err := auth.LoginTwoFactor(ctx, &types.LoginTwoFactorInput{MFAToken: challenge.MFAToken, Code: current})
+	require.NoError(t, err)
+	_, _, err = auth.AuthenticateUser(ctx, out.AccessToken)
+	assert.NoError(t, err)
+
+	// 同一验证码不能重放
+	challenge = login()
+	_, err = auth.LoginTwoFactor(ctx, &types.LoginTwoFactorInput{MFAToken: challenge.MFAToken, Code: current})
+	assertErrorCode(t, err, apperrs.CodeUnauthorized)
+	now = now.Add(time.Minute)
+
+	// 恢复码只能使用一次
+	recovery := confirmed.RecoveryCodes[0]
+	_, err = auth.LoginTwoFactor(ctx, &types.LoginTwoFactorInput{MFAToken: challenge.MFAToken, Code: recovery})
+	require.NoError(t, err)
+	challenge = login()
+	_, err = auth.LoginTwoFactor(ctx, &types.LoginTwoFactorInput{MFAToken: challenge.MFAToken, Code: recovery})
+	assertErrorCode(t, err, apperrs.CodeUnauthorized)
+	now = now.Add(time.Minute)
+
+	status, err := twoFactor.Status(ctx, userID)
+	require.NoError(t, err)
+	assert.True(t, status.Enabled)
+	assert.Equal(t, RecoveryCodeCount-1, status.RecoveryCodesRemaining)
+
+	// 关闭后恢复为单步登录
+	require.NoError(t, twoFactor.Disable(ctx, userID, &types.TwoFactorDisableInput{Password: "password123", Code: confirmed.RecoveryCodes[1]}))
+	out, err = auth.Login(ctx, &types.LoginInput{Email: "tester@example.com", Password: "password123"})
+	require.NoError(t, err)
+	assert.False(t, out.MFARequired)
+	assert.NotEmpty(t, out.AccessToken)
+}
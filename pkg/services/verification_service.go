@@ -0,0 +1,133 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/liukeshao/echo-template/config"
+	"github.com/liukeshao/echo-template/ent"
+	"github.com/liukeshao/echo-template/ent/onetimetoken"
+	userEnt "github.com/liukeshao/echo-template/ent/user"
+	"github.com/liukeshao/echo-template/pkg/apperrs"
+	"github.com/liukeshao/echo-template/pkg/mail"
+	"github.com/liukeshao/echo-template/pkg/types"
+)
+
+// DefaultVerificationExpiry 邮箱验证链接默认有效期
+const DefaultVerificationExpiry = 24 * time.Hour
+
+// VerificationService 邮箱验证服务
+// 开启邮箱验证后注册的用户处于 inactive 状态，通过邮件中的一次性链接激活
+type VerificationService struct {
+	orm     *ent.Client
+	mailer  mail.Mailer
+	hashKey string
+	host    string
+	enabled bool
+	expiry  time.Duration
+}
+
+// NewVerificationService 创建邮箱验证服务
+func NewVerificationService(orm *ent.Client, mailer mail.Mailer, hashKey string, host string, cfg config.AccountConfig) *VerificationService {
+	expiry := cfg.VerificationExpiry
+	if expiry <= 0 {
+		expiry = DefaultVerificationExpiry
+	}
+
+	return &VerificationService{
+		orm:     orm,
+		mailer:  mailer,
+		hashKey: hashKey,
+		host:    strings.TrimRight(host, "/"),
+		enabled: cfg.VerifyEmail,
+		expiry:  expiry,
+	}
+}
+
+// Enabled 是否要求注册用户验证邮箱
+func (s *VerificationService) Enabled() bool {
+	return s != nil && s.enabled
+}
+
+// SendVerification 为用户签发验证令牌并发送验证邮件
+func (s *VerificationService) SendVerification(ctx context.Context, user *ent.User) error {
+	raw, err := issueOneTimeToken(ctx, s.orm.OneTimeToken, s.hashKey, user.ID, onetimetoken.PurposeVerifyEmail, s.expiry)
+	if err != nil {
+		return err
+	}
+
+	link := s.host + "/api/v1/auth/verify-email?token=" + url.QueryEscape(raw)
+	msg := &mail.Message{
+		To:      user.Email,
+		Subject: "验证您的邮箱",
+		Body: fmt.Sprintf("%s，您好：\n\n请点击以下链接完成邮箱验证，链接%s内有效：\n\n%s\n\n如果这不是您本人的操作，请忽略此邮件。",
+			user.Username, formatExpiry(s.expiry), link),
+	}
+	if err := s.mailer.Send(ctx, msg); err != nil {
+		slog.ErrorContext(ctx, "发送验证邮件失败", "error", err, "user_id", user.ID)
+		return apperrs.ErrExternalAPI.With("user_id", user.ID).With("原始错误", err).Errorf("发送验证邮件失败")
+	}
+
+	slog.InfoContext(ctx, "已发送验证邮件", "user_id", user.ID)
+	return nil
+}
+
+// VerifyEmail 使用验证令牌激活账户
+func (s *VerificationService) VerifyEmail(ctx context.Context, input *types.VerifyEmailInput) error {
+	tx, err := s.orm.Tx(ctx)
+	if err != nil {
+		return apperrs.ErrDatabase.With("原始错误", err).Errorf("开启事务失败")
+	}
+	defer tx.Rollback()
+
+	ott, err := consumeOneTimeToken(ctx, tx.OneTimeToken, s.hashKey, input.Token, onetimetoken.PurposeVerifyEmail)
+	if err != nil {
+		return err
+	}
+
+	// 只激活待验证的账户，不会恢复被停用的账户
+	_, err = tx.User.Update().
+		Where(
+			userEnt.ID(ott.UserID),
+			userEnt.StatusEQ(userEnt.StatusInactive),
+		).
+		SetStatus(userEnt.StatusActive).
+		Save(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "激活账户失败", "error", err, "user_id", ott.UserID)
+		return apperrs.ErrDatabase.With("user_id", ott.UserID).With("原始错误", err).Errorf("激活账户失败")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return apperrs.ErrDatabase.With("user_id", ott.UserID).With("原始错误", err).Errorf("提交事务失败")
+	}
+
+	slog.InfoContext(ctx, "邮箱验证成功", "user_id", ott.UserID)
+	return nil
+}
+
+// ResendVerification 重新发送验证邮件。无论邮箱是否注册或已验证都返回成功，不暴露账户是否存在
+func (s *VerificationService) ResendVerification(ctx context.Context, input *types.ResendVerificationInput) error {
+	user, err := s.orm.User.Query().
+		Where(userEnt.Email(input.Email)).
+		Only(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		slog.InfoContext(ctx, "重新发送验证邮件：邮箱未注册", "email", input.Email)
+		return nil
+	case err != nil:
+		slog.ErrorContext(ctx, "查询用户失败", "error", err, "email", input.Email)
+		return apperrs.ErrDatabase.With("email", input.Email).With("原始错误", err).Errorf("查询用户失败")
+	}
+
+	if user.Status != userEnt.StatusInactive {
+		slog.InfoContext(ctx, "重新发送验证邮件：账户无需验证", "user_id", user.ID, "status", user.Status)
+		return nil
+	}
+
+	return s.SendVerification(ctx, user)
+}
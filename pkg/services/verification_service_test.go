@@ -0,0 +1,63 @@
+package services
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/liukeshao/echo-template/config"
+	"github.com/liukeshao/echo-template/pkg/mail"
+	"github.com/liukeshao/echo-template/pkg/types"
+)
+
+// linkTokenPattern 匹配邮件链接中的令牌参数
+var linkTokenPattern = regexp.MustCompile(`token=([^\s&]+)`)
+
+// tokenFromMail 从发送给指定收件人的最后一封邮件中提取令牌
+func tokenFromMail(t *testing.T, mailer *mail.MemoryMailer, to string) string {
+	t.Helper()
+
+	msg, ok := mailer.Last(to)
+	require.True(t, ok, "应发送邮件")
+	m := linkTokenPattern.FindStringSubmatch(msg.Body)
+	require.Len(t, m, 2, "邮件中应包含令牌链接")
+	raw, err := url.QueryUnescape(m[1])
+	require.NoError(t, err)
+	return raw
+}
+
+func TestEmailVerification(t *testing.T) {
+	auth, client := newTestAuthService(t)
+	ctx := context.Background()
+
+	mailer := mail.NewMemoryMailer()
+	verification := NewVerificationService(client, mailer, "test-hash-key", "http://localhost", config.AccountConfig{VerifyEmail: true})
+	auth.SetVerificationService(verification)
+
+	out := registerTestUser(t, auth)
+	assert.True(t, out.VerificationRequired)
+	assert.Empty(t, out.AccessToken, "待验证用户不应签发令牌")
+
+	login := &types.LoginInput{Email: "tester@example.com", Password: "password123"}
+	_, err := auth.Login(ctx, login)
+	assert.Error(t, err, "验证邮箱前不能登录")
+
+	// 重新发送后旧链接失效
+	first := tokenFromMail(t, mailer, "tester@example.com")
+	require.NoError(t, verification.ResendVerification(ctx, &types.ResendVerificationInput{Email: "tester@example.com"}))
+	second := tokenFromMail(t, mailer, "tester@example.com")
+	assert.Error(t, verification.VerifyEmail(ctx, &types.VerifyEmailInput{Token: first}))
+
+	require.NoError(t, verification.VerifyEmail(ctx, &types.VerifyEmailInput{Token: second}))
+	assert.Error(t, verification.VerifyEmail(ctx, &types.VerifyEmailInput{Token: second}), "验证链接只能使用一次")
+
+	_, err = auth.Login(ctx, login)
+	assert.NoError(t, err)
+
+	// 未注册的邮箱同样返回成功
+	assert.NoError(t, verification.ResendVerification(ctx, &types.ResendVerificationInput{Email: "nobody@example.com"}))
+}
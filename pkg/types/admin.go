@@ -0,0 +1,32 @@
+package types
+
+import (
+	z "github.com/Oudwins/zog"
+
+	"github.com/liukeshao/echo-template/pkg/apperrs"
+)
+
+// AdminResetPasswordInput 管理员重置用户密码输入
+type AdminResetPasswordInput struct {
+	UserID      string `param:"id"`          // 用户ID
+	NewPassword string `json:"new_password"` // 新密码
+}
+
+// Validate 验证管理员重置用户密码输入
+func (i *AdminResetPasswordInput) Validate() *apperrs.Response {
+	issuesMap := z.Struct(i.Shape()).Validate(i)
+	if issuesMap != nil {
+		return &apperrs.Response{
+			Code:   400,
+			Errors: FormatIssuesAsErrorDetails(issuesMap),
+		}
+	}
+	return nil
+}
+
+func (i *AdminResetPasswordInput) Shape() z.Shape {
+	return z.Shape{
+		"UserID":      z.String().Len(26).Required(),
+		"NewPassword": z.String().Required(),
+	}
+}
@@ -43,7 +43,7 @@ func (i *RegisterInput) Shape() z.Shape {
 	return z.Shape{
 		"Username": z.String().Min(3).Max(50).Required(),
 		"Email":    z.String().Email().Required(),
-		"Password": z.String().Min(8).Required(),
+		"Password": z.String().Required(),
 	}
 }
 
@@ -101,16 +101,23 @@ type AuthOutput struct {
 	AccessToken  string    `json:"access_token"`  // 访问令牌
 	RefreshToken string    `json:"refresh_token"` // 刷新令牌
 	ExpiresAt    int64     `json:"expires_at"`    // 过期时间戳
+
+	VerificationRequired bool   `json:"verification_required,omitempty"` // 是否需要验证邮箱后才能登录
+	MFARequired          bool   `json:"mfa_required,omitempty"`          // 是否需要完成两步验证后才能登录
+	MFAToken             string `json:"mfa_token,omitempty"`             // 两步验证挑战令牌
+	MFAExpiresAt         int64  `json:"mfa_expires_at,omitempty"`        // 挑战令牌过期时间戳
 }
 
 // UserInfo 用户信息
 type UserInfo struct {
-	ID          string     `json:"id"`            // 用户ID
-	Username    string     `json:"username"`      // 用户名
-	Email       string     `json:"email"`         // 邮箱
-	Status      string     `json:"status"`        // 状态
-	LastLoginAt *time.Time `json:"last_login_at"` // 最后登录时间
-	CreatedAt   time.Time  `json:"created_at"`    // 创建时间
+	ID               string     `json:"id"`                      // 用户ID
+	Username         string     `json:"username"`                // 用户名
+	Email            string     `json:"email"`                   // 邮箱
+	PendingEmail     string     `json:"pending_email,omitempty"` // 待确认的新邮箱
+	Status           string     `json:"status"`                  // 状态
+	TwoFactorEnabled bool       `json:"two_factor_enabled"`      // 是否已开启两步验证
+	LastLoginAt      *time.Time `json:"last_login_at"`           // 最后登录时间
+	CreatedAt        time.Time  `json:"created_at"`              // 创建时间
 }
 
 // JWTClaims JWT声明结构
@@ -118,6 +125,14 @@ type JWTClaims struct {
 	UserID    string `json:"user_id"`
 	Username  string `json:"username"`
 	Email     string `json:"email"`
-	TokenType string `json:"token_type"` // TokenTypeAccess 或 TokenTypeRefresh
+	TokenType string `json:"token_type"`          // TokenTypeAccess 或 TokenTypeRefresh
+	ClientID  string `json:"client_id,omitempty"` // 通过OAuth授权签发时的客户端ID
+	Scope     string `json:"scope,omitempty"`     // 通过OAuth授权签发时的授权范围，以空格分隔
+	Actor     *Actor `json:"act,omitempty"`       // 管理员代为登录时的实际操作者（RFC 8693）
 	jwt.RegisteredClaims
 }
+
+// Actor 代替令牌主体执行操作的实际操作者
+type Actor struct {
+	Subject string `json:"sub"` // 实际操作者的用户ID
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	z "github.com/Oudwins/zog"
+
+	"github.com/liukeshao/echo-template/pkg/apperrs"
+)
+
+// EmailChangeTokenInput 确认或撤销修改邮箱输入
+type EmailChangeTokenInput struct {
+	Token string `json:"token"` // 邮件中的令牌
+}
+
+// Validate 验证确认或撤销修改邮箱输入
+func (i *EmailChangeTokenInput) Validate() *apperrs.Response {
+	issuesMap := z.Struct(i.Shape()).Validate(i)
+	if issuesMap != nil {
+		return &apperrs.Response{
+			Code:   400,
+			Errors: FormatIssuesAsErrorDetails(issuesMap),
+		}
+	}
+	return nil
+}
+
+func (i *EmailChangeTokenInput) Shape() z.Shape {
+	return z.Shape{
+		"Token": z.String().Max(128).Required(),
+	}
+}
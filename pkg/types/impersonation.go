@@ -0,0 +1,111 @@
+package types
+
+import (
+	"time"
+
+	z "github.com/Oudwins/zog"
+
+	"github.com/liukeshao/echo-template/pkg/apperrs"
+)
+
+// ImpersonateInput 管理员代为登录用户账户输入
+type ImpersonateInput struct {
+	UserID string `param:"id"`    // 被代为登录的用户ID
+	Reason string `json:"reason"` // 代为登录的原因，例如工单编号
+}
+
+// Validate 验证代为登录输入
+func (i *ImpersonateInput) Validate() *apperrs.Response {
+	issuesMap := z.Struct(i.Shape()).Validate(i)
+	if issuesMap != nil {
+		return &apperrs.Response{
+			Code:   400,
+			Errors: FormatIssuesAsErrorDetails(issuesMap),
+		}
+	}
+	return nil
+}
+
+func (i *ImpersonateInput) Shape() z.Shape {
+	return z.Shape{
+		"UserID": z.String().Len(26).Required(),
+		"Reason": z.String().Trim().Min(1).Max(500).Required(),
+	}
+}
+
+// ImpersonateOutput 代为登录输出，只签发短期访问令牌，不签发刷新令牌
+type ImpersonateOutput struct {
+	ID          string    `json:"id"`           // 代为登录记录ID
+	User        *UserInfo `json:"user"`         // 被代为登录的用户
+	AccessToken string    `json:"access_token"` // 标记了实际操作者的访问令牌
+	ExpiresAt   int64     `json:"expires_at"`   // 过期时间戳
+}
+
+// ImpersonationInfo 代为登录审计记录
+type ImpersonationInfo struct {
+	ID        string     `json:"id"`         // 记录ID
+	AdminID   string     `json:"admin_id"`   // 发起代为登录的管理员ID
+	UserID    string     `json:"user_id"`    // 被代为登录的用户ID
+	Reason    string     `json:"reason"`     // 代为登录原因
+	IP        string     `json:"ip"`         // 管理员的IP地址
+	UserAgent string     `json:"user_agent"` // 管理员的设备/浏览器信息
+	StartedAt time.Time  `json:"started_at"` // 开始时间
+	ExpiresAt time.Time  `json:"expires_at"` // 令牌过期时间
+	EndedAt   *time.Time `json:"ended_at"`   // 主动结束时间，为空表示未主动结束
+}
+
+// ListImpersonationsInput 代为登录审计记录列表输入
+type ListImpersonationsInput struct {
+	PageInput
+	AdminID string `query:"admin_id"` // 按管理员筛选
+	UserID  string `query:"user_id"`  // 按用户筛选
+}
+
+// Validate 验证代为登录审计记录列表输入
+func (i *ListImpersonationsInput) Validate() *apperrs.Response {
+	issuesMap := z.Struct(i.Shape()).Validate(i)
+	if issuesMap != nil {
+		return &apperrs.Response{
+			Code:   400,
+			Errors: FormatIssuesAsErrorDetails(issuesMap),
+		}
+	}
+	return nil
+}
+
+func (i *ListImpersonationsInput) Shape() z.Shape {
+	return z.Shape{
+		"PageInput": z.Ptr(z.Struct(i.PageInput.Shape())),
+		"AdminID":   z.String().Optional(),
+		"UserID":    z.String().Optional(),
+	}
+}
+
+// ListImpersonationsOutput 代为登录审计记录列表输出
+type ListImpersonationsOutput struct {
+	Impersonations []*ImpersonationInfo `json:"impersonations"` // 审计记录，按开始时间倒序
+	PageOutput
+}
+
+// EndImpersonationInput 结束代为登录输入
+type EndImpersonationInput struct {
+	ID string `param:"id"` // 代为登录记录ID
+}
+
+// Validate 验证结束代为登录输入
+func (i *EndImpersonationInput) Validate() *apperrs.Response {
+	issuesMap := z.Struct(i.Shape()).Validate(i)
+	if issuesMap != nil {
+		return &apperrs.Response{
+			Code:   400,
+			Errors: FormatIssuesAsErrorDetails(issuesMap),
+		}
+	}
+	return nil
+}
+
+func (i *EndImpersonationInput) Shape() z.Shape {
+	return z.Shape{
+		"ID": z.String().Len(26).Required(),
+	}
+}
@@ -0,0 +1,19 @@
+package types
+
+// JWK 单个JSON Web Key（RFC 7517），仅包含公钥参数
+type JWK struct {
+	Kty string `json:"kty"`           // 密钥类型：RSA/EC/OKP
+	Kid string `json:"kid"`           // 密钥ID
+	Use string `json:"use"`           // 用途，固定为 sig
+	Alg string `json:"alg"`           // 签名算法
+	N   string `json:"n,omitempty"`   // RSA 模数
+	E   string `json:"e,omitempty"`   // RSA 指数
+	Crv string `json:"crv,omitempty"` // EC/OKP 曲线
+	X   string `json:"x,omitempty"`   // EC/OKP 公钥 X 坐标
+	Y   string `json:"y,omitempty"`   // EC 公钥 Y 坐标
+}
+
+// JWKSOutput JSON Web Key Set 输出
+type JWKSOutput struct {
+	Keys []JWK `json:"keys"` // 公钥列表
+}
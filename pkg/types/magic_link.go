@@ -0,0 +1,61 @@
+package types
+
+import (
+	z "github.com/Oudwins/zog"
+
+	"github.com/liukeshao/echo-template/pkg/apperrs"
+)
+
+// MagicLinkInput 申请邮件登录链接输入
+type MagicLinkInput struct {
+	Email string `json:"email"` // 邮箱
+}
+
+// Validate 验证申请邮件登录链接输入
+func (i *MagicLinkInput) Validate() *apperrs.Response {
+	issuesMap := z.Struct(i.Shape()).Validate(i)
+	if issuesMap != nil {
+		return &apperrs.Response{
+			Code:   400,
+			Errors: FormatIssuesAsErrorDetails(issuesMap),
+		}
+	}
+	return nil
+}
+
+func (i *MagicLinkInput) Shape() z.Shape {
+	return z.Shape{
+		"Email": z.String().Email().Required(),
+	}
+}
+
+// MagicLinkOutput 申请邮件登录链接输出，无论邮箱是否注册都返回相同的结构
+type MagicLinkOutput struct {
+	Nonce     string `json:"nonce"`      // 客户端随机值，需在发起请求的浏览器中保存，打开链接时一并提交
+	ExpiresAt int64  `json:"expires_at"` // 链接过期时间
+}
+
+// MagicLinkVerifyInput 使用邮件登录链接登录输入
+type MagicLinkVerifyInput struct {
+	Token string `json:"token"` // 邮件链接中的令牌
+	Nonce string `json:"nonce"` // 申请链接时返回的客户端随机值
+}
+
+// Validate 验证使用邮件登录链接登录输入
+func (i *MagicLinkVerifyInput) Validate() *apperrs.Response {
+	issuesMap := z.Struct(i.Shape()).Validate(i)
+	if issuesMap != nil {
+		return &apperrs.Response{
+			Code:   400,
+			Errors: FormatIssuesAsErrorDetails(issuesMap),
+		}
+	}
+	return nil
+}
+
+func (i *MagicLinkVerifyInput) Shape() z.Shape {
+	return z.Shape{
+		"Token": z.String().Max(128).Required(),
+		"Nonce": z.String().Max(128).Required(),
+	}
+}
@@ -53,7 +53,7 @@ func (i *ChangePasswordInput) Shape() z.Shape {
 
 	return z.Shape{
 		"OldPassword": z.String().Required(),
-		"NewPassword": z.String().Min(8).Required(),
+		"NewPassword": z.String().Required(),
 	}
 }
 
@@ -102,3 +102,43 @@ func (i *UpdateEmailInput) Shape() z.Shape {
 		"Email": z.String().Email().Required(),
 	}
 }
+
+// ReauthenticateInput 重新验证身份输入，提供当前密码或两步验证码之一
+type ReauthenticateInput struct {
+	Password string `json:"password,omitempty"` // 当前密码
+	Code     string `json:"code,omitempty"`     // 两步验证码或恢复码，仅开启两步验证的用户可用
+}
+
+// Validate 验证重新验证身份输入
+func (i *ReauthenticateInput) Validate() *apperrs.Response {
+	issuesMap := z.Struct(i.Shape()).Validate(i)
+	if issuesMap != nil {
+		return &apperrs.Response{
+			Code:   400,
+			Errors: FormatIssuesAsErrorDetails(issuesMap),
+		}
+	}
+	if i.Password == "" && i.Code == "" {
+		return &apperrs.Response{
+			Code: 400,
+			Errors: []*apperrs.ErrorDetail{{
+				Location: "Password",
+				Message:  "请输入当前密码或两步验证码",
+			}},
+		}
+	}
+	return nil
+}
+
+func (i *ReauthenticateInput) Shape() z.Shape {
+	return z.Shape{
+		"Password": z.String().Optional(),
+		"Code":     z.String().Max(32).Optional(),
+	}
+}
+
+// ReauthenticateOutput 重新验证身份输出
+type ReauthenticateOutput struct {
+	AuthTime   int64 `json:"auth_time"`   // 完成认证的时间戳
+	ValidUntil int64 `json:"valid_until"` // 在此时间戳之前可以执行敏感操作
+}
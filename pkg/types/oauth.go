@@ -0,0 +1,214 @@
+package types
+
+import (
+	"time"
+
+	z "github.com/Oudwins/zog"
+
+	"github.com/liukeshao/echo-template/pkg/apperrs"
+)
+
+// OAuth 授权模式
+const (
+	GrantTypeAuthorizationCode = "authorization_code"
+	GrantTypeRefreshToken      = "refresh_token"
+	GrantTypeClientCredentials = "client_credentials"
+)
+
+// OAuthGrantTypes 返回客户端可以注册的授权模式
+func OAuthGrantTypes() []string {
+	return []string{GrantTypeAuthorizationCode, GrantTypeClientCredentials}
+}
+
+// CreateOAuthClientInput 注册OAuth客户端输入
+type CreateOAuthClientInput struct {
+	Name         string   `json:"name"`          // 客户端名称
+	Public       bool     `json:"public"`        // 是否为无法保存密钥的公开客户端（如单页应用、移动应用）
+	RedirectURIs []string `json:"redirect_uris"` // 授权回调地址
+	GrantTypes   []string `json:"grant_types"`   // 授权模式
+	Scopes       []string `json:"scopes"`        // 允许申请的授权范围
+}
+
+// Validate 验证注册OAuth客户端输入
+func (i *CreateOAuthClientInput) Validate() *apperrs.Response {
+	issuesMap := z.Struct(i.Shape()).Validate(i)
+	if issuesMap != nil {
+		return &apperrs.Response{
+			Code:   400,
+			Errors: FormatIssuesAsErrorDetails(issuesMap),
+		}
+	}
+	return nil
+}
+
+func (i *CreateOAuthClientInput) Shape() z.Shape {
+	return z.Shape{
+		"Name":         z.String().Min(1).Max(100).Required(),
+		"RedirectURIs": z.Slice(z.String().URL().Max(2048)).Max(10),
+		"GrantTypes":   z.Slice(z.String().OneOf(OAuthGrantTypes())).Min(1).Required(),
+		"Scopes":       z.Slice(z.String().OneOf(Scopes())).Min(1).Required(),
+	}
+}
+
+// OAuthClientInfo OAuth客户端信息
+type OAuthClientInfo struct {
+	ID           string    `json:"client_id"`     // 客户端ID
+	Name         string    `json:"name"`          // 客户端名称
+	Public       bool      `json:"public"`        // 是否为公开客户端
+	RedirectURIs []string  `json:"redirect_uris"` // 授权回调地址
+	GrantTypes   []string  `json:"grant_types"`   // 授权模式
+	Scopes       []string  `json:"scopes"`        // 允许申请的授权范围
+	CreatedAt    time.Time `json:"created_at"`    // 注册时间
+}
+
+// CreateOAuthClientOutput 注册OAuth客户端输出
+type CreateOAuthClientOutput struct {
+	Client       *OAuthClientInfo `json:"client"`                  // 客户端信息
+	ClientSecret string           `json:"client_secret,omitempty"` // 客户端密钥，仅在注册时返回一次
+}
+
+// ListOAuthClientsOutput OAuth客户端列表输出
+type ListOAuthClientsOutput struct {
+	Clients []*OAuthClientInfo `json:"clients"` // 客户端列表
+}
+
+// DeleteOAuthClientInput 删除OAuth客户端输入
+type DeleteOAuthClientInput struct {
+	ID string `param:"id"` // 客户端ID
+}
+
+// Validate 验证删除OAuth客户端输入
+func (i *DeleteOAuthClientInput) Validate() *apperrs.Response {
+	issuesMap := z.Struct(i.Shape()).Validate(i)
+	if issuesMap != nil {
+		return &apperrs.Response{
+			Code:   400,
+			Errors: FormatIssuesAsErrorDetails(issuesMap),
+		}
+	}
+	return nil
+}
+
+func (i *DeleteOAuthClientInput) Shape() z.Shape {
+	return z.Shape{
+		"ID": z.String().Len(26).Required(),
+	}
+}
+
+// OAuthAuthorizeInput 授权请求（RFC 6749 第4.1.1节），授权确认页面原样转发客户端的请求参数
+type OAuthAuthorizeInput struct {
+	ResponseType        string `query:"response_type" json:"response_type"`                 // 固定为 code
+	ClientID            string `query:"client_id" json:"client_id"`                         // 客户端ID
+	RedirectURI         string `query:"redirect_uri" json:"redirect_uri"`                   // 授权回调地址
+	Scope               string `query:"scope" json:"scope"`                                 // 申请的授权范围，以空格分隔，为空时申请客户端的全部范围
+	State               string `query:"state" json:"state"`                                 // 客户端状态，原样带回回调地址
+	CodeChallenge       string `query:"code_challenge" json:"code_challenge"`               // PKCE 挑战值
+	CodeChallengeMethod string `query:"code_challenge_method" json:"code_challenge_method"` // 固定为 S256
+}
+
+// Validate 验证授权请求
+func (i *OAuthAuthorizeInput) Validate() *apperrs.Response {
+	issuesMap := z.Struct(i.Shape()).Validate(i)
+	if issuesMap != nil {
+		return &apperrs.Response{
+			Code:   400,
+			Errors: FormatIssuesAsErrorDetails(issuesMap),
+		}
+	}
+	return nil
+}
+
+func (i *OAuthAuthorizeInput) Shape() z.Shape {
+	return z.Shape{
+		"ResponseType":        z.String().OneOf([]string{"code"}).Required(),
+		"ClientID":            z.String().Len(26).Required(),
+		"RedirectURI":         z.String().Max(2048).Required(),
+		"Scope":               z.String().Max(1024).Optional(),
+		"State":               z.String().Max(512).Optional(),
+		"CodeChallenge":       z.String().Min(43).Max(128).Required(),
+		"CodeChallengeMethod": z.String().OneOf([]string{"S256"}).Required(),
+	}
+}
+
+// OAuthAuthorizeOutput 授权确认页面展示的信息
+type OAuthAuthorizeOutput struct {
+	Client      *OAuthClientInfo `json:"client"`       // 申请授权的客户端
+	Scopes      []string         `json:"scopes"`       // 申请的授权范围
+	RedirectURI string           `json:"redirect_uri"` // 授权回调地址
+}
+
+// OAuthConsentInput 用户确认或拒绝授权
+type OAuthConsentInput struct {
+	OAuthAuthorizeInput
+	Approve bool `json:"approve"` // 是否同意授权
+}
+
+// OAuthConsentOutput 用户确认授权后的跳转地址
+type OAuthConsentOutput struct {
+	RedirectURI string `json:"redirect_uri"` // 带有授权码或错误信息的回调地址
+}
+
+// OAuthTokenInput 令牌请求（RFC 6749 第4.1.3、4.4.2、6节）
+// 令牌端点按 RFC 6749 返回错误，参数由服务层校验
+type OAuthTokenInput struct {
+	GrantType    string `form:"grant_type"`    // 授权模式
+	Code         string `form:"code"`          // 授权码
+	RedirectURI  string `form:"redirect_uri"`  // 授权请求中的回调地址
+	CodeVerifier string `form:"code_verifier"` // PKCE 校验值
+	RefreshToken string `form:"refresh_token"` // 刷新令牌
+	Scope        string `form:"scope"`         // 客户端凭据模式申请的授权范围
+	ClientID     string `form:"client_id"`     // 客户端ID，未使用 HTTP Basic 认证时提供
+	ClientSecret string `form:"client_secret"` // 客户端密钥，未使用 HTTP Basic 认证时提供
+}
+
+// OAuthTokenOutput 令牌响应（RFC 6749 第5.1节）
+type OAuthTokenOutput struct {
+	AccessToken  string `json:"access_token"`            // 访问令牌
+	TokenType    string `json:"token_type"`              // 固定为 Bearer
+	ExpiresIn    int64  `json:"expires_in"`              // 访问令牌剩余有效秒数
+	RefreshToken string `json:"refresh_token,omitempty"` // 刷新令牌，客户端凭据模式不签发
+	Scope        string `json:"scope,omitempty"`         // 授予的授权范围
+}
+
+// OAuthTokenActionInput 令牌内省（RFC 7662）与撤销（RFC 7009）请求
+type OAuthTokenActionInput struct {
+	Token         string `form:"token"`           // 要内省或撤销的令牌
+	TokenTypeHint string `form:"token_type_hint"` // 令牌类型提示：access_token 或 refresh_token
+	ClientID      string `form:"client_id"`       // 客户端ID，未使用 HTTP Basic 认证时提供
+	ClientSecret  string `form:"client_secret"`   // 客户端密钥，未使用 HTTP Basic 认证时提供
+}
+
+// OAuthIntrospectionOutput 令牌内省响应（RFC 7662 第2.2节）
+type OAuthIntrospectionOutput struct {
+	Active    bool   `json:"active"`               // 令牌是否有效
+	Scope     string `json:"scope,omitempty"`      // 授权范围
+	ClientID  string `json:"client_id,omitempty"`  // 客户端ID
+	Username  string `json:"username,omitempty"`   // 授权用户的用户名
+	TokenType string `json:"token_type,omitempty"` // 令牌类型
+	Exp       int64  `json:"exp,omitempty"`        // 过期时间
+	Iat       int64  `json:"iat,omitempty"`        // 签发时间
+	Sub       string `json:"sub,omitempty"`        // 授权用户ID，客户端凭据模式为客户端ID
+	Iss       string `json:"iss,omitempty"`        // 签发者
+	Jti       string `json:"jti,omitempty"`        // 令牌ID
+}
+
+// OAuthErrorOutput OAuth错误响应（RFC 6749 第5.2节）
+type OAuthErrorOutput struct {
+	Error            string `json:"error"`                       // 错误码
+	ErrorDescription string `json:"error_description,omitempty"` // 错误描述
+}
+
+// OAuthServerMetadata 授权服务元数据（RFC 8414）
+type OAuthServerMetadata struct {
+	Issuer                            string   `json:"issuer"`
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	IntrospectionEndpoint             string   `json:"introspection_endpoint"`
+	RevocationEndpoint                string   `json:"revocation_endpoint"`
+	JWKSURI                           string   `json:"jwks_uri"`
+	ScopesSupported                   []string `json:"scopes_supported"`
+	ResponseTypesSupported            []string `json:"response_types_supported"`
+	GrantTypesSupported               []string `json:"grant_types_supported"`
+	CodeChallengeMethodsSupported     []string `json:"code_challenge_methods_supported"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+}
@@ -0,0 +1,87 @@
+package types
+
+import (
+	z "github.com/Oudwins/zog"
+
+	"github.com/liukeshao/echo-template/pkg/apperrs"
+)
+
+// OIDCProvidersOutput 身份提供方列表输出
+type OIDCProvidersOutput struct {
+	Providers []string `json:"providers"` // 已配置的身份提供方名称
+}
+
+// OIDCLoginInput 发起外部账户登录输入
+type OIDCLoginInput struct {
+	Provider string `param:"provider"` // 身份提供方名称
+}
+
+// Validate 验证发起外部账户登录输入
+func (i *OIDCLoginInput) Validate() *apperrs.Response {
+	issuesMap := z.Struct(i.Shape()).Validate(i)
+	if issuesMap != nil {
+		return &apperrs.Response{
+			Code:   400,
+			Errors: FormatIssuesAsErrorDetails(issuesMap),
+		}
+	}
+	return nil
+}
+
+func (i *OIDCLoginInput) Shape() z.Shape {
+	return z.Shape{
+		"Provider": z.String().Max(64).Required(),
+	}
+}
+
+// OIDCCallbackInput 身份提供方回调输入
+type OIDCCallbackInput struct {
+	Provider         string `param:"provider"`          // 身份提供方名称
+	Code             string `query:"code"`              // 授权码
+	State            string `query:"state"`             // 发起登录时生成的state
+	Error            string `query:"error"`             // 身份提供方返回的错误码
+	ErrorDescription string `query:"error_description"` // 身份提供方返回的错误描述
+}
+
+// Validate 验证身份提供方回调输入
+func (i *OIDCCallbackInput) Validate() *apperrs.Response {
+	issuesMap := z.Struct(i.Shape()).Validate(i)
+	if issuesMap != nil {
+		return &apperrs.Response{
+			Code:   400,
+			Errors: FormatIssuesAsErrorDetails(issuesMap),
+		}
+	}
+	return nil
+}
+
+func (i *OIDCCallbackInput) Shape() z.Shape {
+	return z.Shape{
+		"Provider": z.String().Max(64).Required(),
+		"Code":     z.String().Max(2048).Optional(),
+		"State":    z.String().Max(128).Required(),
+	}
+}
+
+// OIDCExchangeInput 使用登录码换取令牌输入
+type OIDCExchangeInput struct {
+	Code string `json:"code"` // 回调跳转到前端页面时附带的一次性登录码
+}
+
+// Validate 验证使用登录码换取令牌输入
+func (i *OIDCExchangeInput) Validate() *apperrs.Response {
+	issuesMap := z.Struct(i.Shape()).Validate(i)
+	if issuesMap != nil {
+		return &apperrs.Response{
+			Code:   400,
+			Errors: FormatIssuesAsErrorDetails(issuesMap),
+		}
+	}
+	return nil
+}
+
+func (i *OIDCExchangeInput) Shape() z.Shape {
+	return z.Shape{
+		"Code": z.String().Max(128).Required(),
+	}
+}
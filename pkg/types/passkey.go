@@ -0,0 +1,128 @@
+package types
+
+import (
+	"encoding/json"
+	"time"
+
+	z "github.com/Oudwins/zog"
+
+	"github.com/liukeshao/echo-template/pkg/apperrs"
+)
+
+// PasskeyBeginOutput 通行密钥注册或登录流程开始输出
+type PasskeyBeginOutput struct {
+	SessionToken string `json:"session_token"` // 完成流程时需要回传的会话令牌
+	Options      any    `json:"options"`       // 传给 navigator.credentials.create/get 的参数
+}
+
+// PasskeyRegisterFinishInput 完成通行密钥注册输入
+type PasskeyRegisterFinishInput struct {
+	SessionToken string          `json:"session_token"` // 开始注册时返回的会话令牌
+	Name         string          `json:"name"`          // 凭据名称
+	Credential   json.RawMessage `json:"credential"`    // navigator.credentials.create 返回的凭据
+}
+
+// Validate 验证完成通行密钥注册输入
+func (i *PasskeyRegisterFinishInput) Validate() *apperrs.Response {
+	issuesMap := z.Struct(i.Shape()).Validate(i)
+	if issuesMap != nil {
+		return &apperrs.Response{
+			Code:   400,
+			Errors: FormatIssuesAsErrorDetails(issuesMap),
+		}
+	}
+	return nil
+}
+
+func (i *PasskeyRegisterFinishInput) Shape() z.Shape {
+	return z.Shape{
+		"SessionToken": z.String().Max(128).Required(),
+		"Name":         z.String().Max(64).Optional(),
+	}
+}
+
+// PasskeyLoginFinishInput 完成通行密钥登录输入
+type PasskeyLoginFinishInput struct {
+	SessionToken string          `json:"session_token"` // 开始登录时返回的会话令牌
+	Credential   json.RawMessage `json:"credential"`    // navigator.credentials.get 返回的断言
+}
+
+// Validate 验证完成通行密钥登录输入
+func (i *PasskeyLoginFinishInput) Validate() *apperrs.Response {
+	issuesMap := z.Struct(i.Shape()).Validate(i)
+	if issuesMap != nil {
+		return &apperrs.Response{
+			Code:   400,
+			Errors: FormatIssuesAsErrorDetails(issuesMap),
+		}
+	}
+	return nil
+}
+
+func (i *PasskeyLoginFinishInput) Shape() z.Shape {
+	return z.Shape{
+		"SessionToken": z.String().Max(128).Required(),
+	}
+}
+
+// PasskeyInfo 通行密钥信息
+type PasskeyInfo struct {
+	ID         string     `json:"id"`           // 凭据记录ID
+	Name       string     `json:"name"`         // 凭据名称
+	Synced     bool       `json:"synced"`       // 是否已同步到其他设备
+	CreatedAt  time.Time  `json:"created_at"`   // 注册时间
+	LastUsedAt *time.Time `json:"last_used_at"` // 最后使用时间
+}
+
+// ListPasskeysOutput 通行密钥列表输出
+type ListPasskeysOutput struct {
+	Passkeys []*PasskeyInfo `json:"passkeys"` // 通行密钥列表
+}
+
+// RenamePasskeyInput 重命名通行密钥输入
+type RenamePasskeyInput struct {
+	ID   string `param:"id"`  // 凭据记录ID
+	Name string `json:"name"` // 新名称
+}
+
+// Validate 验证重命名通行密钥输入
+func (i *RenamePasskeyInput) Validate() *apperrs.Response {
+	issuesMap := z.Struct(i.Shape()).Validate(i)
+	if issuesMap != nil {
+		return &apperrs.Response{
+			Code:   400,
+			Errors: FormatIssuesAsErrorDetails(issuesMap),
+		}
+	}
+	return nil
+}
+
+func (i *RenamePasskeyInput) Shape() z.Shape {
+	return z.Shape{
+		"ID":   z.String().Len(26).Required(),
+		"Name": z.String().Min(1).Max(64).Required(),
+	}
+}
+
+// DeletePasskeyInput 删除通行密钥输入
+type DeletePasskeyInput struct {
+	ID string `param:"id"` // 凭据记录ID
+}
+
+// Validate 验证删除通行密钥输入
+func (i *DeletePasskeyInput) Validate() *apperrs.Response {
+	issuesMap := z.Struct(i.Shape()).Validate(i)
+	if issuesMap != nil {
+		return &apperrs.Response{
+			Code:   400,
+			Errors: FormatIssuesAsErrorDetails(issuesMap),
+		}
+	}
+	return nil
+}
+
+func (i *DeletePasskeyInput) Shape() z.Shape {
+	return z.Shape{
+		"ID": z.String().Len(26).Required(),
+	}
+}
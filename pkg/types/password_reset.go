@@ -0,0 +1,55 @@
+package types
+
+import (
+	z "github.com/Oudwins/zog"
+
+	"github.com/liukeshao/echo-template/pkg/apperrs"
+)
+
+// ForgotPasswordInput 忘记密码输入
+type ForgotPasswordInput struct {
+	Email string `json:"email"` // 邮箱
+}
+
+// Validate 验证忘记密码输入
+func (i *ForgotPasswordInput) Validate() *apperrs.Response {
+	issuesMap := z.Struct(i.Shape()).Validate(i)
+	if issuesMap != nil {
+		return &apperrs.Response{
+			Code:   400,
+			Errors: FormatIssuesAsErrorDetails(issuesMap),
+		}
+	}
+	return nil
+}
+
+func (i *ForgotPasswordInput) Shape() z.Shape {
+	return z.Shape{
+		"Email": z.String().Email().Required(),
+	}
+}
+
+// ResetPasswordInput 重置密码输入
+type ResetPasswordInput struct {
+	Token       string `json:"token"`        // 重置邮件中的令牌
+	NewPassword string `json:"new_password"` // 新密码
+}
+
+// Validate 验证重置密码输入
+func (i *ResetPasswordInput) Validate() *apperrs.Response {
+	issuesMap := z.Struct(i.Shape()).Validate(i)
+	if issuesMap != nil {
+		return &apperrs.Response{
+			Code:   400,
+			Errors: FormatIssuesAsErrorDetails(issuesMap),
+		}
+	}
+	return nil
+}
+
+func (i *ResetPasswordInput) Shape() z.Shape {
+	return z.Shape{
+		"Token":       z.String().Max(128).Required(),
+		"NewPassword": z.String().Required(),
+	}
+}
@@ -0,0 +1,81 @@
+package types
+
+import (
+	"time"
+
+	z "github.com/Oudwins/zog"
+
+	"github.com/liukeshao/echo-template/pkg/apperrs"
+)
+
+// CreatePersonalAccessTokenInput 创建个人访问令牌输入
+type CreatePersonalAccessTokenInput struct {
+	Name          string   `json:"name"`            // 令牌名称
+	Scopes        []string `json:"scopes"`          // 授权范围
+	ExpiresInDays int      `json:"expires_in_days"` // 有效天数，为0时永不过期
+}
+
+// Validate 验证创建个人访问令牌输入
+func (i *CreatePersonalAccessTokenInput) Validate() *apperrs.Response {
+	issuesMap := z.Struct(i.Shape()).Validate(i)
+	if issuesMap != nil {
+		return &apperrs.Response{
+			Code:   400,
+			Errors: FormatIssuesAsErrorDetails(issuesMap),
+		}
+	}
+	return nil
+}
+
+func (i *CreatePersonalAccessTokenInput) Shape() z.Shape {
+	return z.Shape{
+		"Name":          z.String().Min(1).Max(64).Required(),
+		"Scopes":        z.Slice(z.String().OneOf(Scopes())).Min(1).Required(),
+		"ExpiresInDays": z.Int().GTE(1).LTE(3650).Optional(),
+	}
+}
+
+// PersonalAccessTokenInfo 个人访问令牌信息
+type PersonalAccessTokenInfo struct {
+	ID         string     `json:"id"`           // 令牌ID
+	Name       string     `json:"name"`         // 令牌名称
+	Prefix     string     `json:"prefix"`       // 令牌开头的字符，用于识别令牌
+	Scopes     []string   `json:"scopes"`       // 授权范围
+	ExpiresAt  *time.Time `json:"expires_at"`   // 过期时间，为空表示永不过期
+	LastUsedAt *time.Time `json:"last_used_at"` // 最后使用时间
+	CreatedAt  time.Time  `json:"created_at"`   // 创建时间
+}
+
+// CreatePersonalAccessTokenOutput 创建个人访问令牌输出
+type CreatePersonalAccessTokenOutput struct {
+	Token string                   `json:"token"` // 令牌，仅在创建时返回一次
+	Info  *PersonalAccessTokenInfo `json:"info"`  // 令牌信息
+}
+
+// ListPersonalAccessTokensOutput 个人访问令牌列表输出
+type ListPersonalAccessTokensOutput struct {
+	Tokens []*PersonalAccessTokenInfo `json:"tokens"` // 未撤销的令牌列表
+}
+
+// RevokePersonalAccessTokenInput 撤销个人访问令牌输入
+type RevokePersonalAccessTokenInput struct {
+	ID string `param:"id"` // 令牌ID
+}
+
+// Validate 验证撤销个人访问令牌输入
+func (i *RevokePersonalAccessTokenInput) Validate() *apperrs.Response {
+	issuesMap := z.Struct(i.Shape()).Validate(i)
+	if issuesMap != nil {
+		return &apperrs.Response{
+			Code:   400,
+			Errors: FormatIssuesAsErrorDetails(issuesMap),
+		}
+	}
+	return nil
+}
+
+func (i *RevokePersonalAccessTokenInput) Shape() z.Shape {
+	return z.Shape{
+		"ID": z.String().Len(26).Required(),
+	}
+}
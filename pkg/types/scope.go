@@ -0,0 +1,12 @@
+package types
+
+// 授权范围，个人访问令牌和第三方应用令牌只能访问声明了对应授权范围的接口
+const (
+	ScopeProfileRead  = "profile:read"  // 读取账户资料
+	ScopeProfileWrite = "profile:write" // 修改账户资料
+)
+
+// Scopes 返回所有可授予个人访问令牌和第三方应用的授权范围
+func Scopes() []string {
+	return []string{ScopeProfileRead, ScopeProfileWrite}
+}
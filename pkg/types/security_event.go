@@ -0,0 +1,85 @@
+package types
+
+import (
+	"time"
+
+	z "github.com/Oudwins/zog"
+
+	"github.com/liukeshao/echo-template/pkg/apperrs"
+)
+
+// 安全事件类型常量
+const (
+	SecurityEventLoginSucceeded       = "login_succeeded"        // 登录成功
+	SecurityEventLoginFailed          = "login_failed"           // 登录失败
+	SecurityEventTokenRefreshed       = "token_refreshed"        // 刷新令牌
+	SecurityEventLogout               = "logout"                 // 退出登录
+	SecurityEventPasswordChanged      = "password_changed"       // 修改或重置密码
+	SecurityEventEmailChangeRequested = "email_change_requested" // 申请修改邮箱
+	SecurityEventEmailChanged         = "email_changed"          // 确认修改邮箱
+	SecurityEventEmailChangeReverted  = "email_change_reverted"  // 撤销修改邮箱
+	SecurityEventSessionRevoked       = "session_revoked"        // 撤销会话
+	SecurityEventTwoFactorEnabled     = "two_factor_enabled"     // 开启两步验证
+	SecurityEventTwoFactorDisabled    = "two_factor_disabled"    // 关闭两步验证
+	SecurityEventAccountDeleted       = "account_deleted"        // 注销账户
+)
+
+// SecurityEventTypes 返回所有安全事件类型
+func SecurityEventTypes() []string {
+	return []string{
+		SecurityEventLoginSucceeded,
+		SecurityEventLoginFailed,
+		SecurityEventTokenRefreshed,
+		SecurityEventLogout,
+		SecurityEventPasswordChanged,
+		SecurityEventEmailChangeRequested,
+		SecurityEventEmailChanged,
+		SecurityEventEmailChangeReverted,
+		SecurityEventSessionRevoked,
+		SecurityEventTwoFactorEnabled,
+		SecurityEventTwoFactorDisabled,
+		SecurityEventAccountDeleted,
+	}
+}
+
+// SecurityEventInfo 安全事件信息
+type SecurityEventInfo struct {
+	ID        string    `json:"id"`               // 事件ID
+	Type      string    `json:"type"`             // 事件类型
+	Detail    string    `json:"detail,omitempty"` // 补充说明
+	IP        string    `json:"ip"`               // 客户端IP
+	UserAgent string    `json:"user_agent"`       // 客户端User-Agent
+	RequestID string    `json:"request_id"`       // 请求ID，用于关联服务端日志
+	CreatedAt time.Time `json:"created_at"`       // 发生时间
+}
+
+// ListSecurityEventsInput 安全事件列表输入
+type ListSecurityEventsInput struct {
+	PageInput
+	Type string `query:"type"` // 按事件类型筛选
+}
+
+// Validate 验证安全事件列表输入
+func (i *ListSecurityEventsInput) Validate() *apperrs.Response {
+	issuesMap := z.Struct(i.Shape()).Validate(i)
+	if issuesMap != nil {
+		return &apperrs.Response{
+			Code:   400,
+			Errors: FormatIssuesAsErrorDetails(issuesMap),
+		}
+	}
+	return nil
+}
+
+func (i *ListSecurityEventsInput) Shape() z.Shape {
+	return z.Shape{
+		"PageInput": z.Ptr(z.Struct(i.PageInput.Shape())),
+		"Type":      z.String().OneOf(SecurityEventTypes()).Optional(),
+	}
+}
+
+// ListSecurityEventsOutput 安全事件列表输出
+type ListSecurityEventsOutput struct {
+	Events []*SecurityEventInfo `json:"events"` // 安全事件，按发生时间倒序
+	PageOutput
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"time"
+
+	z "github.com/Oudwins/zog"
+
+	"github.com/liukeshao/echo-template/pkg/apperrs"
+)
+
+// SessionInfo 登录会话信息
+type SessionInfo struct {
+	ID         string    `json:"id"`           // 会话ID
+	UserAgent  string    `json:"user_agent"`   // 设备/浏览器信息
+	IP         string    `json:"ip"`           // IP地址
+	CreatedAt  time.Time `json:"created_at"`   // 登录时间
+	LastUsedAt time.Time `json:"last_used_at"` // 最后活跃时间
+	Current    bool      `json:"current"`      // 是否为当前会话
+}
+
+// ListSessionsOutput 会话列表输出
+type ListSessionsOutput struct {
+	Sessions []*SessionInfo `json:"sessions"` // 会话列表
+}
+
+// RevokeSessionInput 撤销会话输入
+type RevokeSessionInput struct {
+	ID string `param:"id"` // 会话ID
+}
+
+// Validate 验证撤销会话输入
+func (i *RevokeSessionInput) Validate() *apperrs.Response {
+	issuesMap := z.Struct(i.Shape()).Validate(i)
+	if issuesMap != nil {
+		return &apperrs.Response{
+			Code:   400,
+			Errors: FormatIssuesAsErrorDetails(issuesMap),
+		}
+	}
+	return nil
+}
+
+func (i *RevokeSessionInput) Shape() z.Shape {
+	return z.Shape{
+		"ID": z.String().Len(26).Required(),
+	}
+}
+
+// RevokeSessionsOutput 批量撤销会话输出
+type RevokeSessionsOutput struct {
+	Revoked int `json:"revoked"` // 撤销的会话数量
+}
@@ -0,0 +1,97 @@
+package types
+
+import (
+	z "github.com/Oudwins/zog"
+
+	"github.com/liukeshao/echo-template/pkg/apperrs"
+)
+
+// TwoFactorStatusOutput 两步验证状态输出
+type TwoFactorStatusOutput struct {
+	Enabled                bool `json:"enabled"`                  // 是否已开启两步验证
+	RecoveryCodesRemaining int  `json:"recovery_codes_remaining"` // 剩余可用的恢复码数量
+}
+
+// TwoFactorSetupOutput 两步验证密钥输出
+type TwoFactorSetupOutput struct {
+	Secret          string `json:"secret"`           // base32编码的密钥，供无法扫码时手动输入
+	ProvisioningURI string `json:"provisioning_uri"` // otpauth:// 地址，用于生成二维码
+}
+
+// TwoFactorConfirmInput 确认开启两步验证输入
+type TwoFactorConfirmInput struct {
+	Code string `json:"code"` // 身份验证器应用中的验证码
+}
+
+// Validate 验证确认开启两步验证输入
+func (i *TwoFactorConfirmInput) Validate() *apperrs.Response {
+	issuesMap := z.Struct(i.Shape()).Validate(i)
+	if issuesMap != nil {
+		return &apperrs.Response{
+			Code:   400,
+			Errors: FormatIssuesAsErrorDetails(issuesMap),
+		}
+	}
+	return nil
+}
+
+func (i *TwoFactorConfirmInput) Shape() z.Shape {
+	return z.Shape{
+		"Code": z.String().Len(6).Required(),
+	}
+}
+
+// TwoFactorConfirmOutput 确认开启两步验证输出
+type TwoFactorConfirmOutput struct {
+	RecoveryCodes []string `json:"recovery_codes"` // 恢复码，仅在此时返回一次
+}
+
+// TwoFactorDisableInput 关闭两步验证输入
+type TwoFactorDisableInput struct {
+	Password string `json:"password"` // 当前密码
+	Code     string `json:"code"`     // 验证码或恢复码
+}
+
+// Validate 验证关闭两步验证输入
+func (i *TwoFactorDisableInput) Validate() *apperrs.Response {
+	issuesMap := z.Struct(i.Shape()).Validate(i)
+	if issuesMap != nil {
+		return &apperrs.Response{
+			Code:   400,
+			Errors: FormatIssuesAsErrorDetails(issuesMap),
+		}
+	}
+	return nil
+}
+
+func (i *TwoFactorDisableInput) Shape() z.Shape {
+	return z.Shape{
+		"Password": z.String().Required(),
+		"Code":     z.String().Max(32).Required(),
+	}
+}
+
+// LoginTwoFactorInput 两步验证登录输入
+type LoginTwoFactorInput struct {
+	MFAToken string `json:"mfa_token"` // 密码验证通过后返回的挑战令牌
+	Code     string `json:"code"`      // 验证码或恢复码
+}
+
+// Validate 验证两步验证登录输入
+func (i *LoginTwoFactorInput) Validate() *apperrs.Response {
+	issuesMap := z.Struct(i.Shape()).Validate(i)
+	if issuesMap != nil {
+		return &apperrs.Response{
+			Code:   400,
+			Errors: FormatIssuesAsErrorDetails(issuesMap),
+		}
+	}
+	return nil
+}
+
+func (i *LoginTwoFactorInput) Shape() z.Shape {
+	return z.Shape{
+		"MFAToken": z.String().Max(128).Required(),
+		"Code":     z.String().Max(32).Required(),
+	}
+}
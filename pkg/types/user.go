@@ -18,6 +18,17 @@ func UserStatuses() []string {
 	return []string{UserStatusActive, UserStatusInactive, UserStatusSuspended}
 }
 
+// 用户角色常量
+const (
+	UserRoleUser  = "user"
+	UserRoleAdmin = "admin"
+)
+
+// UserRoles 返回所有用户角色值
+func UserRoles() []string {
+	return []string{UserRoleUser, UserRoleAdmin}
+}
+
 // CreateUserInput 创建用户输入
 type CreateUserInput struct {
 	Username string `json:"username"`         // 用户名
@@ -43,7 +54,7 @@ func (i *CreateUserInput) Shape() z.Shape {
 	return z.Shape{
 		"Username": z.String().Min(3).Max(50).Required(),
 		"Email":    z.String().Email().Required(),
-		"Password": z.String().Min(8).Required(),
+		"Password": z.String().Required(),
 		"Status":   z.String().OneOf(UserStatuses()).Optional(),
 	}
 }
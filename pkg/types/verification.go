@@ -0,0 +1,53 @@
+package types
+
+import (
+	z "github.com/Oudwins/zog"
+
+	"github.com/liukeshao/echo-template/pkg/apperrs"
+)
+
+// VerifyEmailInput 邮箱验证输入
+type VerifyEmailInput struct {
+	Token string `json:"token" query:"token"` // 邮件中的验证令牌
+}
+
+// Validate 验证邮箱验证输入
+func (i *VerifyEmailInput) Validate() *apperrs.Response {
+	issuesMap := z.Struct(i.Shape()).Validate(i)
+	if issuesMap != nil {
+		return &apperrs.Response{
+			Code:   400,
+			Errors: FormatIssuesAsErrorDetails(issuesMap),
+		}
+	}
+	return nil
+}
+
+func (i *VerifyEmailInput) Shape() z.Shape {
+	return z.Shape{
+		"Token": z.String().Max(128).Required(),
+	}
+}
+
+// ResendVerificationInput 重新发送验证邮件输入
+type ResendVerificationInput struct {
+	Email string `json:"email"` // 邮箱
+}
+
+// Validate 验证重新发送验证邮件输入
+func (i *ResendVerificationInput) Validate() *apperrs.Response {
+	issuesMap := z.Struct(i.Shape()).Validate(i)
+	if issuesMap != nil {
+		return &apperrs.Response{
+			Code:   400,
+			Errors: FormatIssuesAsErrorDetails(issuesMap),
+		}
+	}
+	return nil
+}
+
+func (i *ResendVerificationInput) Shape() z.Shape {
+	return z.Shape{
+		"Email": z.String().Email().Required(),
+	}
+}
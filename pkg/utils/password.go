@@ -1,24 +1,169 @@
 package utils
 
 import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
 	"golang.org/x/crypto/bcrypt"
 )
 
-const (
-	// 默认bcrypt成本值
-	DefaultBcryptCost = 12
-)
+// ErrUnknownPasswordHash 无法识别密码哈希的算法
+var ErrUnknownPasswordHash = errors.New("无法识别的密码哈希格式")
+
+// PasswordHasher 密码哈希算法，生成的哈希中包含算法和参数，便于之后升级
+type PasswordHasher interface {
+	// Hash 生成密码哈希
+	Hash(password string) (string, error)
+
+	// Verify 校验密码是否与哈希匹配，rehash 为 true 表示哈希使用了旧算法或旧参数，应在校验通过后重新生成
+	Verify(encoded string, password string) (ok bool, rehash bool, err error)
+}
+
+// Argon2idParams Argon2id 参数
+type Argon2idParams struct {
+	Memory      uint32 // 内存开销（KiB）
+	Iterations  uint32 // 迭代次数
+	Parallelism uint8  // 并行度
+	SaltLength  uint32 // 盐长度（字节）
+	KeyLength   uint32 // 哈希长度（字节）
+}
+
+// DefaultArgon2idParams 默认 Argon2id 参数，即 OWASP 推荐的最低配置
+var DefaultArgon2idParams = Argon2idParams{
+	Memory:      19 * 1024,
+	Iterations:  2,
+	Parallelism: 1,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+// Argon2idHasher 使用 Argon2id 生成密码哈希，同时可以校验旧的 bcrypt 哈希
+type Argon2idHasher struct {
+	params Argon2idParams
+	pepper []byte
+}
 
-// HashPassword 对密码进行哈希加密
-func HashPassword(password string) (string, error) {
-	hashedBytes, err := bcrypt.GenerateFromPassword([]byte(password), DefaultBcryptCost)
+// NewArgon2idHasher 创建 Argon2id 密码哈希，参数为零值时使用默认值。
+// pepper 不为空时先使用 HMAC-SHA256 混入服务端密钥再计算哈希，修改 pepper 会使所有 Argon2id 哈希失效
+func NewArgon2idHasher(params Argon2idParams, pepper string) *Argon2idHasher {
+	if params.Memory == 0 {
+		params.Memory = DefaultArgon2idParams.Memory
+	}
+	if params.Iterations == 0 {
+		params.Iterations = DefaultArgon2idParams.Iterations
+	}
+	if params.Parallelism == 0 {
+		params.Parallelism = DefaultArgon2idParams.Parallelism
+	}
+	if params.SaltLength == 0 {
+		params.SaltLength = DefaultArgon2idParams.SaltLength
+	}
+	if params.KeyLength == 0 {
+		params.KeyLength = DefaultArgon2idParams.KeyLength
+	}
+
+	return &Argon2idHasher{
+		params: params,
+		pepper: []byte(pepper),
+	}
+}
+
+// Hash 生成 PHC 格式的 Argon2id 哈希：$argon2id$v=19$m=内存,t=迭代次数,p=并行度$盐$哈希
+func (h *Argon2idHasher) Hash(password string) (string, error) {
+	salt := make([]byte, h.params.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("生成密码盐失败: %w", err)
+	}
+
+	key := argon2.IDKey(h.peppered(password), salt, h.params.Iterations, h.params.Memory, h.params.Parallelism, h.params.KeyLength)
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, h.params.Memory, h.params.Iterations, h.params.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+// Verify 校验 Argon2id 或 bcrypt 哈希，bcrypt 哈希和参数与当前配置不同的 Argon2id 哈希需要重新生成
+func (h *Argon2idHasher) Verify(encoded string, password string) (bool, bool, error) {
+	switch {
+	case strings.HasPrefix(encoded, "$argon2id$"):
+		params, salt, key, err := decodeArgon2idHash(encoded)
+		if err != nil {
+			return false, false, err
+		}
+		actual := argon2.IDKey(h.peppered(password), salt, params.Iterations, params.Memory, params.Parallelism, params.KeyLength)
+		if subtle.ConstantTimeCompare(actual, key) != 1 {
+			return false, false, nil
+		}
+		return true, params != h.params, nil
+
+	case isBcryptHash(encoded):
+		// bcrypt 哈希生成时没有混入 pepper
+		err := bcrypt.CompareHashAndPassword([]byte(encoded), []byte(password))
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return false, false, nil
+		}
+		if err != nil {
+			return false, false, err
+		}
+		return true, true, nil
+
+	default:
+		return false, false, ErrUnknownPasswordHash
+	}
+}
+
+// peppered 混入服务端密钥后的密码
+func (h *Argon2idHasher) peppered(password string) []byte {
+	if len(h.pepper) == 0 {
+		return []byte(password)
+	}
+	mac := hmac.New(sha256.New, h.pepper)
+	mac.Write([]byte(password))
+	return mac.Sum(nil)
+}
+
+// decodeArgon2idHash 解析 PHC 格式的 Argon2id 哈希
+func decodeArgon2idHash(encoded string) (Argon2idParams, []byte, []byte, error) {
+	var params Argon2idParams
+
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 {
+		return params, nil, nil, ErrUnknownPasswordHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return params, nil, nil, fmt.Errorf("解析 Argon2id 版本失败: %w", err)
+	}
+	if version != argon2.Version {
+		return params, nil, nil, fmt.Errorf("不支持的 Argon2id 版本: %d", version)
+	}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism); err != nil {
+		return params, nil, nil, fmt.Errorf("解析 Argon2id 参数失败: %w", err)
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return params, nil, nil, fmt.Errorf("解析 Argon2id 盐失败: %w", err)
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
 	if err != nil {
-		return "", err
+		return params, nil, nil, fmt.Errorf("解析 Argon2id 哈希失败: %w", err)
 	}
-	return string(hashedBytes), nil
+	params.SaltLength = uint32(len(salt))
+	params.KeyLength = uint32(len(key))
+	return params, salt, key, nil
 }
 
-// VerifyPassword 验证密码是否匹配哈希值
-func VerifyPassword(hashedPassword, password string) error {
-	return bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(password))
+// isBcryptHash 判断是否为 bcrypt 哈希
+func isBcryptHash(encoded string) bool {
+	return strings.HasPrefix(encoded, "$2a$") || strings.HasPrefix(encoded, "$2b$") || strings.HasPrefix(encoded, "$2y$")
 }
@@ -0,0 +1,52 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestArgon2idHasher(t *testing.T) {
+	hasher := NewArgon2idHasher(Argon2idParams{}, "pepper")
+
+	encoded, err := hasher.Hash("password123")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(encoded, "$argon2id$v=19$m=19456,t=2,p=1$"))
+
+	ok, rehash, err := hasher.Verify(encoded, "password123")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.False(t, rehash)
+
+	ok, _, err = hasher.Verify(encoded, "wrong-password")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	// pepper 不同时无法通过校验
+	ok, _, err = NewArgon2idHasher(Argon2idParams{}, "other-pepper").Verify(encoded, "password123")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	// 参数调整后仍能校验旧哈希，并提示重新生成
+	ok, rehash, err = NewArgon2idHasher(Argon2idParams{Iterations: 3}, "pepper").Verify(encoded, "password123")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.True(t, rehash)
+
+	// 旧的 bcrypt 哈希可以校验，并提示重新生成
+	legacy, err := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.MinCost)
+	require.NoError(t, err)
+	ok, rehash, err = hasher.Verify(string(legacy), "password123")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.True(t, rehash)
+	ok, _, err = hasher.Verify(string(legacy), "wrong-password")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	_, _, err = hasher.Verify("plaintext", "plaintext")
+	assert.ErrorIs(t, err, ErrUnknownPasswordHash)
+}
@@ -0,0 +1,16 @@
+package utils
+
+import "unicode/utf8"
+
+// Truncate 将字符串截断到不超过 maxBytes 字节，且不会截断多字节字符
+func Truncate(s string, maxBytes int) string {
+	if len(s) <= maxBytes {
+		return s
+	}
+
+	cut := maxBytes
+	for cut > 0 && !utf8.RuneStart(s[cut]) {
+		cut--
+	}
+	return s[:cut]
+}
@@ -0,0 +1,23 @@
+package utils
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+)
+
+// HashToken 使用HMAC-SHA256计算令牌的带密钥哈希，返回十六进制字符串
+func HashToken(key string, token string) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(token))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// GenerateRandomToken 生成32字节的随机令牌，返回base64url编码字符串
+func GenerateRandomToken() string {
+	b := make([]byte, 32)
+	rand.Read(b)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
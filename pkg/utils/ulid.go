@@ -18,3 +18,12 @@ func IsValidULID(str string) bool {
 	_, err := ulid.Parse(str)
 	return err == nil
 }
+
+// ULIDTime 返回ULID中编码的时间戳
+func ULIDTime(str string) (time.Time, bool) {
+	id, err := ulid.Parse(str)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return ulid.Time(id.Time()), true
+}